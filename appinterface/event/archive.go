@@ -0,0 +1,478 @@
+package event
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const ARCHIVE_FILE_EXTENSION = ".jsonl.gz"
+
+// Number of archive readers kept open, so that projections replaying the archive at different
+// heights do not re-read a file from its beginning for every height
+const MAX_ARCHIVE_CURSORS = 8
+
+var archiveFilePattern = regexp.MustCompile(`^(.+)_(-?\d+)_(-?\d+)\.jsonl\.gz$`)
+
+// ArchiveRecord is an event row of an archived partition. Archive files contain one JSON encoded
+// record per line ordered by height, the same order as the events are read from the events table.
+type ArchiveRecord struct {
+	UUID    string          `json:"uuid"`
+	Height  int64           `json:"height"`
+	Name    string          `json:"name"`
+	Version int             `json:"version"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// ArchiveFile is a gzip compressed archive of an events table partition
+type ArchiveFile struct {
+	Path       string
+	FromHeight int64
+	ToHeight   int64
+}
+
+func (file *ArchiveFile) Contains(height int64) bool {
+	return height >= file.FromHeight && height < file.ToHeight
+}
+
+func ArchiveFileName(partition Partition) string {
+	return partition.Name + ARCHIVE_FILE_EXTENSION
+}
+
+// FileArchive reads the events of the partitions archived to a local directory
+type FileArchive struct {
+	dir   string
+	table string
+
+	mutex   sync.Mutex
+	files   []ArchiveFile
+	cursors []*archiveCursor
+}
+
+func NewFileArchive(dir string, table string) (*FileArchive, error) {
+	archive := &FileArchive{
+		dir:   dir,
+		table: table,
+
+		files:   make([]ArchiveFile, 0),
+		cursors: make([]*archiveCursor, 0),
+	}
+	if err := archive.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func (archive *FileArchive) Dir() string {
+	return archive.dir
+}
+
+// Refresh re-scans the archive directory for archive files written since the last scan
+func (archive *FileArchive) Refresh() error {
+	fileInfos, err := ioutil.ReadDir(archive.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			fileInfos = nil
+		} else {
+			return fmt.Errorf("error reading events archive directory: %v", err)
+		}
+	}
+
+	files := make([]ArchiveFile, 0)
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() {
+			continue
+		}
+		matches := archiveFilePattern.FindStringSubmatch(fileInfo.Name())
+		if matches == nil || matches[1] != archive.table {
+			continue
+		}
+		fromHeight, fromErr := strconv.ParseInt(matches[2], 10, 64)
+		toHeight, toErr := strconv.ParseInt(matches[3], 10, 64)
+		if fromErr != nil || toErr != nil {
+			continue
+		}
+
+		files = append(files, ArchiveFile{
+			Path:       filepath.Join(archive.dir, fileInfo.Name()),
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].FromHeight < files[j].FromHeight
+	})
+
+	archive.mutex.Lock()
+	archive.files = files
+	archive.mutex.Unlock()
+	return nil
+}
+
+// Files returns the archive files ordered by height
+func (archive *FileArchive) Files() []ArchiveFile {
+	archive.mutex.Lock()
+	defer archive.mutex.Unlock()
+
+	return append([]ArchiveFile{}, archive.files...)
+}
+
+func (archive *FileArchive) Contains(height int64) bool {
+	archive.mutex.Lock()
+	defer archive.mutex.Unlock()
+
+	return archive.findFile(height) != nil
+}
+
+func (archive *FileArchive) findFile(height int64) *ArchiveFile {
+	for i := range archive.files {
+		if archive.files[i].Contains(height) {
+			return &archive.files[i]
+		}
+	}
+	return nil
+}
+
+// GetAllByHeight returns the archived records of the height. Reading heights in ascending order
+// continues from the last read position of the file instead of reading it from the beginning.
+func (archive *FileArchive) GetAllByHeight(height int64) ([]ArchiveRecord, error) {
+	archive.mutex.Lock()
+	defer archive.mutex.Unlock()
+
+	file := archive.findFile(height)
+	if file == nil {
+		return nil, fmt.Errorf("height %d is not archived", height)
+	}
+
+	cursor, err := archive.acquireCursor(file.Path, height)
+	if err != nil {
+		return nil, err
+	}
+	records, err := cursor.readHeight(height)
+	if err != nil {
+		archive.removeCursor(cursor)
+		return nil, fmt.Errorf("error reading events archive %s: %v", file.Path, err)
+	}
+
+	return records, nil
+}
+
+// acquireCursor returns an open cursor of the file not yet past the height, and opens one when
+// there is none. The least recently used cursor is closed when there are too many cursors open.
+func (archive *FileArchive) acquireCursor(path string, height int64) (*archiveCursor, error) {
+	for i, cursor := range archive.cursors {
+		if cursor.path == path && cursor.position <= height {
+			// Move to the end as the most recently used
+			archive.cursors = append(append(archive.cursors[:i:i], archive.cursors[i+1:]...), cursor)
+			return cursor, nil
+		}
+	}
+
+	cursor, err := openArchiveCursor(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(archive.cursors) >= MAX_ARCHIVE_CURSORS {
+		_ = archive.cursors[0].close()
+		archive.cursors = archive.cursors[1:]
+	}
+	archive.cursors = append(archive.cursors, cursor)
+	return cursor, nil
+}
+
+func (archive *FileArchive) removeCursor(cursor *archiveCursor) {
+	for i := range archive.cursors {
+		if archive.cursors[i] == cursor {
+			archive.cursors = append(archive.cursors[:i], archive.cursors[i+1:]...)
+			break
+		}
+	}
+	_ = cursor.close()
+}
+
+// Close closes all open archive files
+func (archive *FileArchive) Close() error {
+	archive.mutex.Lock()
+	defer archive.mutex.Unlock()
+
+	var closeErr error
+	for _, cursor := range archive.cursors {
+		if err := cursor.close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	archive.cursors = make([]*archiveCursor, 0)
+	return closeErr
+}
+
+// archiveCursor reads an archive file forward. All records below position have been read.
+type archiveCursor struct {
+	path     string
+	file     *os.File
+	gzReader *gzip.Reader
+	decoder  *json.Decoder
+
+	position    int64
+	maybeNext   *ArchiveRecord
+	isExhausted bool
+}
+
+func openArchiveCursor(path string) (*archiveCursor, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening events archive %s: %v", path, err)
+	}
+	gzReader, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("error reading events archive %s: %v", path, err)
+	}
+
+	return &archiveCursor{
+		path:     path,
+		file:     file,
+		gzReader: gzReader,
+		decoder:  json.NewDecoder(gzReader),
+	}, nil
+}
+
+func (cursor *archiveCursor) readHeight(height int64) ([]ArchiveRecord, error) {
+	records := make([]ArchiveRecord, 0)
+	for {
+		if cursor.maybeNext == nil {
+			if cursor.isExhausted {
+				break
+			}
+			var record ArchiveRecord
+			if err := cursor.decoder.Decode(&record); err != nil {
+				if errors.Is(err, io.EOF) {
+					cursor.isExhausted = true
+					break
+				}
+				return nil, err
+			}
+			cursor.maybeNext = &record
+		}
+
+		if cursor.maybeNext.Height > height {
+			break
+		}
+		if cursor.maybeNext.Height == height {
+			records = append(records, *cursor.maybeNext)
+		}
+		cursor.maybeNext = nil
+	}
+
+	cursor.position = height + 1
+	return records, nil
+}
+
+func (cursor *archiveCursor) close() error {
+	_ = cursor.gzReader.Close()
+	return cursor.file.Close()
+}
+
+// ArchiveWriter writes an archive file. The file only appears in the archive directory once the
+// writer is committed.
+type ArchiveWriter struct {
+	path     string
+	tempFile *os.File
+	gzWriter *gzip.Writer
+	encoder  *json.Encoder
+
+	count      int64
+	lastHeight *int64
+}
+
+func NewArchiveWriter(dir string, partition Partition) (*ArchiveWriter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating events archive directory: %v", err)
+	}
+
+	path := filepath.Join(dir, ArchiveFileName(partition))
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("events archive %s already exists", path)
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "."+ArchiveFileName(partition)+".*")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary events archive: %v", err)
+	}
+	gzWriter := gzip.NewWriter(tempFile)
+
+	return &ArchiveWriter{
+		path:     path,
+		tempFile: tempFile,
+		gzWriter: gzWriter,
+		encoder:  json.NewEncoder(gzWriter),
+	}, nil
+}
+
+// Write appends the record to the archive. Records must be written in ascending height order.
+func (writer *ArchiveWriter) Write(record ArchiveRecord) error {
+	if writer.lastHeight != nil && record.Height < *writer.lastHeight {
+		return fmt.Errorf(
+			"event %s at height %d is written after height %d", record.UUID, record.Height, *writer.lastHeight,
+		)
+	}
+
+	if err := writer.encoder.Encode(record); err != nil {
+		return fmt.Errorf("error writing events archive: %v", err)
+	}
+	writer.count += 1
+	height := record.Height
+	writer.lastHeight = &height
+	return nil
+}
+
+func (writer *ArchiveWriter) Count() int64 {
+	return writer.count
+}
+
+// Commit flushes the archive to disk and moves it to its final path
+func (writer *ArchiveWriter) Commit() error {
+	if err := writer.gzWriter.Close(); err != nil {
+		writer.Abort()
+		return fmt.Errorf("error compressing events archive: %v", err)
+	}
+	if err := writer.tempFile.Sync(); err != nil {
+		writer.Abort()
+		return fmt.Errorf("error syncing events archive: %v", err)
+	}
+	if err := writer.tempFile.Close(); err != nil {
+		_ = os.Remove(writer.tempFile.Name())
+		return fmt.Errorf("error closing events archive: %v", err)
+	}
+	if err := os.Rename(writer.tempFile.Name(), writer.path); err != nil {
+		_ = os.Remove(writer.tempFile.Name())
+		return fmt.Errorf("error moving events archive to %s: %v", writer.path, err)
+	}
+
+	return nil
+}
+
+// Abort discards the archive
+func (writer *ArchiveWriter) Abort() {
+	_ = writer.tempFile.Close()
+	_ = os.Remove(writer.tempFile.Name())
+}
+
+// ArchivePartition writes the events of the partition to an archive file, verifies the archive
+// against the partition, then detaches and drops the partition. The returned count is the number of
+// archived events.
+func ArchivePartition(
+	rdbConn rdb.Conn,
+	partitioner *RDbPartitioner,
+	archiveDir string,
+	partition Partition,
+) (int64, error) {
+	rdbHandle := rdbConn.ToHandle()
+
+	sql, args, err := rdbHandle.StmtBuilder.Select(
+		"uuid", "height", "name", "version", "payload",
+	).From(
+		partition.Name,
+	).OrderBy("height", "id").ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("error building events partition selection SQL: %v", err)
+	}
+
+	writer, err := NewArchiveWriter(archiveDir, partition)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsResult, err := rdbHandle.Query(sql, args...)
+	if err != nil {
+		writer.Abort()
+		return 0, fmt.Errorf("error executing events partition selection SQL: %v", err)
+	}
+	for rowsResult.Next() {
+		var record ArchiveRecord
+		var payload string
+		if err = rowsResult.Scan(&record.UUID, &record.Height, &record.Name, &record.Version, &payload); err != nil {
+			rowsResult.Close()
+			writer.Abort()
+			return 0, fmt.Errorf("error scanning event of partition %s: %v", partition.Name, err)
+		}
+		record.Payload = json.RawMessage(payload)
+
+		if err = writer.Write(record); err != nil {
+			rowsResult.Close()
+			writer.Abort()
+			return 0, err
+		}
+	}
+	rowsErr := rowsResult.Err()
+	rowsResult.Close()
+	if rowsErr != nil {
+		writer.Abort()
+		return 0, fmt.Errorf("error iterating events of partition %s: %v", partition.Name, rowsErr)
+	}
+
+	if err = writer.Commit(); err != nil {
+		return 0, err
+	}
+
+	// The archive is read back so that a corrupted file is never left as the only copy of the events
+	archivedCount, err := countArchiveRecords(filepath.Join(archiveDir, ArchiveFileName(partition)))
+	if err != nil {
+		return 0, fmt.Errorf("error verifying events archive: %v", err)
+	}
+
+	countSQL, countArgs, err := rdbHandle.StmtBuilder.Select("COUNT(*)").From(partition.Name).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("error building events partition count SQL: %v", err)
+	}
+	var partitionCount int64
+	if err = rdbHandle.QueryRow(countSQL, countArgs...).Scan(&partitionCount); err != nil {
+		return 0, fmt.Errorf("error counting events of partition %s: %v", partition.Name, err)
+	}
+	if archivedCount != partitionCount {
+		return 0, fmt.Errorf(
+			"events archive of partition %s has %d events but the partition has %d",
+			partition.Name, archivedCount, partitionCount,
+		)
+	}
+
+	if err = partitioner.DetachPartition(rdbConn, partition); err != nil {
+		return 0, err
+	}
+
+	return archivedCount, nil
+}
+
+func countArchiveRecords(path string) (int64, error) {
+	cursor, err := openArchiveCursor(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = cursor.close()
+	}()
+
+	count := int64(0)
+	for {
+		var record ArchiveRecord
+		if err := cursor.decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return count, nil
+			}
+			return 0, err
+		}
+		count += 1
+	}
+}
@@ -0,0 +1,134 @@
+package event
+
+import (
+	"fmt"
+	"sync"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+)
+
+var _ entity_event.Store = &MemoryStore{}
+
+// MemoryStore is an in-memory event store intended for tests. Like RDbStore, events are stored
+// encoded and decoded by the Registry on read, so that projections receive the same events as they
+// would in production.
+type MemoryStore struct {
+	mutex sync.RWMutex
+
+	Registry *entity_event.Registry
+
+	eventsByHeight    map[int64][]memoryStoreRecord
+	uuids             map[string]bool
+	maybeLatestHeight *int64
+}
+
+type memoryStoreRecord struct {
+	name    string
+	version int
+	payload string
+}
+
+func NewMemoryStore(registry *entity_event.Registry) *MemoryStore {
+	return &MemoryStore{
+		Registry: registry,
+
+		eventsByHeight: make(map[int64][]memoryStoreRecord),
+		uuids:          make(map[string]bool),
+	}
+}
+
+// GetLatestHeight returns latest event height, nil if no event is stored
+func (store *MemoryStore) GetLatestHeight() (*int64, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	if store.maybeLatestHeight == nil {
+		return nil, nil
+	}
+	latestHeight := *store.maybeLatestHeight
+	return &latestHeight, nil
+}
+
+func (store *MemoryStore) GetAllByHeight(height int64) ([]entity_event.Event, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	return store.getAllByHeight(height)
+}
+
+func (store *MemoryStore) GetAllByHeightRange(fromHeight int64, toHeight int64) (map[int64][]entity_event.Event, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	eventsByHeight := make(map[int64][]entity_event.Event)
+	for height := fromHeight; height <= toHeight; height++ {
+		if len(store.eventsByHeight[height]) == 0 {
+			continue
+		}
+		events, err := store.getAllByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		eventsByHeight[height] = events
+	}
+
+	return eventsByHeight, nil
+}
+
+func (store *MemoryStore) getAllByHeight(height int64) ([]entity_event.Event, error) {
+	events := make([]entity_event.Event, 0, len(store.eventsByHeight[height]))
+	for _, record := range store.eventsByHeight[height] {
+		event, err := store.Registry.DecodeByType(record.name, record.version, []byte(record.payload))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding the event string into type: %v", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (store *MemoryStore) Insert(event entity_event.Event) error {
+	return store.InsertAll([]entity_event.Event{event})
+}
+
+// InsertAll insert all events into store. No event is inserted when any of them fails.
+func (store *MemoryStore) InsertAll(events []entity_event.Event) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	records := make([]memoryStoreRecord, 0, len(events))
+	uuids := make([]string, 0, len(events))
+	isInserting := make(map[string]bool)
+	for _, event := range events {
+		uuid := event.UUID()
+		if store.uuids[uuid] || isInserting[uuid] {
+			return fmt.Errorf("error inserting event: duplicated event UUID %s", uuid)
+		}
+		isInserting[uuid] = true
+		uuids = append(uuids, uuid)
+
+		encodedEvent, err := event.ToJSON()
+		if err != nil {
+			return fmt.Errorf("error encoding event to json: %v", err)
+		}
+		records = append(records, memoryStoreRecord{
+			name:    event.Name(),
+			version: event.Version(),
+			payload: encodedEvent,
+		})
+	}
+
+	for i, event := range events {
+		height := event.Height()
+		store.eventsByHeight[height] = append(store.eventsByHeight[height], records[i])
+		store.uuids[uuids[i]] = true
+
+		if store.maybeLatestHeight == nil || height > *store.maybeLatestHeight {
+			store.maybeLatestHeight = &height
+		}
+	}
+
+	return nil
+}
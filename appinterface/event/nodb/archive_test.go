@@ -0,0 +1,177 @@
+package nodb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appinterface_event "github.com/crypto-com/chain-indexing/appinterface/event"
+)
+
+var _ = Describe("FileArchive", func() {
+	var archiveDir string
+
+	BeforeEach(func() {
+		var err error
+		archiveDir, err = ioutil.TempDir("", "chain-indexing-archive")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(archiveDir)
+	})
+
+	writeArchive := func(partition appinterface_event.Partition, heights ...int64) {
+		writer, err := appinterface_event.NewArchiveWriter(archiveDir, partition)
+		Expect(err).To(BeNil())
+		for i, height := range heights {
+			Expect(writer.Write(appinterface_event.ArchiveRecord{
+				UUID:    fmt.Sprintf("uuid-%d", i),
+				Height:  height,
+				Name:    "BlockCreated",
+				Version: 1,
+				Payload: json.RawMessage(fmt.Sprintf(`{"height":%d}`, height)),
+			})).To(Succeed())
+		}
+		Expect(writer.Commit()).To(Succeed())
+	}
+
+	recordUUIDs := func(records []appinterface_event.ArchiveRecord) []string {
+		uuids := make([]string, 0, len(records))
+		for _, record := range records {
+			uuids = append(uuids, record.UUID)
+		}
+		return uuids
+	}
+
+	It("should read back the archived records by height", func() {
+		writeArchive(appinterface_event.Partition{
+			Name:       appinterface_event.PartitionName("events", 0, 10),
+			FromHeight: 0,
+			ToHeight:   10,
+		}, 1, 1, 2, 5)
+
+		archive, err := appinterface_event.NewFileArchive(archiveDir, "events")
+		Expect(err).To(BeNil())
+		defer archive.Close()
+
+		Expect(archive.Contains(0)).To(BeTrue())
+		Expect(archive.Contains(9)).To(BeTrue())
+		Expect(archive.Contains(10)).To(BeFalse())
+
+		records, err := archive.GetAllByHeight(1)
+		Expect(err).To(BeNil())
+		Expect(recordUUIDs(records)).To(Equal([]string{"uuid-0", "uuid-1"}))
+		Expect(string(records[0].Payload)).To(Equal(`{"height":1}`))
+
+		records, err = archive.GetAllByHeight(2)
+		Expect(err).To(BeNil())
+		Expect(recordUUIDs(records)).To(Equal([]string{"uuid-2"}))
+
+		records, err = archive.GetAllByHeight(3)
+		Expect(err).To(BeNil())
+		Expect(records).To(BeEmpty())
+
+		records, err = archive.GetAllByHeight(5)
+		Expect(err).To(BeNil())
+		Expect(recordUUIDs(records)).To(Equal([]string{"uuid-3"}))
+	})
+
+	It("should read a height again after reading later heights", func() {
+		writeArchive(appinterface_event.Partition{
+			Name:       appinterface_event.PartitionName("events", 0, 10),
+			FromHeight: 0,
+			ToHeight:   10,
+		}, 1, 2, 3)
+
+		archive, err := appinterface_event.NewFileArchive(archiveDir, "events")
+		Expect(err).To(BeNil())
+		defer archive.Close()
+
+		records, err := archive.GetAllByHeight(3)
+		Expect(err).To(BeNil())
+		Expect(recordUUIDs(records)).To(Equal([]string{"uuid-2"}))
+
+		records, err = archive.GetAllByHeight(1)
+		Expect(err).To(BeNil())
+		Expect(recordUUIDs(records)).To(Equal([]string{"uuid-0"}))
+	})
+
+	It("should return error when the height is not archived", func() {
+		archive, err := appinterface_event.NewFileArchive(archiveDir, "events")
+		Expect(err).To(BeNil())
+
+		Expect(archive.Contains(1)).To(BeFalse())
+		_, err = archive.GetAllByHeight(1)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should find archives written after a refresh only", func() {
+		archive, err := appinterface_event.NewFileArchive(archiveDir, "events")
+		Expect(err).To(BeNil())
+
+		writeArchive(appinterface_event.Partition{
+			Name:       appinterface_event.PartitionName("events", 10, 20),
+			FromHeight: 10,
+			ToHeight:   20,
+		}, 10)
+		Expect(archive.Contains(10)).To(BeFalse())
+
+		Expect(archive.Refresh()).To(Succeed())
+		Expect(archive.Contains(10)).To(BeTrue())
+		Expect(archive.Files()).To(Equal([]appinterface_event.ArchiveFile{
+			{
+				Path:       filepath.Join(archiveDir, "events_10_20.jsonl.gz"),
+				FromHeight: 10,
+				ToHeight:   20,
+			},
+		}))
+	})
+
+	It("should ignore archives of other tables", func() {
+		writeArchive(appinterface_event.Partition{
+			Name:       appinterface_event.PartitionName("other_events", 0, 10),
+			FromHeight: 0,
+			ToHeight:   10,
+		}, 1)
+
+		archive, err := appinterface_event.NewFileArchive(archiveDir, "events")
+		Expect(err).To(BeNil())
+
+		Expect(archive.Contains(1)).To(BeFalse())
+	})
+
+	It("should not create the archive when the writer is aborted or the records are out of order", func() {
+		partition := appinterface_event.Partition{
+			Name:       appinterface_event.PartitionName("events", 0, 10),
+			FromHeight: 0,
+			ToHeight:   10,
+		}
+		writer, err := appinterface_event.NewArchiveWriter(archiveDir, partition)
+		Expect(err).To(BeNil())
+		Expect(writer.Write(appinterface_event.ArchiveRecord{UUID: "uuid-0", Height: 2, Payload: json.RawMessage("{}")})).To(Succeed())
+		Expect(writer.Write(appinterface_event.ArchiveRecord{UUID: "uuid-1", Height: 1, Payload: json.RawMessage("{}")})).NotTo(Succeed())
+		writer.Abort()
+
+		fileInfos, err := ioutil.ReadDir(archiveDir)
+		Expect(err).To(BeNil())
+		Expect(fileInfos).To(BeEmpty())
+	})
+
+	It("should not overwrite an existing archive", func() {
+		partition := appinterface_event.Partition{
+			Name:       appinterface_event.PartitionName("events", 0, 10),
+			FromHeight: 0,
+			ToHeight:   10,
+		}
+		writeArchive(partition, 1)
+
+		_, err := appinterface_event.NewArchiveWriter(archiveDir, partition)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,68 @@
+package nodb_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appinterface_event "github.com/crypto-com/chain-indexing/appinterface/event"
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("MemoryStore", func() {
+	var registry *entity_event.Registry
+
+	BeforeEach(func() {
+		registry = entity_event.NewRegistry()
+		event_usecase.RegisterEvents(registry)
+	})
+
+	It("should return nil latest height when no event is stored", func() {
+		store := appinterface_event.NewMemoryStore(registry)
+
+		Expect(store.GetLatestHeight()).To(BeNil())
+		Expect(store.GetAllByHeight(1)).To(BeEmpty())
+	})
+
+	It("should return decoded events of height in insertion order", func() {
+		store := appinterface_event.NewMemoryStore(registry)
+
+		anyEvent := newBlockCreated(2, "ANY_HASH")
+		anyOtherEvent := newBlockCreated(2, "ANY_OTHER_HASH")
+		Expect(store.InsertAll([]entity_event.Event{anyEvent, anyOtherEvent})).To(Succeed())
+		Expect(store.Insert(newBlockCreated(1, "ANY_HASH"))).To(Succeed())
+
+		Expect(store.GetLatestHeight()).To(Equal(primptr.Int64(2)))
+
+		events, err := store.GetAllByHeight(2)
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0]).NotTo(BeIdenticalTo(anyEvent))
+		Expect(events[0].UUID()).To(Equal(anyEvent.UUID()))
+		Expect(events[0].(*event_usecase.BlockCreated).Block.Hash).To(Equal("ANY_HASH"))
+		Expect(events[1].UUID()).To(Equal(anyOtherEvent.UUID()))
+	})
+
+	It("should insert none of the events when any of them has duplicated UUID", func() {
+		store := appinterface_event.NewMemoryStore(registry)
+
+		anyEvent := newBlockCreated(1, "ANY_HASH")
+		Expect(store.Insert(anyEvent)).To(Succeed())
+		Expect(store.InsertAll([]entity_event.Event{newBlockCreated(2, "ANY_HASH"), anyEvent})).NotTo(Succeed())
+
+		Expect(store.GetLatestHeight()).To(Equal(primptr.Int64(1)))
+		Expect(store.GetAllByHeight(2)).To(BeEmpty())
+	})
+})
+
+func newBlockCreated(height int64, hash string) *event_usecase.BlockCreated {
+	return event_usecase.NewBlockCreated(&usecase_model.Block{
+		Height:     height,
+		Hash:       hash,
+		Time:       utctime.FromUnixNano(int64(1000000)),
+		Signatures: []usecase_model.BlockSignature{},
+	})
+}
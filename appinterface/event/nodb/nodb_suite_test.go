@@ -0,0 +1,15 @@
+package nodb_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The event stores and archives not requiring a database are tested apart from the Event Suite, so
+// that they run without the Postgres test environment
+func TestNoDB(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Event NoDB Suite")
+}
@@ -0,0 +1,228 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const DEFAULT_PARTITION_SIZE = int64(100000)
+
+// Partition is a height range partition of the events table. FromHeight is inclusive and ToHeight
+// is exclusive, the same as the partition bounds of Postgres.
+type Partition struct {
+	Name       string
+	FromHeight int64
+	ToHeight   int64
+}
+
+func (partition *Partition) Contains(height int64) bool {
+	return height >= partition.FromHeight && height < partition.ToHeight
+}
+
+var partitionBoundPattern = regexp.MustCompile(`^FOR VALUES FROM \('?(-?\d+)'?\) TO \('?(-?\d+)'?\)$`)
+
+// RDbPartitioner maintains the height range partitions of a Postgres events table partitioned by
+// `PARTITION BY RANGE (height)`. New partitions are aligned to multiples of the partition size, and
+// are shrunk when they would overlap an existing partition created with another size.
+type RDbPartitioner struct {
+	table         string
+	partitionSize int64
+
+	mutex sync.Mutex
+	// Partitions known to exist, read from the database when a height is not covered by any of them
+	knownPartitions []Partition
+}
+
+func NewRDbPartitioner(table string, partitionSize int64) *RDbPartitioner {
+	if partitionSize <= 0 {
+		partitionSize = DEFAULT_PARTITION_SIZE
+	}
+
+	return &RDbPartitioner{
+		table:         table,
+		partitionSize: partitionSize,
+
+		knownPartitions: make([]Partition, 0),
+	}
+}
+
+// EnsurePartitions creates the missing partitions covering the heights
+func (partitioner *RDbPartitioner) EnsurePartitions(rdbHandle *rdb.Handle, heights []int64) error {
+	partitioner.mutex.Lock()
+	defer partitioner.mutex.Unlock()
+
+	// Partitions created in this call are not known until they are read back from the database,
+	// because the transaction creating them may be rolled back
+	createdPartitions := make([]Partition, 0)
+	isRefreshed := false
+	for _, height := range heights {
+		if findPartition(partitioner.knownPartitions, height) != nil ||
+			findPartition(createdPartitions, height) != nil {
+			continue
+		}
+
+		if !isRefreshed {
+			partitions, err := partitioner.ListPartitions(rdbHandle)
+			if err != nil {
+				return err
+			}
+			partitioner.knownPartitions = partitions
+			isRefreshed = true
+
+			if findPartition(partitioner.knownPartitions, height) != nil {
+				continue
+			}
+		}
+
+		partition := partitioner.newPartition(
+			height, append(append([]Partition{}, partitioner.knownPartitions...), createdPartitions...),
+		)
+		// nolint:gosec
+		sql := fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM (%d) TO (%d)",
+			partition.Name, partitioner.table, partition.FromHeight, partition.ToHeight,
+		)
+		if _, err := rdbHandle.Exec(sql); err != nil {
+			return fmt.Errorf("error creating events partition %s: %v", partition.Name, err)
+		}
+		createdPartitions = append(createdPartitions, partition)
+	}
+
+	return nil
+}
+
+func findPartition(partitions []Partition, height int64) *Partition {
+	for i := range partitions {
+		if partitions[i].Contains(height) {
+			return &partitions[i]
+		}
+	}
+	return nil
+}
+
+// newPartition returns the partition of the height aligned to the partition size, shrunk to not
+// overlap the existing partitions
+func (partitioner *RDbPartitioner) newPartition(height int64, existingPartitions []Partition) Partition {
+	fromHeight := height - height%partitioner.partitionSize
+	if height < 0 && height%partitioner.partitionSize != 0 {
+		fromHeight -= partitioner.partitionSize
+	}
+	toHeight := fromHeight + partitioner.partitionSize
+
+	for _, partition := range existingPartitions {
+		if partition.ToHeight > fromHeight && partition.ToHeight <= height {
+			fromHeight = partition.ToHeight
+		}
+		if partition.FromHeight < toHeight && partition.FromHeight > height {
+			toHeight = partition.FromHeight
+		}
+	}
+
+	return Partition{
+		Name:       PartitionName(partitioner.table, fromHeight, toHeight),
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	}
+}
+
+func PartitionName(table string, fromHeight int64, toHeight int64) string {
+	return fmt.Sprintf("%s_%d_%d", table, fromHeight, toHeight)
+}
+
+// ListPartitions returns the attached height range partitions ordered by height. The default
+// partition is not included.
+func (partitioner *RDbPartitioner) ListPartitions(rdbHandle *rdb.Handle) ([]Partition, error) {
+	sql, args, err := rdbHandle.StmtBuilder.Select(
+		"child.relname", "pg_get_expr(child.relpartbound, child.oid)",
+	).From(
+		"pg_inherits",
+	).Join(
+		"pg_class child ON child.oid = pg_inherits.inhrelid",
+	).Where(
+		"pg_inherits.inhparent = to_regclass(?)", partitioner.table,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building events partitions selection SQL: %v", err)
+	}
+
+	rowsResult, err := rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error querying events partitions: %v", err)
+	}
+	defer rowsResult.Close()
+
+	partitions := make([]Partition, 0)
+	for rowsResult.Next() {
+		var name string
+		var bound string
+		if err = rowsResult.Scan(&name, &bound); err != nil {
+			return nil, fmt.Errorf("error scanning events partition: %v", err)
+		}
+
+		matches := partitionBoundPattern.FindStringSubmatch(bound)
+		if matches == nil {
+			// Default partition
+			continue
+		}
+		fromHeight, _ := strconv.ParseInt(matches[1], 10, 64)
+		toHeight, _ := strconv.ParseInt(matches[2], 10, 64)
+		partitions = append(partitions, Partition{
+			Name:       name,
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+		})
+	}
+	if err = rowsResult.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating events partitions: %v", err)
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].FromHeight < partitions[j].FromHeight
+	})
+	return partitions, nil
+}
+
+// DetachPartition detaches the partition from the events table and drops it
+func (partitioner *RDbPartitioner) DetachPartition(rdbConn rdb.Conn, partition Partition) error {
+	if partition.Name == "" {
+		return errors.New("missing partition name")
+	}
+
+	rdbTx, err := rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	// nolint:gosec
+	if _, err = rdbTx.Exec(fmt.Sprintf(
+		"ALTER TABLE %s DETACH PARTITION %s", partitioner.table, partition.Name,
+	)); err != nil {
+		return fmt.Errorf("error detaching events partition %s: %v", partition.Name, err)
+	}
+	// nolint:gosec
+	if _, err = rdbTx.Exec(fmt.Sprintf("DROP TABLE %s", partition.Name)); err != nil {
+		return fmt.Errorf("error dropping events partition %s: %v", partition.Name, err)
+	}
+
+	if err = rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing events partition detachment: %v", err)
+	}
+	committed = true
+
+	partitioner.mutex.Lock()
+	partitioner.knownPartitions = make([]Partition, 0)
+	partitioner.mutex.Unlock()
+	return nil
+}
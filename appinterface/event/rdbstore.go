@@ -29,6 +29,12 @@ type RDbStore struct {
 	Registry  *entity_event.Registry
 
 	table string
+
+	maybePartitioner *RDbPartitioner
+	maybeArchive     *FileArchive
+
+	maybeBlobStore     entity_event.BlobStore
+	blobPayloadMinSize int
 }
 
 func NewRDbStore(handle *rdb.Handle, registry *entity_event.Registry) *RDbStore {
@@ -40,6 +46,27 @@ func NewRDbStore(handle *rdb.Handle, registry *entity_event.Registry) *RDbStore
 	}
 }
 
+// WithPartitioner creates the partitions of the inserted event heights when the events table is
+// partitioned by height range
+func (store *RDbStore) WithPartitioner(partitioner *RDbPartitioner) *RDbStore {
+	store.maybePartitioner = partitioner
+	return store
+}
+
+// WithArchive reads the events of the detached partitions from the archive
+func (store *RDbStore) WithArchive(archive *FileArchive) *RDbStore {
+	store.maybeArchive = archive
+	return store
+}
+
+// WithBlobStore moves the event payloads of at least minSize bytes to the blob store, leaving a
+// blob reference in the events table. The Registry must be given the same blob store to decode them.
+func (store *RDbStore) WithBlobStore(blobStore entity_event.BlobStore, minSize int) *RDbStore {
+	store.maybeBlobStore = blobStore
+	store.blobPayloadMinSize = minSize
+	return store
+}
+
 // GetLatestHeight returns latest event height, nil if no event is stored
 func (store *RDbStore) GetLatestHeight() (*int64, error) {
 	sql, args, err := store.rdbHandle.StmtBuilder.Select(
@@ -64,6 +91,138 @@ func (store *RDbStore) GetLatestHeight() (*int64, error) {
 }
 
 func (store *RDbStore) GetAllByHeight(height int64) ([]entity_event.Event, error) {
+	if store.maybeArchive != nil && store.maybeArchive.Contains(height) {
+		return store.getAllByHeightFromArchive(height)
+	}
+
+	events, err := store.getAllByHeightFromRDb(height)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 && store.maybeArchive != nil {
+		// The partition of the height may have been archived by another process since the last scan
+		if err = store.maybeArchive.Refresh(); err != nil {
+			return nil, err
+		}
+		if store.maybeArchive.Contains(height) {
+			return store.getAllByHeightFromArchive(height)
+		}
+	}
+
+	return events, nil
+}
+
+// GetAllByHeightRange returns the events of the heights from fromHeight to toHeight inclusively by
+// height with a single query. Heights without events are missing from the result.
+func (store *RDbStore) GetAllByHeightRange(fromHeight int64, toHeight int64) (map[int64][]entity_event.Event, error) {
+	eventsByHeight, err := store.getAllByHeightRangeFromRDb(fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	if store.maybeArchive == nil {
+		return eventsByHeight, nil
+	}
+
+	// The events of the archived partitions are not in the events table
+	isRefreshed := false
+	for height := fromHeight; height <= toHeight; height++ {
+		if len(eventsByHeight[height]) > 0 {
+			continue
+		}
+		if !store.maybeArchive.Contains(height) {
+			if isRefreshed {
+				continue
+			}
+			if err = store.maybeArchive.Refresh(); err != nil {
+				return nil, err
+			}
+			isRefreshed = true
+			if !store.maybeArchive.Contains(height) {
+				continue
+			}
+		}
+
+		events, err := store.getAllByHeightFromArchive(height)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 {
+			eventsByHeight[height] = events
+		}
+	}
+
+	return eventsByHeight, nil
+}
+
+func (store *RDbStore) getAllByHeightRangeFromRDb(
+	fromHeight int64,
+	toHeight int64,
+) (map[int64][]entity_event.Event, error) {
+	sql, args, err := store.rdbHandle.StmtBuilder.Select(
+		"uuid", "height", "name", "version", "payload",
+	).From(
+		store.table,
+	).Where(
+		"height >= ? AND height <= ?", fromHeight, toHeight,
+	).OrderBy("height", "id").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building get all events by height range selection SQL: %v", err)
+	}
+
+	rows, err := store.rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing get all events by height range selection SQL: %v", err)
+	}
+	defer rows.Close()
+
+	eventsByHeight := make(map[int64][]entity_event.Event)
+	for rows.Next() {
+		var (
+			uuid    string
+			height  int64
+			name    string
+			version int
+			payload string
+		)
+
+		if err := rows.Scan(&uuid, &height, &name, &version, &payload); err != nil {
+			return nil, fmt.Errorf("error executing get each event by height range selection SQL: %v", err)
+		}
+
+		event, err := store.Registry.DecodeByType(name, version, []byte(payload))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding the event string into type: %v", err)
+		}
+
+		eventsByHeight[height] = append(eventsByHeight[height], event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating events by height range: %v", err)
+	}
+
+	return eventsByHeight, nil
+}
+
+func (store *RDbStore) getAllByHeightFromArchive(height int64) ([]entity_event.Event, error) {
+	records, err := store.maybeArchive.GetAllByHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting all archived events by height: %v", err)
+	}
+
+	events := make([]entity_event.Event, 0, len(records))
+	for _, record := range records {
+		event, err := store.Registry.DecodeByType(record.Name, record.Version, record.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding the event string into type: %v", err)
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (store *RDbStore) getAllByHeightFromRDb(height int64) ([]entity_event.Event, error) {
 	sql, args, err := store.rdbHandle.StmtBuilder.Select(
 		"uuid", "height", "name", "version", "payload",
 	).From(
@@ -111,9 +270,15 @@ func (store *RDbStore) GetAllByHeight(height int64) ([]entity_event.Event, error
 }
 
 func (store *RDbStore) Insert(event entity_event.Event) error {
-	encodedEvent, err := event.ToJSON()
+	if store.maybePartitioner != nil {
+		if err := store.maybePartitioner.EnsurePartitions(store.rdbHandle, []int64{event.Height()}); err != nil {
+			return fmt.Errorf("error ensuring events partitions: %v", err)
+		}
+	}
+
+	encodedEvent, err := store.encodePayload(event)
 	if err != nil {
-		return fmt.Errorf("error encoding event to json: %v", err)
+		return err
 	}
 	sql, args, err := store.rdbHandle.StmtBuilder.Insert(
 		store.table,
@@ -152,6 +317,16 @@ func (store *RDbStore) InsertAllWithRDbHandle(rdbHandle *rdb.Handle, events []en
 		return nil
 	}
 
+	if store.maybePartitioner != nil {
+		heights := make([]int64, 0, len(events))
+		for _, event := range events {
+			heights = append(heights, event.Height())
+		}
+		if err := store.maybePartitioner.EnsurePartitions(rdbHandle, heights); err != nil {
+			return fmt.Errorf("error ensuring events partitions: %v", err)
+		}
+	}
+
 	pendingRowCount := 0
 	var stmtBuilder sq.InsertBuilder
 
@@ -164,9 +339,9 @@ func (store *RDbStore) InsertAllWithRDbHandle(rdbHandle *rdb.Handle, events []en
 				"uuid", "height", "name", "version", "payload",
 			)
 		}
-		encodedEvent, err := event.ToJSON()
+		encodedEvent, err := store.encodePayload(event)
 		if err != nil {
-			return fmt.Errorf("error encoding event to json: %v", err)
+			return err
 		}
 		stmtBuilder = stmtBuilder.Values(
 			event.UUID(),
@@ -196,3 +371,24 @@ func (store *RDbStore) InsertAllWithRDbHandle(rdbHandle *rdb.Handle, events []en
 
 	return nil
 }
+
+// encodePayload encodes the event, and moves the encoded event to the blob store when it is large
+func (store *RDbStore) encodePayload(event entity_event.Event) (string, error) {
+	encodedEvent, err := event.ToJSON()
+	if err != nil {
+		return "", fmt.Errorf("error encoding event to json: %v", err)
+	}
+	if store.maybeBlobStore == nil || len(encodedEvent) < store.blobPayloadMinSize {
+		return encodedEvent, nil
+	}
+
+	ref, err := store.maybeBlobStore.Put([]byte(encodedEvent))
+	if err != nil {
+		return "", fmt.Errorf("error putting event payload to blob store: %v", err)
+	}
+	encodedBlobRef, err := entity_event.NewBlobRef(ref, len(encodedEvent), event).ToJSON()
+	if err != nil {
+		return "", fmt.Errorf("error encoding blob reference to json: %v", err)
+	}
+	return encodedBlobRef, nil
+}
@@ -2,6 +2,9 @@ package eventhandler
 
 import (
 	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
 
 	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
@@ -12,6 +15,9 @@ import (
 
 var _ Handler = &RDbEventStoreHandler{}
 
+// Channel notified of the heights committed to the event store
+const DEFAULT_HEIGHT_NOTIFICATION_CHANNEL = "event_store_heights"
+
 // RDbEventStoreHandler is an event handler which persist the event to event store
 type RDbEventStoreHandler struct {
 	logger  applogger.Logger
@@ -19,6 +25,8 @@ type RDbEventStoreHandler struct {
 
 	eventStore  *event_interface.RDbStore
 	statusStore *rdbstatusstore.RDbStatusStore
+	// Optional. Postgres channel notified of the committed heights when set.
+	maybeNotificationChannel *string
 }
 
 func NewRDbEventStoreHandler(
@@ -38,6 +46,19 @@ func NewRDbEventStoreHandler(
 	}
 }
 
+// WithEventStore replaces the event store, e.g. by one creating the partitions of the events table
+func (handler *RDbEventStoreHandler) WithEventStore(eventStore *event_interface.RDbStore) *RDbEventStoreHandler {
+	handler.eventStore = eventStore
+	return handler
+}
+
+// WithHeightNotification notifies the Postgres channel of each committed height, so that the
+// listening projections do not wait for their next poll
+func (handler *RDbEventStoreHandler) WithHeightNotification(channel string) *RDbEventStoreHandler {
+	handler.maybeNotificationChannel = &channel
+	return handler
+}
+
 func (handler *RDbEventStoreHandler) GetLastHandledEventHeight() (*int64, error) {
 	return handler.statusStore.GetLastIndexedBlockHeight()
 }
@@ -58,12 +79,33 @@ func (handler *RDbEventStoreHandler) HandleEvents(blockHeight int64, events []ev
 		return fmt.Errorf("error updating last indexed block height to %d: %v", blockHeight, err)
 	}
 
+	// Notifications are delivered on commit
+	if handler.maybeNotificationChannel != nil {
+		if err := handler.notifyHeight(txHandle, *handler.maybeNotificationChannel, blockHeight); err != nil {
+			return fmt.Errorf("error notifying height %d: %v", blockHeight, err)
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("error committing block synchronization outcomes: %v", err)
 	}
 	return nil
 }
 
+func (handler *RDbEventStoreHandler) notifyHeight(rdbHandle *rdb.Handle, channel string, blockHeight int64) error {
+	sql, args, err := rdbHandle.StmtBuilder.Select().Column(
+		sq.Expr("pg_notify(?, ?)", channel, strconv.FormatInt(blockHeight, 10)),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building height notification SQL: %v", err)
+	}
+
+	if _, err := rdbHandle.Exec(sql, args...); err != nil {
+		return fmt.Errorf("error executing height notification SQL: %v", err)
+	}
+	return nil
+}
+
 func initEventStore(rdbHandle *rdb.Handle, registry *event.Registry) *event_interface.RDbStore {
 	return event_interface.NewRDbStore(rdbHandle, registry)
 }
@@ -0,0 +1,26 @@
+package rdbprojectionbase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HEIGHT_NOTIFICATION_CHANNEL is the Postgres channel notified by the trigger of the default table
+// whenever a projection commits its last handled event height. The payload is `<id>:<height>`.
+const HEIGHT_NOTIFICATION_CHANNEL = "projection_heights"
+
+// ParseHeightNotification parses the projection Id and the last handled event height from the
+// payload of a height notification
+func ParseHeightNotification(payload string) (string, int64, error) {
+	separatorIndex := strings.LastIndex(payload, ":")
+	if separatorIndex <= 0 {
+		return "", 0, fmt.Errorf("missing projection Id in height notification %q", payload)
+	}
+
+	height, err := strconv.ParseInt(payload[separatorIndex+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("error parsing height of height notification %q: %v", payload, err)
+	}
+	return payload[:separatorIndex], height, nil
+}
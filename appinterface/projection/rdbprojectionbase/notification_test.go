@@ -0,0 +1,24 @@
+package rdbprojectionbase_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+)
+
+var _ = Describe("ParseHeightNotification", func() {
+	It("should parse the projection Id and the height", func() {
+		projectionId, height, err := rdbprojectionbase.ParseHeightNotification("Validator:100")
+		Expect(err).To(BeNil())
+		Expect(projectionId).To(Equal("Validator"))
+		Expect(height).To(Equal(int64(100)))
+	})
+
+	It("should return error when the payload is malformed", func() {
+		_, _, err := rdbprojectionbase.ParseHeightNotification("100")
+		Expect(err).NotTo(BeNil())
+		_, _, err = rdbprojectionbase.ParseHeightNotification("Validator:")
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -96,3 +96,51 @@ func (impl *Store) GetLastHandledEventHeight(rdbHandle *rdb.Handle, projectionId
 
 	return primptr.Int64(lastHandledEventHeight), nil
 }
+
+// DeleteLastHandledEventHeight deletes the record of the projection, so that the projection handles
+// the events from the beginning again
+func (impl *Store) DeleteLastHandledEventHeight(rdbHandle *rdb.Handle, projectionId string) error {
+	sql, args, err := rdbHandle.StmtBuilder.Delete(
+		impl.table,
+	).Where("id = ?", projectionId).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building last handled event height deletion SQL: %v", err)
+	}
+
+	if _, err := rdbHandle.Exec(sql, args...); err != nil {
+		return fmt.Errorf("error executing last handled event height deletion SQL: %v", err)
+	}
+
+	return nil
+}
+
+// GetAllLastHandledEventHeights returns the last handled event heights of all projections keyed by
+// projection id
+func (impl *Store) GetAllLastHandledEventHeights(rdbHandle *rdb.Handle) (map[string]int64, error) {
+	sql, args, err := rdbHandle.StmtBuilder.Select(
+		"id", "last_handled_event_height",
+	).From(
+		impl.table,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building last handled event heights selection SQL: %v", err)
+	}
+
+	rowsResult, err := rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing last handled event heights selection SQL: %v", err)
+	}
+	defer rowsResult.Close()
+
+	heights := make(map[string]int64)
+	for rowsResult.Next() {
+		var projectionId string
+		var lastHandledEventHeight int64
+		if err := rowsResult.Scan(&projectionId, &lastHandledEventHeight); err != nil {
+			return nil, fmt.Errorf("error scanning last handled event height: %v", err)
+		}
+		heights[projectionId] = lastHandledEventHeight
+	}
+
+	return heights, nil
+}
@@ -0,0 +1,29 @@
+package view
+
+// Projections are the projection types whose view tables are read by the APIs, i.e. the table
+// owners of the enabled projections writing to the tables without prefix. The views of the other
+// projections have no table to read, so their routes and queries are not served. Nil serves the views
+// of all projections.
+type Projections []string
+
+// Serves returns true when the view tables of all the projection types are served
+func (projections Projections) Serves(projectionTypes ...string) bool {
+	if projections == nil {
+		return true
+	}
+	for _, projectionType := range projectionTypes {
+		if !projections.contains(projectionType) {
+			return false
+		}
+	}
+	return true
+}
+
+func (projections Projections) contains(projectionType string) bool {
+	for _, servedType := range projections {
+		if servedType == projectionType {
+			return true
+		}
+	}
+	return false
+}
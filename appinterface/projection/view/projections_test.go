@@ -0,0 +1,25 @@
+package view_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+)
+
+var _ = Describe("Projections", func() {
+	It("should serve the views of all projections when nil", func() {
+		var projections view.Projections
+
+		Expect(projections.Serves("Block", "NFT")).To(BeTrue())
+	})
+
+	It("should only serve the views of the listed projections", func() {
+		projections := view.Projections{"Block", "Transaction"}
+
+		Expect(projections.Serves("Block")).To(BeTrue())
+		Expect(projections.Serves("Block", "Transaction")).To(BeTrue())
+		Expect(projections.Serves("Block", "NFT")).To(BeFalse())
+		Expect(view.Projections{}.Serves("Block")).To(BeFalse())
+	})
+})
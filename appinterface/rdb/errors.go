@@ -16,4 +16,7 @@ var (
 
 	// when trying to scan a null row
 	ErrNoRows = errors.New("no rows in result set")
+
+	// when committing a transaction of a fenced connection without holding the lease
+	ErrLeaseNotHeld = errors.New("lease is not held")
 )
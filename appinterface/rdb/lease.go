@@ -0,0 +1,346 @@
+package rdb
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const DEFAULT_LEASE_TABLE = "leases"
+const DEFAULT_LEASE_TTL = 10 * time.Second
+
+// Lease times are taken from the database clock, so that the clocks of the processes do not matter
+const LEASE_NOW_EXPR = "(EXTRACT(EPOCH FROM now()) * 1000000000)::BIGINT"
+
+// now() is the start time of the transaction, so the lease is fenced with the actual current time
+const LEASE_CLOCK_NOW_EXPR = "(EXTRACT(EPOCH FROM clock_timestamp()) * 1000000000)::BIGINT"
+
+// Lease table should have the following schema
+// | Field       | Data Type | Constraint  |
+// | ----------- | --------- | ----------- |
+// | name        | VARCHAR   | PRIMARY KEY |
+// | holder      | VARCHAR   | NOT NULL    |
+// | acquired_at | BIGINT    | NOT NULL    |
+// | renewed_at  | BIGINT    | NOT NULL    |
+// | expires_at  | BIGINT    | NOT NULL    |
+
+// Lease elects one leader among the processes competing for the lease name. The holder renews the
+// lease every third of the TTL, and considers the lease lost when it has not renewed it for two
+// thirds of the TTL, before the lease expires in the database. The other processes stand by and take
+// over the lease once it expires, i.e. within the TTL after the holder dies.
+type Lease struct {
+	logger    applogger.Logger
+	rdbHandle *Handle
+
+	table  string
+	name   string
+	holder string
+	ttl    time.Duration
+
+	mutex sync.Mutex
+	cond  *sync.Cond
+	// Zero when the lease is not held
+	heldUntil time.Time
+}
+
+func NewLease(logger applogger.Logger, rdbHandle *Handle, name string, holder string) *Lease {
+	lease := &Lease{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "Lease",
+			"lease":  name,
+		}),
+		rdbHandle: rdbHandle,
+
+		table:  DEFAULT_LEASE_TABLE,
+		name:   name,
+		holder: holder,
+		ttl:    DEFAULT_LEASE_TTL,
+	}
+	lease.cond = sync.NewCond(&lease.mutex)
+	return lease
+}
+
+func (lease *Lease) WithTTL(ttl time.Duration) *Lease {
+	lease.ttl = ttl
+	return lease
+}
+
+func (lease *Lease) Name() string {
+	return lease.name
+}
+
+// RunInBackground keeps acquiring or renewing the lease until the process exits. The first attempt
+// is made before returning, so that an available lease is held right away.
+func (lease *Lease) RunInBackground() {
+	lease.renew()
+	go func() {
+		for {
+			<-time.After(lease.ttl / 3)
+			lease.renew()
+		}
+	}()
+}
+
+func (lease *Lease) renew() {
+	sentAt := time.Now()
+	acquired, err := lease.tryAcquire()
+	if err != nil {
+		// The lease is kept until it runs out, as the database may still consider it held
+		lease.logger.Errorf("error renewing lease: %v", err)
+		return
+	}
+
+	lease.mutex.Lock()
+	defer lease.mutex.Unlock()
+
+	wasHeld := lease.isHeldLocked()
+	if !acquired {
+		lease.heldUntil = time.Time{}
+		if wasHeld {
+			lease.logger.Errorf("lost lease to another holder")
+		}
+		return
+	}
+
+	lease.heldUntil = sentAt.Add(lease.ttl * 2 / 3)
+	if !wasHeld {
+		lease.logger.Infof("acquired lease as %s", lease.holder)
+		lease.cond.Broadcast()
+	}
+}
+
+// tryAcquire acquires the lease when it is expired, or renews it when it is held by the holder
+func (lease *Lease) tryAcquire() (bool, error) {
+	sql, args, err := lease.rdbHandle.StmtBuilder.Insert(
+		lease.table,
+	).Columns(
+		"name",
+		"holder",
+		"acquired_at",
+		"renewed_at",
+		"expires_at",
+	).Values(
+		lease.name,
+		lease.holder,
+		sq.Expr(LEASE_NOW_EXPR),
+		sq.Expr(LEASE_NOW_EXPR),
+		sq.Expr(fmt.Sprintf("%s + ?", LEASE_NOW_EXPR), lease.ttl.Nanoseconds()),
+	).Suffix(fmt.Sprintf(`ON CONFLICT (name) DO UPDATE SET
+		holder = EXCLUDED.holder,
+		acquired_at = CASE
+			WHEN %[1]s.holder = EXCLUDED.holder AND %[1]s.expires_at >= EXCLUDED.renewed_at THEN %[1]s.acquired_at
+			ELSE EXCLUDED.acquired_at
+		END,
+		renewed_at = EXCLUDED.renewed_at,
+		expires_at = EXCLUDED.expires_at
+		WHERE %[1]s.holder = EXCLUDED.holder OR %[1]s.expires_at < EXCLUDED.renewed_at`, lease.table),
+	).ToSql()
+	if err != nil {
+		return false, fmt.Errorf("error building lease upsertion SQL: %v", err)
+	}
+
+	execResult, err := lease.rdbHandle.Exec(sql, args...)
+	if err != nil {
+		return false, fmt.Errorf("error upserting lease: %v", err)
+	}
+
+	return execResult.RowsAffected() == 1, nil
+}
+
+// IsHeld returns true when the lease is held by the process and has not run out
+func (lease *Lease) IsHeld() bool {
+	lease.mutex.Lock()
+	defer lease.mutex.Unlock()
+
+	return lease.isHeldLocked()
+}
+
+func (lease *Lease) isHeldLocked() bool {
+	return !lease.heldUntil.IsZero() && time.Now().Before(lease.heldUntil)
+}
+
+// WaitUntilHeld blocks until the process holds the lease
+func (lease *Lease) WaitUntilHeld() {
+	lease.mutex.Lock()
+	defer lease.mutex.Unlock()
+
+	for !lease.isHeldLocked() {
+		lease.cond.Wait()
+	}
+}
+
+// Fence returns the connection whose transactions only commit while the process holds the lease in
+// the database. The lease row is locked by the check before committing, so that no other process
+// can take over the lease until the transaction ends. A transaction outliving the lease, e.g. a slow
+// batch, is rolled back with ErrLeaseNotHeld instead of writing along with the new holder.
+func (lease *Lease) Fence(conn Conn) Conn {
+	return &fencedConn{
+		conn:  conn,
+		lease: lease,
+	}
+}
+
+// checkHeldWithRDbHandle locks the lease row when the lease is held by the holder and has not
+// expired, and returns ErrLeaseNotHeld otherwise
+func (lease *Lease) checkHeldWithRDbHandle(rdbHandle *Handle) error {
+	sql, args, err := rdbHandle.StmtBuilder.Update(
+		lease.table,
+	).Set(
+		"holder", sq.Expr("holder"),
+	).Where(
+		fmt.Sprintf("name = ? AND holder = ? AND expires_at > %s", LEASE_CLOCK_NOW_EXPR),
+		lease.name, lease.holder,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building lease check SQL: %v: %w", err, ErrBuildSQLStmt)
+	}
+
+	execResult, err := rdbHandle.Exec(sql, args...)
+	if err != nil {
+		return fmt.Errorf("error checking lease: %v: %w", err, ErrWrite)
+	}
+	if execResult.RowsAffected() == 0 {
+		lease.mutex.Lock()
+		lease.heldUntil = time.Time{}
+		lease.mutex.Unlock()
+
+		return fmt.Errorf("error checking lease %s: %w", lease.name, ErrLeaseNotHeld)
+	}
+
+	return nil
+}
+
+// fencedConn checks the lease before committing each transaction. Exec outside of a transaction is
+// run in a transaction of its own. Queries are not fenced.
+type fencedConn struct {
+	conn  Conn
+	lease *Lease
+}
+
+func (conn *fencedConn) Begin() (Tx, error) {
+	tx, err := conn.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &fencedTx{
+		Tx:    tx,
+		lease: conn.lease,
+	}, nil
+}
+
+func (conn *fencedConn) Exec(sql string, args ...interface{}) (ExecResult, error) {
+	tx, err := conn.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	execResult, err := tx.Exec(sql, args...)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return execResult, nil
+}
+
+func (conn *fencedConn) Query(sql string, args ...interface{}) (RowsResult, error) {
+	return conn.conn.Query(sql, args...)
+}
+
+func (conn *fencedConn) QueryRow(sql string, args ...interface{}) RowResult {
+	return conn.conn.QueryRow(sql, args...)
+}
+
+func (conn *fencedConn) ToHandle() *Handle {
+	handle := conn.conn.ToHandle()
+	return &Handle{
+		Runner:      conn,
+		TypeConv:    handle.TypeConv,
+		StmtBuilder: handle.StmtBuilder,
+	}
+}
+
+type fencedTx struct {
+	Tx
+
+	lease *Lease
+}
+
+func (tx *fencedTx) Commit() error {
+	if err := tx.lease.checkHeldWithRDbHandle(tx.Tx.ToHandle()); err != nil {
+		_ = tx.Tx.Rollback()
+		return err
+	}
+
+	return tx.Tx.Commit()
+}
+
+// LeaseState is the state of a lease in the database
+type LeaseState struct {
+	Name       string          `json:"name"`
+	Holder     string          `json:"holder"`
+	AcquiredAt utctime.UTCTime `json:"acquiredAt"`
+	RenewedAt  utctime.UTCTime `json:"renewedAt"`
+	ExpiresAt  utctime.UTCTime `json:"expiresAt"`
+	// An expired lease is taken over by the next process renewing it
+	IsExpired bool `json:"isExpired"`
+}
+
+// ListLeaseStates returns the states of all the leases in the table ordered by name
+func ListLeaseStates(rdbHandle *Handle, table string) ([]LeaseState, error) {
+	sql, args, err := rdbHandle.StmtBuilder.Select(
+		"name",
+		"holder",
+		"acquired_at",
+		"renewed_at",
+		"expires_at",
+		fmt.Sprintf("expires_at < %s", LEASE_NOW_EXPR),
+	).From(
+		table,
+	).OrderBy(
+		"name",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building lease states selection SQL: %v", err)
+	}
+
+	rowsResult, err := rdbHandle.Query(sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing lease states selection SQL: %v", err)
+	}
+	defer rowsResult.Close()
+
+	states := make([]LeaseState, 0)
+	for rowsResult.Next() {
+		var state LeaseState
+		var acquiredAt, renewedAt, expiresAt int64
+		if err := rowsResult.Scan(
+			&state.Name,
+			&state.Holder,
+			&acquiredAt,
+			&renewedAt,
+			&expiresAt,
+			&state.IsExpired,
+		); err != nil {
+			return nil, fmt.Errorf("error scanning lease state row: %v", err)
+		}
+		state.AcquiredAt = utctime.FromUnixNano(acquiredAt)
+		state.RenewedAt = utctime.FromUnixNano(renewedAt)
+		state.ExpiresAt = utctime.FromUnixNano(expiresAt)
+
+		states = append(states, state)
+	}
+	if err := rowsResult.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lease state rows: %v", err)
+	}
+
+	return states, nil
+}
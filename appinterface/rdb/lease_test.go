@@ -0,0 +1,120 @@
+package rdb_test
+
+import (
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("Lease", func() {
+	const anyTTL = 300 * time.Millisecond
+
+	var conn *MockRDbConn
+	var lease *rdb.Lease
+
+	mockUpsertResult := func(rowsAffected int64) *mock.Call {
+		execResult := &MockRDbExecResult{}
+		execResult.On("RowsAffected").Return(rowsAffected)
+		return conn.On("Exec", mock.Anything, "sync", "any-holder", anyTTL.Nanoseconds()).Return(execResult, nil)
+	}
+
+	BeforeEach(func() {
+		conn = NewMockRDBbConn()
+		lease = rdb.NewLease(NewFakeLogger(), &rdb.Handle{
+			Runner:      conn,
+			StmtBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+		}, "sync", "any-holder").WithTTL(anyTTL)
+	})
+
+	It("should be held once the upsertion succeeds", func() {
+		mockUpsertResult(1)
+		Expect(lease.IsHeld()).To(BeFalse())
+
+		lease.RunInBackground()
+		lease.WaitUntilHeld()
+
+		Expect(lease.IsHeld()).To(BeTrue())
+		Consistently(lease.IsHeld, anyTTL).Should(BeTrue())
+	})
+
+	It("should stand by while another process holds the lease", func() {
+		mockUpsertResult(0)
+
+		lease.RunInBackground()
+
+		Consistently(lease.IsHeld, anyTTL).Should(BeFalse())
+		conn.AssertCalled(GinkgoT(), "Exec", mock.Anything, "sync", "any-holder", anyTTL.Nanoseconds())
+	})
+
+	It("should run out before the lease expires when it cannot be renewed", func() {
+		mockUpsertResult(1).Once()
+		conn.On(
+			"Exec", mock.Anything, "sync", "any-holder", anyTTL.Nanoseconds(),
+		).Return(nil, errors.New("connection refused"))
+
+		lease.RunInBackground()
+		lease.WaitUntilHeld()
+		heldAt := time.Now()
+
+		Eventually(lease.IsHeld, anyTTL).Should(BeFalse())
+		Expect(time.Since(heldAt)).To(BeNumerically("<", anyTTL))
+	})
+
+	Describe("Fence", func() {
+		var tx *MockRDbTx
+
+		mockCheckResult := func(rowsAffected int64) {
+			execResult := &MockRDbExecResult{}
+			execResult.On("RowsAffected").Return(rowsAffected)
+			tx.On("Exec", mock.Anything, "sync", "any-holder").Return(execResult, nil)
+		}
+
+		BeforeEach(func() {
+			tx = &MockRDbTx{}
+			tx.On("ToHandle").Return(&rdb.Handle{
+				Runner:      tx,
+				StmtBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+			})
+			conn.On("Begin").Return(tx, nil)
+		})
+
+		It("should commit the transaction when the lease is held", func() {
+			mockCheckResult(1)
+			tx.On("Commit").Return(nil)
+
+			fencedTx, err := lease.Fence(conn).Begin()
+			Expect(err).To(BeNil())
+			Expect(fencedTx.Commit()).To(Succeed())
+
+			tx.AssertCalled(GinkgoT(), "Commit")
+			tx.AssertNotCalled(GinkgoT(), "Rollback")
+		})
+
+		It("should roll back the transaction when the lease is not held", func() {
+			// The lease is not renewed again during the test
+			upsertResult := &MockRDbExecResult{}
+			upsertResult.On("RowsAffected").Return(int64(1))
+			conn.On("Exec", mock.Anything, "sync", "any-holder", time.Hour.Nanoseconds()).Return(upsertResult, nil)
+			lease.WithTTL(time.Hour).RunInBackground()
+			lease.WaitUntilHeld()
+			mockCheckResult(0)
+			tx.On("Rollback").Return(nil)
+
+			fencedTx, err := lease.Fence(conn).Begin()
+			Expect(err).To(BeNil())
+			Expect(errors.Is(fencedTx.Commit(), rdb.ErrLeaseNotHeld)).To(BeTrue())
+
+			tx.AssertCalled(GinkgoT(), "Rollback")
+			tx.AssertNotCalled(GinkgoT(), "Commit")
+			Expect(lease.IsHeld()).To(BeFalse())
+		})
+	})
+})
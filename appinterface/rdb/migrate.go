@@ -17,3 +17,13 @@ type Migrate interface {
 	// database is at dirty state
 	Version() (version uint, dirty bool, err error)
 }
+
+// Migration is a schema migration declared in code, e.g. one of the migrations owned by a projection.
+// Migrations are applied in ascending order of version.
+type Migration struct {
+	Version uint
+	// Short description of the migration, e.g. `view_blocks`
+	Name string
+	Up   string
+	Down string
+}
@@ -0,0 +1,245 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/apikey"
+)
+
+const API_KEY_TIME_LAYOUT = "2006-01-02 15:04:05"
+
+// apiKeyCommand is the admin command to manage the API keys used by the rate limit middleware
+func apiKeyCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "apikey",
+		Usage: "Manage API keys of the HTTP API",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create an API key. The key is only shown once.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "name",
+						Usage:    "Name of the API key owner",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "tier",
+						Usage:    "Rate limit tier of the API key, one of [rate_limit.tiers]",
+						Required: true,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					if err = validateTier(config, ctx.String("tier")); err != nil {
+						return err
+					}
+					store, err := setupAPIKeyStore(config)
+					if err != nil {
+						return err
+					}
+
+					apiKey, key, err := store.Create(ctx.String("name"), ctx.String("tier"))
+					if err != nil {
+						return fmt.Errorf("error creating API key: %v", err)
+					}
+
+					fmt.Printf("Created API key %d (%s) of tier %s\n", apiKey.Id, apiKey.Name, apiKey.Tier)
+					fmt.Printf("Key: %s\n", key)
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List API keys",
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					store, err := setupAPIKeyStore(config)
+					if err != nil {
+						return err
+					}
+
+					apiKeys, err := store.List()
+					if err != nil {
+						return fmt.Errorf("error listing API keys: %v", err)
+					}
+
+					writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+					fmt.Fprintln(writer, "ID\tNAME\tPREFIX\tTIER\tCREATED AT\tREVOKED AT")
+					for _, apiKey := range apiKeys {
+						revokedAt := "-"
+						if apiKey.MaybeRevokedAt != nil {
+							revokedAt = formatAPIKeyTime(apiKey.MaybeRevokedAt.UnixNano())
+						}
+						fmt.Fprintf(
+							writer, "%d\t%s\t%s\t%s\t%s\t%s\n",
+							apiKey.Id,
+							apiKey.Name,
+							apiKey.KeyPrefix,
+							apiKey.Tier,
+							formatAPIKeyTime(apiKey.CreatedAt.UnixNano()),
+							revokedAt,
+						)
+					}
+					return writer.Flush()
+				},
+			},
+			{
+				Name:      "set-tier",
+				Usage:     "Change the rate limit tier of an API key",
+				ArgsUsage: "ID",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "tier",
+						Usage:    "Rate limit tier of the API key, one of [rate_limit.tiers]",
+						Required: true,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					id, err := apiKeyIdArg(ctx)
+					if err != nil {
+						return err
+					}
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					if err = validateTier(config, ctx.String("tier")); err != nil {
+						return err
+					}
+					store, err := setupAPIKeyStore(config)
+					if err != nil {
+						return err
+					}
+
+					if err = store.SetTier(id, ctx.String("tier")); err != nil {
+						if errors.Is(err, rdb.ErrNoRows) {
+							return fmt.Errorf("API key %d not found", id)
+						}
+						return fmt.Errorf("error updating API key tier: %v", err)
+					}
+
+					fmt.Printf("Updated API key %d to tier %s\n", id, ctx.String("tier"))
+					return nil
+				},
+			},
+			{
+				Name:      "revoke",
+				Usage:     "Revoke an API key",
+				ArgsUsage: "ID",
+				Action: func(ctx *cli.Context) error {
+					id, err := apiKeyIdArg(ctx)
+					if err != nil {
+						return err
+					}
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					store, err := setupAPIKeyStore(config)
+					if err != nil {
+						return err
+					}
+
+					if _, err = store.FindById(id); err != nil {
+						if errors.Is(err, rdb.ErrNoRows) {
+							return fmt.Errorf("API key %d not found", id)
+						}
+						return fmt.Errorf("error finding API key: %v", err)
+					}
+					if err = store.Revoke(id); err != nil {
+						return fmt.Errorf("error revoking API key: %v", err)
+					}
+
+					fmt.Printf("Revoked API key %d\n", id)
+					return nil
+				},
+			},
+			{
+				Name:      "usage",
+				Usage:     "Show daily request counters of an API key",
+				ArgsUsage: "ID",
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:  "days",
+						Usage: "Number of days to show",
+						Value: 30,
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					id, err := apiKeyIdArg(ctx)
+					if err != nil {
+						return err
+					}
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					store, err := setupAPIKeyStore(config)
+					if err != nil {
+						return err
+					}
+
+					sinceDate := time.Now().UTC().AddDate(0, 0, 1-ctx.Int("days")).Format(apikey.USAGE_DATE_LAYOUT)
+					usages, err := store.ListUsages(id, sinceDate)
+					if err != nil {
+						return fmt.Errorf("error listing API key usages: %v", err)
+					}
+
+					writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+					fmt.Fprintln(writer, "DATE\tREQUESTS\tREJECTED")
+					for _, usage := range usages {
+						fmt.Fprintf(writer, "%s\t%d\t%d\n", usage.Date, usage.RequestCount, usage.RejectedCount)
+					}
+					return writer.Flush()
+				},
+			},
+		},
+	}
+}
+
+func setupAPIKeyStore(config *Config) (*apikey.Store, error) {
+	rdbConn, err := SetupRDbConn(config, newLogger(config))
+	if err != nil {
+		return nil, fmt.Errorf("error setting up RDb connection: %v", err)
+	}
+
+	return apikey.NewStore(rdbConn.ToHandle()), nil
+}
+
+func validateTier(config *Config, tier string) error {
+	if _, ok := config.RateLimit.Tiers[tier]; !ok {
+		return fmt.Errorf("unknown tier %s: tiers are configured in [rate_limit.tiers]", tier)
+	}
+	return nil
+}
+
+func apiKeyIdArg(ctx *cli.Context) (int64, error) {
+	if ctx.Args().Len() != 1 {
+		return 0, errors.New("expected exactly one argument: API key ID")
+	}
+
+	id, err := strconv.ParseInt(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid API key ID %s: %v", ctx.Args().First(), err)
+	}
+	return id, nil
+}
+
+func formatAPIKeyTime(unixNano int64) string {
+	return time.Unix(0, unixNano).UTC().Format(API_KEY_TIME_LAYOUT)
+}
@@ -0,0 +1,225 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+
+	"github.com/crypto-com/chain-indexing/infrastructure"
+
+	"github.com/crypto-com/chain-indexing/internal/filereader/toml"
+	"github.com/crypto-com/chain-indexing/projection"
+	"github.com/urfave/cli/v2"
+)
+
+const SYSTEM_MODE_EVENT_STORE = "EVENT_STORE"
+const SYSTEM_MODE_TENDERMINT_DIRECT = "TENDERMINT_DIRECT"
+
+// App is the chain-indexing CLI. A custom binary creates it, registers the projections and HTTP
+// routes outside of this repository to it, and runs it in place of CliApp.
+type App struct {
+	projectionRegistry *projection.Registry
+	routeFactories     []RouteFactory
+}
+
+// NewApp creates the CLI with the projections of this repository registered
+func NewApp() *App {
+	return &App{
+		projectionRegistry: projection.NewCoreRegistry(),
+		routeFactories:     make([]RouteFactory, 0),
+	}
+}
+
+// ProjectionRegistry returns the registry resolving the enabled projections
+func (app *App) ProjectionRegistry() *projection.Registry {
+	return app.projectionRegistry
+}
+
+// WithRoutes adds the HTTP routes created by the factory to the HTTP API server
+func (app *App) WithRoutes(factory RouteFactory) *App {
+	app.routeFactories = append(app.routeFactories, factory)
+	return app
+}
+
+// CliApp runs the CLI with the projections and HTTP routes of this repository only
+func CliApp(args []string) error {
+	return NewApp().Run(args)
+}
+
+func (app *App) Run(args []string) error {
+	cliApp := &cli.App{
+		Name:                 filepath.Base(args[0]),
+		Usage:                "Crypto.com Chain Indexing Service",
+		Version:              "v0.0.1",
+		Copyright:            "(c) 2020 Crypto.com",
+		EnableBashCompletion: true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "config",
+				Value: "./config/config.toml",
+				Usage: "TOML `FILE` to load configuration from",
+			},
+
+			&cli.StringFlag{
+				Name:  "logLevel,l",
+				Usage: "Log level (Allowed values: fatal,error,info,debug)",
+			},
+			&cli.BoolFlag{
+				Name:  "color",
+				Usage: "Display colored log",
+			},
+
+			&cli.BoolFlag{
+				Name:    "dbSSL",
+				Usage:   "Enable Postgres SSL mode",
+				EnvVars: []string{"DB_SSL"},
+			},
+			&cli.StringFlag{
+				Name:    "dbHost",
+				Usage:   "Postgres database hostname",
+				EnvVars: []string{"DB_HOST"},
+			},
+			&cli.StringFlag{
+				Name:    "dbReplicaHost",
+				Usage:   "Postgres read replica hostname",
+				EnvVars: []string{"DB_REPLICA_HOST"},
+			},
+			&cli.UintFlag{
+				Name:    "dbPort",
+				Usage:   "Postgres database port",
+				EnvVars: []string{"DB_PORT"},
+			},
+			&cli.StringFlag{
+				Name:    "dbUsername",
+				Usage:   "Postgres username",
+				EnvVars: []string{"DB_USERNAME"},
+			},
+			&cli.StringFlag{
+				Name:     "dbPassword",
+				Usage:    "Postgres password",
+				EnvVars:  []string{"DB_PASSWORD"},
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:    "dbName",
+				Usage:   "Postgres database name",
+				EnvVars: []string{"DB_NAME"},
+			},
+			&cli.StringFlag{
+				Name:    "dbSchema",
+				Usage:   "Postgres schema name",
+				EnvVars: []string{"DB_SCHEMA"},
+			},
+
+			&cli.StringFlag{
+				Name:    "blobStoreS3AccessKeyID",
+				Usage:   "Access key ID of the S3 blob store",
+				EnvVars: []string{"BLOB_STORE_S3_ACCESS_KEY_ID"},
+			},
+			&cli.StringFlag{
+				Name:    "blobStoreS3SecretAccessKey",
+				Usage:   "Secret access key of the S3 blob store",
+				EnvVars: []string{"BLOB_STORE_S3_SECRET_ACCESS_KEY"},
+			},
+
+			&cli.StringFlag{
+				Name:    "tendermintURL",
+				Usage:   "Tendermint HTTP RPC URL",
+				EnvVars: []string{"TENDERMINT_URL"},
+			},
+			&cli.StringFlag{
+				Name:    "cosmosAppURL",
+				Usage:   " Cosmos App RPC URL",
+				EnvVars: []string{"COSMOSAPP_URL"},
+			},
+		},
+		Commands: append(app.roleCommands(),
+			apiKeyCommand(),
+			eventsCommand(),
+			app.migrateCommand(),
+		),
+		Action: func(ctx *cli.Context) error {
+			return app.runRole(ctx, ROLE_ALL)
+		},
+	}
+
+	err := cliApp.Run(args)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// loadConfig reads the config file and overrides it by the CLI flags and environment variables
+func loadConfig(ctx *cli.Context) (*Config, error) {
+	configPath := ctx.String("config")
+	configReader, configFileErr := toml.FromFile(configPath)
+	if configFileErr != nil {
+		return nil, configFileErr
+	}
+	var fileConfig FileConfig
+	readConfigErr := configReader.Read(&fileConfig)
+	if readConfigErr != nil {
+		return nil, readConfigErr
+	}
+
+	cliConfig := CLIConfig{
+		LogLevel: ctx.String("logLevel"),
+
+		DatabaseHost:        ctx.String("dbHost"),
+		DatabaseReplicaHost: ctx.String("dbReplicaHost"),
+		DatabaseUsername:    ctx.String("dbUsername"),
+		DatabasePassword:    ctx.String("dbPassword"),
+		DatabaseName:        ctx.String("dbName"),
+		DatabaseSchema:      ctx.String("dbSchema"),
+
+		BlobStoreS3AccessKeyID:     ctx.String("blobStoreS3AccessKeyID"),
+		BlobStoreS3SecretAccessKey: ctx.String("blobStoreS3SecretAccessKey"),
+
+		TendermintHTTPRPCUrl: ctx.String("tendermintURL"),
+		CosmosHTTPRPCUrl:     ctx.String("cosmosAppURL"),
+	}
+	if ctx.IsSet("color") {
+		cliConfig.LoggerColor = primptr.Bool(ctx.Bool("color"))
+	}
+	if ctx.IsSet("dbSSL") {
+		cliConfig.DatabaseSSL = primptr.Bool(ctx.Bool("dbSSL"))
+	}
+	if ctx.IsSet("dgPort") {
+		cliConfig.DatabasePort = primptr.Int32(int32(ctx.Int("dbPort")))
+	}
+
+	config := Config{
+		fileConfig,
+	}
+	config.OverrideByCLIConfig(&cliConfig)
+
+	return &config, nil
+}
+
+func newLogger(config *Config) applogger.Logger {
+	logLevel := parseLogLevel(config.Logger.Level)
+	logger := infrastructure.NewZerologLogger(os.Stdout)
+	logger.SetLogLevel(logLevel)
+
+	return logger
+}
+
+func parseLogLevel(level string) applogger.LogLevel {
+	switch level {
+	case "panic":
+		return applogger.LOG_LEVEL_PANIC
+	case "error":
+		return applogger.LOG_LEVEL_ERROR
+	case "info":
+		return applogger.LOG_LEVEL_INFO
+	case "debug":
+		return applogger.LOG_LEVEL_DEBUG
+	default:
+		panic("Unsupported log level: " + level)
+	}
+}
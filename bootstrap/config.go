@@ -0,0 +1,293 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/ratelimit"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+)
+
+type Config struct {
+	FileConfig
+}
+
+func (config *Config) OverrideByCLIConfig(cliConfig *CLIConfig) {
+	if cliConfig.LogLevel != "" {
+		config.Logger.Level = cliConfig.LogLevel
+	}
+	if cliConfig.LoggerColor != nil {
+		config.Logger.Color = *cliConfig.LoggerColor
+	}
+	if cliConfig.DatabaseSSL != nil {
+		config.Database.SSL = *cliConfig.DatabaseSSL
+	}
+	if cliConfig.DatabaseHost != "" {
+		config.Database.Host = cliConfig.DatabaseHost
+	}
+	if cliConfig.DatabaseReplicaHost != "" {
+		config.Database.ReplicaHost = cliConfig.DatabaseReplicaHost
+	}
+	if cliConfig.DatabasePort != nil {
+		config.Database.Port = *cliConfig.DatabasePort
+	}
+	if cliConfig.DatabaseUsername != "" {
+		config.Database.Username = cliConfig.DatabaseUsername
+	}
+	// Always overwrite database password with CLI (ENV)
+	config.Database.Password = cliConfig.DatabasePassword
+	if cliConfig.DatabaseName != "" {
+		config.Database.Name = cliConfig.DatabaseName
+	}
+	if cliConfig.DatabaseSchema != "" {
+		config.Database.Schema = cliConfig.DatabaseSchema
+	}
+	if cliConfig.BlobStoreS3AccessKeyID != "" {
+		config.BlobStore.S3AccessKeyID = cliConfig.BlobStoreS3AccessKeyID
+	}
+	if cliConfig.BlobStoreS3SecretAccessKey != "" {
+		config.BlobStore.S3SecretAccessKey = cliConfig.BlobStoreS3SecretAccessKey
+	}
+	if cliConfig.TendermintHTTPRPCUrl != "" {
+		config.Tendermint.HTTPRPCUrl = cliConfig.TendermintHTTPRPCUrl
+	}
+	if cliConfig.CosmosHTTPRPCUrl != "" {
+		config.CosmosApp.HTTPRPCUrl = cliConfig.CosmosHTTPRPCUrl
+	}
+}
+
+type CLIConfig struct {
+	LoggerColor *bool
+	LogLevel    string
+
+	DatabaseSSL         *bool
+	DatabaseHost        string
+	DatabaseReplicaHost string
+	DatabasePort        *int32
+	DatabaseUsername    string
+	DatabasePassword    string
+	DatabaseName        string
+	DatabaseSchema      string
+
+	BlobStoreS3AccessKeyID     string
+	BlobStoreS3SecretAccessKey string
+
+	TendermintHTTPRPCUrl string
+	CosmosHTTPRPCUrl     string
+}
+
+// FileConfig is the struct matches config.toml
+type FileConfig struct {
+	Blockchain BlockchainConfig
+	System     SystemConfig
+	Sync       SyncConfig
+	// Leader election between the replicas running the sync and the projections
+	LeaderElection LeaderElectionConfig `toml:"leader_election"`
+	EventStore     EventStoreConfig     `toml:"event_store"`
+	BlobStore      BlobStoreConfig      `toml:"blob_store"`
+	Tendermint     TendermintConfig
+	CosmosApp      CosmosAppConfig `toml:"cosmosapp"`
+	HTTP           HTTPConfig
+	Debug          DebugConfig
+	Database       DatabaseConfig
+	Postgres       PostgresConfig
+	Logger         LoggerConfig
+	Projection     ProjectionConfig
+	Supply         SupplyConfig
+	GraphQL        GraphQLConfig   `toml:"graphql"`
+	GRPC           GRPCConfig      `toml:"grpc"`
+	RateLimit      RateLimitConfig `toml:"rate_limit"`
+	HTTPCache      HTTPCacheConfig `toml:"http_cache"`
+}
+
+type BlockchainConfig struct {
+	BondingDenom           string `toml:"bonding_denom"`
+	AccountAddressPrefix   string `toml:"account_address_prefix"`
+	AccountPubKeyPrefix    string `toml:"account_pubkey_prefix"`
+	ValidatorAddressPrefix string `toml:"validator_address_prefix"`
+	ValidatorPubKeyPrefix  string `toml:"validator_pubkey_prefix"`
+	ConNodeAddressPrefix   string `toml:"connode_address_prefix"`
+	ConNodePubKeyPrefix    string `toml:"connode_pubkey_prefix"`
+}
+
+type SystemConfig struct {
+	Mode string `toml:"mode"`
+}
+
+type SyncConfig struct {
+	WindowSize int `toml:"window_size"`
+}
+
+type LeaderElectionConfig struct {
+	// Duration string, the default TTL is used when empty
+	LeaseTTL string `toml:"lease_ttl"`
+}
+
+type EventStoreConfig struct {
+	PartitionSize int64  `toml:"partition_size"`
+	ArchiveDir    string `toml:"archive_dir"`
+}
+
+type BlobStoreConfig struct {
+	// "fs" or "s3", the blob store is disabled when empty
+	Type           string `toml:"type"`
+	MinPayloadSize int    `toml:"min_payload_size"`
+	FSDir          string `toml:"fs_dir"`
+	S3Endpoint     string `toml:"s3_endpoint"`
+	S3Region       string `toml:"s3_region"`
+	S3Bucket       string `toml:"s3_bucket"`
+	S3Prefix       string `toml:"s3_prefix"`
+	// Provided by CLI (ENV) only
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
+type HTTPConfig struct {
+	ListeningAddress   string   `toml:"listening_address"`
+	RoutePrefix        string   `toml:"route_prefix"`
+	CorsAllowedOrigins []string `toml:"cors_allowed_origins"`
+	CorsAllowedMethods []string `toml:"cors_allowed_methods"`
+	CorsAllowedHeaders []string `toml:"cors_allowed_headers"`
+}
+
+type DebugConfig struct {
+	PprofEnable           bool   `toml:"pprof_enable"`
+	PprofListeningAddress string `toml:"pprof_listening_address"`
+}
+
+type TendermintConfig struct {
+	HTTPRPCUrl           string `toml:"http_rpc_url"`
+	Insecure             bool   `toml:"insecure"`
+	StrictGenesisParsing bool   `toml:"strict_genesis_parsing"`
+}
+
+type CosmosAppConfig struct {
+	HTTPRPCUrl string `toml:"http_rpc_url"`
+	Insecure   bool   `toml:"insecure"`
+}
+
+type DatabaseConfig struct {
+	SSL      bool   `toml:"ssl"`
+	Host     string `toml:"host"`
+	Port     int32  `toml:"port"`
+	Username string `toml:"username"`
+	Password string
+	Name     string `toml:"name"`
+	Schema   string `toml:"schema"`
+	// Optional read replica serving the API queries. It shares the credentials of the primary.
+	ReplicaHost string `toml:"replica_host"`
+	ReplicaPort int32  `toml:"replica_port"`
+}
+
+type PostgresConfig struct {
+	MaxConns            int32  `toml:"pool_max_conns"`
+	MinConns            int32  `toml:"pool_min_conns"`
+	MaxConnLifeTime     string `toml:"pool_max_conn_lifetime"`
+	MaxConnIdleTime     string `toml:"pool_max_conn_idle_time"`
+	HealthCheckInterval string `toml:"pool_health_check_interval"`
+	// API queries fall back to the primary when the replica lags behind the projection heights of
+	// the primary by more than this number of blocks
+	ReplicaMaxLag           int64  `toml:"replica_max_lag"`
+	ReplicaLagCheckInterval string `toml:"replica_lag_check_interval"`
+}
+
+type LoggerConfig struct {
+	Level string `toml:"level"`
+	Color bool   `toml:"color"`
+}
+
+type ProjectionConfig struct {
+	Enables                  []string `toml:"enables"`
+	ReadBatchSize            int64    `toml:"read_batch_size"`
+	ReadBufferSize           int      `toml:"read_buffer_size"`
+	MaxHeightsPerTransaction int      `toml:"max_heights_per_transaction"`
+	// The `[projection.config.<Id>]` sections, decoded by the projection types
+	Config map[string]map[string]interface{} `toml:"config"`
+}
+
+type GraphQLConfig struct {
+	Enable bool `toml:"enable"`
+	// Queries exceeding the limits are rejected before execution. Zero means unlimited.
+	MaxDepth      int `toml:"max_depth"`
+	MaxComplexity int `toml:"max_complexity"`
+}
+
+type GRPCConfig struct {
+	Enable           bool   `toml:"enable"`
+	ListeningAddress string `toml:"listening_address"`
+	// Interval to check for new blocks for block subscriptions, e.g. "1s"
+	SubscriptionPollInterval string `toml:"subscription_poll_interval"`
+}
+
+type HTTPCacheConfig struct {
+	Enable     bool `toml:"enable"`
+	MaxEntries int  `toml:"max_entries"`
+	// Duration clients and CDNs may reuse a response without revalidation, e.g. "5s"
+	MaxAge string `toml:"max_age"`
+	// Interval to re-read the projection heights which invalidate the cached responses, e.g. "1s"
+	HeightRefreshInterval string `toml:"height_refresh_interval"`
+}
+
+type RateLimitConfig struct {
+	Enable bool `toml:"enable"`
+	// Reject requests without an API key. Otherwise they are limited per IP by the anonymous tier.
+	RequireAPIKey     bool   `toml:"require_api_key"`
+	APIKeyHeader      string `toml:"api_key_header"`
+	AnonymousTier     string `toml:"anonymous_tier"`
+	TrustForwardedFor bool   `toml:"trust_forwarded_for"`
+	// How long API key lookups are cached, e.g. "1m". Revocations take effect after it.
+	KeyCacheTTL string `toml:"key_cache_ttl"`
+	// Interval to write API key usage counters to the database, e.g. "1m"
+	UsageFlushInterval string                         `toml:"usage_flush_interval"`
+	Tiers              map[string]RateLimitTierConfig `toml:"tiers"`
+}
+
+type RateLimitTierConfig struct {
+	// Zero means unlimited
+	RequestsPerSecond float64 `toml:"requests_per_second"`
+	Burst             int     `toml:"burst"`
+}
+
+// RateLimitTiers returns the configured tiers keyed by name
+func (config *RateLimitConfig) RateLimitTiers() map[string]ratelimit.Tier {
+	tiers := make(map[string]ratelimit.Tier, len(config.Tiers))
+	for name, tierConfig := range config.Tiers {
+		tiers[name] = ratelimit.Tier{
+			Name:              name,
+			RequestsPerSecond: tierConfig.RequestsPerSecond,
+			Burst:             tierConfig.Burst,
+		}
+	}
+	return tiers
+}
+
+type SupplyConfig struct {
+	// Accounts excluded from circulating supply. Module accounts are specified by module names.
+	CirculatingExcludedAddresses []string `toml:"circulating_excluded_addresses"`
+	CirculatingExcludedModules   []string `toml:"circulating_excluded_modules"`
+}
+
+// CirculatingExcludedAccounts returns the list of account addresses excluded from circulating supply
+func (config *SupplyConfig) CirculatingExcludedAccounts(accountAddressPrefix string) ([]string, error) {
+	moduleAccounts := tmcosmosutils.NewModuleAccounts(accountAddressPrefix)
+	moduleAddresses := map[string]string{
+		"fee_collector":          moduleAccounts.FeeCollector,
+		"mint":                   moduleAccounts.Mint,
+		"distribution":           moduleAccounts.Distribution,
+		"gov":                    moduleAccounts.Gov,
+		"bonded_tokens_pool":     moduleAccounts.BondedTokensPool,
+		"not_bonded_tokens_pool": moduleAccounts.NotBondedTokensPool,
+		"transfer":               moduleAccounts.IBCTransfer,
+	}
+
+	accounts := make([]string, 0, len(config.CirculatingExcludedAddresses)+len(config.CirculatingExcludedModules))
+	accounts = append(accounts, config.CirculatingExcludedAddresses...)
+	for _, module := range config.CirculatingExcludedModules {
+		address, ok := moduleAddresses[module]
+		if !ok {
+			return nil, fmt.Errorf("unknown module account: %s", module)
+		}
+		accounts = append(accounts, address)
+	}
+
+	return accounts, nil
+}
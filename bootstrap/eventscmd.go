@@ -0,0 +1,207 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/urfave/cli/v2"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/infrastructure/blobstore"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+const BLOB_STORE_TYPE_FS = "fs"
+const BLOB_STORE_TYPE_S3 = "s3"
+
+// NewRDbEventStore creates the event store creating the height range partitions of the events table,
+// and reading the archived partitions back from the archive directory. Large payloads are moved to
+// the blob store when it is enabled, and the registry is set up to resolve them.
+func NewRDbEventStore(
+	rdbConn rdb.Conn,
+	registry *event.Registry,
+	config EventStoreConfig,
+	blobStoreConfig BlobStoreConfig,
+) (*event_interface.RDbStore, error) {
+	eventStore := event_interface.NewRDbStore(rdbConn.ToHandle(), registry).WithPartitioner(
+		event_interface.NewRDbPartitioner(event_interface.DEFAULT_TABLE, config.PartitionSize),
+	)
+	if config.ArchiveDir != "" {
+		archive, err := event_interface.NewFileArchive(config.ArchiveDir, event_interface.DEFAULT_TABLE)
+		if err != nil {
+			return nil, err
+		}
+		eventStore = eventStore.WithArchive(archive)
+	}
+
+	maybeBlobStore, err := NewBlobStore(blobStoreConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating blob store: %v", err)
+	}
+	if maybeBlobStore != nil {
+		registry.WithBlobStore(maybeBlobStore)
+		eventStore = eventStore.WithBlobStore(maybeBlobStore, blobStoreConfig.MinPayloadSize)
+	}
+
+	return eventStore, nil
+}
+
+// NewBlobStore creates the configured blob store, nil when it is disabled
+func NewBlobStore(config BlobStoreConfig) (event.BlobStore, error) {
+	switch config.Type {
+	case "":
+		return nil, nil
+	case BLOB_STORE_TYPE_FS:
+		fsBlobStore, err := blobstore.NewFSBlobStore(config.FSDir)
+		if err != nil {
+			return nil, err
+		}
+		return fsBlobStore, nil
+	case BLOB_STORE_TYPE_S3:
+		s3BlobStore, err := blobstore.NewS3BlobStore(blobstore.S3Config{
+			Endpoint:        config.S3Endpoint,
+			Region:          config.S3Region,
+			Bucket:          config.S3Bucket,
+			Prefix:          config.S3Prefix,
+			AccessKeyID:     config.S3AccessKeyID,
+			SecretAccessKey: config.S3SecretAccessKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s3BlobStore, nil
+	default:
+		return nil, fmt.Errorf("unsupported blob store type: %s", config.Type)
+	}
+}
+
+// eventsCommand is the admin command to archive the partitions of the events table
+func eventsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "events",
+		Usage: "Manage the partitions of the event store",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "partitions",
+				Usage: "List the partitions of the events table and the archived partitions",
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					rdbConn, err := SetupRDbConn(config, newLogger(config))
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+
+					partitioner := event_interface.NewRDbPartitioner(
+						event_interface.DEFAULT_TABLE, config.EventStore.PartitionSize,
+					)
+					partitions, err := partitioner.ListPartitions(rdbConn.ToHandle())
+					if err != nil {
+						return err
+					}
+					archiveFiles := make([]event_interface.ArchiveFile, 0)
+					if config.EventStore.ArchiveDir != "" {
+						archive, archiveErr := event_interface.NewFileArchive(
+							config.EventStore.ArchiveDir, event_interface.DEFAULT_TABLE,
+						)
+						if archiveErr != nil {
+							return archiveErr
+						}
+						archiveFiles = archive.Files()
+					}
+
+					writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+					fmt.Fprintln(writer, "FROM HEIGHT\tTO HEIGHT\tLOCATION")
+					for _, archiveFile := range archiveFiles {
+						fmt.Fprintf(
+							writer, "%d\t%d\t%s\n", archiveFile.FromHeight, archiveFile.ToHeight, archiveFile.Path,
+						)
+					}
+					for _, partition := range partitions {
+						fmt.Fprintf(
+							writer, "%d\t%d\ttable %s\n", partition.FromHeight, partition.ToHeight, partition.Name,
+						)
+					}
+					return writer.Flush()
+				},
+			},
+			{
+				Name: "archive",
+				Usage: "Archive the partitions of the events table below a height to the archive directory, then " +
+					"detach and drop them",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:     "before",
+						Usage:    "Archive the partitions whose heights are all below `HEIGHT`",
+						Required: true,
+					},
+					&cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "Only list the partitions to archive",
+					},
+				},
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					if config.EventStore.ArchiveDir == "" {
+						return errors.New("missing [event_store] archive_dir")
+					}
+					rdbConn, err := SetupRDbConn(config, newLogger(config))
+					if err != nil {
+						return fmt.Errorf("error setting up RDb connection: %v", err)
+					}
+
+					registry := event.NewRegistry()
+					event_usecase.RegisterEvents(registry)
+					latestHeight, err := event_interface.NewRDbStore(rdbConn.ToHandle(), registry).GetLatestHeight()
+					if err != nil {
+						return fmt.Errorf("error getting latest event height: %v", err)
+					}
+
+					partitioner := event_interface.NewRDbPartitioner(
+						event_interface.DEFAULT_TABLE, config.EventStore.PartitionSize,
+					)
+					partitions, err := partitioner.ListPartitions(rdbConn.ToHandle())
+					if err != nil {
+						return err
+					}
+
+					before := ctx.Int64("before")
+					for _, partition := range partitions {
+						if partition.ToHeight > before {
+							continue
+						}
+						// The partition receiving new events is never archived
+						if latestHeight == nil || partition.ToHeight > *latestHeight {
+							continue
+						}
+
+						if ctx.Bool("dry-run") {
+							fmt.Printf("Would archive partition %s\n", partition.Name)
+							continue
+						}
+						count, archiveErr := event_interface.ArchivePartition(
+							rdbConn, partitioner, config.EventStore.ArchiveDir, partition,
+						)
+						if archiveErr != nil {
+							return fmt.Errorf("error archiving partition %s: %v", partition.Name, archiveErr)
+						}
+						fmt.Printf(
+							"Archived partition %s with %d events to %s\n",
+							partition.Name, count, config.EventStore.ArchiveDir,
+						)
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
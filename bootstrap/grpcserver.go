@@ -0,0 +1,95 @@
+package bootstrap
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/services"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_SUBSCRIPTION_POLL_INTERVAL = time.Second
+
+type GRPCServer struct {
+	logger  applogger.Logger
+	rdbConn rdb.Conn
+
+	listeningAddress         string
+	subscriptionPollInterval string
+
+	// Nil serves the views of all projections
+	projections view.Projections
+}
+
+// NewGRPCServer creates a new gRPC server serving the same views as the HTTP API server
+func NewGRPCServer(logger applogger.Logger, rdbConn rdb.Conn, config *Config) *GRPCServer {
+	return &GRPCServer{
+		logger:  logger,
+		rdbConn: rdbConn,
+
+		listeningAddress:         config.GRPC.ListeningAddress,
+		subscriptionPollInterval: config.GRPC.SubscriptionPollInterval,
+	}
+}
+
+// WithProjections only registers the services and serves the methods reading the views of the
+// projections, whose tables are the ones migrated with the enabled projections
+func (server *GRPCServer) WithProjections(projections view.Projections) *GRPCServer {
+	server.projections = projections
+	return server
+}
+
+func (server *GRPCServer) Run() error {
+	subscriptionPollInterval := DEFAULT_SUBSCRIPTION_POLL_INTERVAL
+	if server.subscriptionPollInterval != "" {
+		var err error
+		subscriptionPollInterval, err = time.ParseDuration(server.subscriptionPollInterval)
+		if err != nil {
+			return fmt.Errorf("error parsing gRPC subscription poll interval: %v", err)
+		}
+	}
+
+	rdbHandle := server.rdbConn.ToHandle()
+	grpcServer := grpcapi.NewServer(
+		server.listeningAddress,
+		server.logger,
+	).Register(func(registrar *grpc.Server) {
+		if server.projections.Serves("Block") {
+			pb.RegisterBlockServiceServer(registrar, services.NewBlocks(
+				server.logger, rdbHandle, subscriptionPollInterval,
+			).WithProjections(server.projections))
+		}
+		if server.projections.Serves("Transaction") {
+			pb.RegisterTransactionServiceServer(registrar, services.NewTransactions(server.logger, rdbHandle))
+		}
+		if server.projections.Serves("Account") ||
+			server.projections.Serves("AccountTransaction") ||
+			server.projections.Serves("AccountMessage") {
+			pb.RegisterAccountServiceServer(registrar, services.NewAccounts(
+				server.logger, rdbHandle,
+			).WithProjections(server.projections))
+		}
+		if server.projections.Serves("Validator") {
+			pb.RegisterValidatorServiceServer(registrar, services.NewValidators(server.logger, rdbHandle))
+		}
+		if server.projections.Serves("Proposal") {
+			pb.RegisterProposalServiceServer(registrar, services.NewProposals(server.logger, rdbHandle))
+		}
+		if server.projections.Serves("NFT") {
+			pb.RegisterNFTServiceServer(registrar, services.NewNFTs(server.logger, rdbHandle))
+		}
+	})
+
+	server.logger.Infof("gRPC server start listening on: %s", server.listeningAddress)
+	if err := grpcServer.ListenAndServe(); err != nil {
+		return fmt.Errorf("error listening and serving gRPC server: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,412 @@
+package bootstrap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lab259/cors"
+
+	"github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/apikey"
+	cosmosapp_infrastructure "github.com/crypto-com/chain-indexing/infrastructure/cosmosapp"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/cache"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/graphqlapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/openapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/ratelimit"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/routes"
+	"github.com/crypto-com/chain-indexing/infrastructure/replica"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_KEY_CACHE_TTL = time.Minute
+const DEFAULT_USAGE_FLUSH_INTERVAL = time.Minute
+const DEFAULT_CACHE_MAX_ENTRIES = 10000
+const DEFAULT_CACHE_HEIGHT_REFRESH_INTERVAL = time.Second
+
+type HTTPAPIServer struct {
+	logger          applogger.Logger
+	rdbConn         rdb.Conn
+	cosmosAppClient cosmosapp.Client
+	// Optional. Nil when no read replica is configured.
+	maybeReplicaRouter *replica.Router
+
+	accountAddressPrefix   string
+	validatorAddressPrefix string
+	conNodeAddressPrefix   string
+
+	listeningAddress string
+	routePrefix      string
+
+	corsAllowedOrigins []string
+	corsAllowedMethods []string
+	corsAllowedHeaders []string
+
+	pprof DebugConfig
+
+	supply SupplyConfig
+
+	graphQL GraphQLConfig
+
+	rateLimit RateLimitConfig
+
+	httpCache HTTPCacheConfig
+
+	// Nil serves the views of all projections
+	projections view.Projections
+
+	routeFactories []RouteFactory
+}
+
+// RouteFactory creates HTTP routes served in addition to the routes of this repository
+type RouteFactory func(params RouteParams) ([]routes.ExtraRoute, error)
+
+// RouteParams are passed to a RouteFactory to create the handlers of its routes
+type RouteParams struct {
+	Logger applogger.Logger
+	// Serves the API queries, the read replica when it is configured
+	RDbConn         rdb.Conn
+	CosmosAppClient cosmosapp.Client
+
+	AccountAddressPrefix   string
+	ValidatorAddressPrefix string
+	ConNodeAddressPrefix   string
+}
+
+// NewHTTPAPIServer creates a new server instance serving the views through rdbConn, which is a
+// replica router when maybeReplicaRouter is not nil
+func NewHTTPAPIServer(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	maybeReplicaRouter *replica.Router,
+	config *Config,
+) *HTTPAPIServer {
+	var cosmosClient cosmosapp.Client
+	if config.CosmosApp.Insecure {
+		cosmosClient = cosmosapp_infrastructure.NewInsecureHTTPClient(
+			config.CosmosApp.HTTPRPCUrl,
+			config.Blockchain.BondingDenom,
+		)
+	} else {
+		cosmosClient = cosmosapp_infrastructure.NewHTTPClient(
+			config.CosmosApp.HTTPRPCUrl,
+			config.Blockchain.BondingDenom,
+		)
+	}
+	return &HTTPAPIServer{
+		logger:             logger,
+		rdbConn:            rdbConn,
+		cosmosAppClient:    cosmosClient,
+		maybeReplicaRouter: maybeReplicaRouter,
+
+		accountAddressPrefix:   config.Blockchain.AccountAddressPrefix,
+		validatorAddressPrefix: config.Blockchain.ValidatorAddressPrefix,
+		conNodeAddressPrefix:   config.Blockchain.ConNodeAddressPrefix,
+		listeningAddress:       config.HTTP.ListeningAddress,
+		routePrefix:            config.HTTP.RoutePrefix,
+
+		corsAllowedOrigins: config.HTTP.CorsAllowedOrigins,
+		corsAllowedMethods: config.HTTP.CorsAllowedMethods,
+		corsAllowedHeaders: config.HTTP.CorsAllowedHeaders,
+
+		pprof: config.Debug,
+
+		supply: config.Supply,
+
+		graphQL: config.GraphQL,
+
+		rateLimit: config.RateLimit,
+
+		httpCache: config.HTTPCache,
+
+		routeFactories: make([]RouteFactory, 0),
+	}
+}
+
+// WithProjections only serves the routes and the queries reading the views of the projections, whose
+// tables are the ones migrated with the enabled projections
+func (server *HTTPAPIServer) WithProjections(projections view.Projections) *HTTPAPIServer {
+	server.projections = projections
+	return server
+}
+
+// WithRoutes adds the routes created by the factories to the routes of this repository
+func (server *HTTPAPIServer) WithRoutes(routeFactories []RouteFactory) *HTTPAPIServer {
+	server.routeFactories = append(server.routeFactories, routeFactories...)
+	return server
+}
+
+// Run function runs the polling server to index the data from Tendermint
+func (server *HTTPAPIServer) Run() error {
+	httpServer := httpapi.NewServer(
+		server.listeningAddress,
+	).WithLogger(
+		server.logger,
+	)
+
+	if server.pprof.PprofEnable {
+		pprofServer := httpapi.NewServer(
+			server.pprof.PprofListeningAddress,
+		).WithLogger(
+			server.logger,
+		)
+		fixPath := "/debug/pprof"
+		pprofServer = pprofServer.WithPprof(fixPath)
+		go func() {
+			server.logger.Infof("pprof server start listening on: %s%s", server.pprof.PprofListeningAddress, fixPath)
+			if err := pprofServer.ListenAndServe(); err != nil {
+				panic(fmt.Errorf("error listening and serving HTTP pprof server: %w", err))
+			}
+		}()
+	}
+
+	if len(server.corsAllowedOrigins) != 0 {
+		httpServer = httpServer.WithCors(cors.Options{
+			AllowedOrigins: server.corsAllowedOrigins,
+			AllowedMethods: server.corsAllowedMethods,
+			AllowedHeaders: server.corsAllowedHeaders,
+			Debug:          true,
+		})
+	}
+
+	if server.rateLimit.Enable {
+		rateLimitMiddleware, err := server.setupRateLimit()
+		if err != nil {
+			return fmt.Errorf("error setting up rate limit: %v", err)
+		}
+		httpServer = httpServer.Use(rateLimitMiddleware.Handler)
+	}
+
+	searchHandler := handlers.NewSearch(
+		server.logger, server.rdbConn.ToHandle(),
+	).WithProjections(server.projections)
+	blocksHandler := handlers.NewBlocks(server.logger, server.rdbConn.ToHandle())
+	statusHandler := handlers.NewStatusHandler(server.logger, server.cosmosAppClient, server.rdbConn.ToHandle())
+	transactionsHandler := handlers.NewTransactions(server.logger, server.rdbConn.ToHandle())
+	blockEventsHandler := handlers.NewBlockEvents(server.logger, server.rdbConn.ToHandle())
+	validatorsHandler := handlers.NewValidators(
+		server.logger,
+		server.validatorAddressPrefix,
+		server.conNodeAddressPrefix,
+		server.cosmosAppClient,
+		server.rdbConn.ToHandle(),
+	)
+	accountTransactionsHandler := handlers.NewAccountTransactions(server.logger, server.rdbConn.ToHandle())
+	accountMessagesHandler := handlers.NewAccountMessages(server.logger, server.rdbConn.ToHandle())
+	accountsHandler := handlers.NewAccounts(
+		server.logger,
+		server.rdbConn.ToHandle(),
+		server.cosmosAppClient,
+		server.validatorAddressPrefix,
+	)
+	proposalsHandler := handlers.NewProposals(
+		server.logger,
+		server.rdbConn.ToHandle(),
+		server.cosmosAppClient,
+	)
+	nftsHandler := handlers.NewNFTs(
+		server.logger,
+		server.rdbConn.ToHandle(),
+	)
+	circulatingExcludedAddresses, err := server.supply.CirculatingExcludedAccounts(server.accountAddressPrefix)
+	if err != nil {
+		return fmt.Errorf("error resolving circulating supply excluded accounts: %v", err)
+	}
+	supplyHandler := handlers.NewSupply(
+		server.logger,
+		server.rdbConn.ToHandle(),
+		server.cosmosAppClient,
+		circulatingExcludedAddresses,
+	)
+	communityPoolHandler := handlers.NewCommunityPool(
+		server.logger,
+		server.rdbConn.ToHandle(),
+	)
+	vestingHandler := handlers.NewVesting(
+		server.logger,
+		server.rdbConn.ToHandle(),
+	)
+	multisigAccountsHandler := handlers.NewMultisigAccounts(
+		server.logger,
+		server.rdbConn.ToHandle(),
+	)
+	pubKeysHandler := handlers.NewPubKeys(
+		server.logger,
+		server.rdbConn.ToHandle(),
+		server.accountAddressPrefix,
+		server.validatorAddressPrefix,
+		server.conNodeAddressPrefix,
+	)
+	replicaHandler := handlers.NewReplica(server.logger, server.maybeReplicaRouter)
+	healthHandler := handlers.NewHealth(server.logger, server.rdbConn.ToHandle())
+	accountExportHandler := handlers.NewAccountExport(
+		server.logger,
+		server.rdbConn.ToHandle(),
+		server.accountAddressPrefix,
+	)
+	var maybeGraphQLHandler *handlers.GraphQL
+	if server.graphQL.Enable {
+		maybeGraphQLHandler, err = handlers.NewGraphQL(
+			server.logger,
+			server.rdbConn.ToHandle(),
+			server.validatorAddressPrefix,
+			server.projections,
+			graphqlapi.Limits{
+				MaxDepth:      server.graphQL.MaxDepth,
+				MaxComplexity: server.graphQL.MaxComplexity,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("error creating GraphQL handler: %v", err)
+		}
+	}
+
+	openAPIDocument := routes.NewOpenAPIDocument(
+		server.routePrefix, maybeGraphQLHandler != nil, server.projections,
+	)
+	openAPIHandler, err := handlers.NewOpenAPI(server.logger, openAPIDocument)
+	if err != nil {
+		return fmt.Errorf("error creating OpenAPI handler: %v", err)
+	}
+	if server.httpCache.Enable {
+		cacheMiddleware, cacheErr := server.setupHTTPCache()
+		if cacheErr != nil {
+			return fmt.Errorf("error setting up HTTP cache: %v", cacheErr)
+		}
+		// Registered before the validator so that only valid requests reach the cache
+		httpServer = httpServer.UseOnRoutes(cacheMiddleware.RouteMiddleware)
+	}
+	// Rejects invalid request parameters according to the specification before the handlers
+	httpServer = httpServer.UseOnRoutes(openapi.NewValidator(openAPIDocument, server.routePrefix).Middleware)
+
+	routeRegistry := routes.NewRoutesRegistry(
+		healthHandler,
+		searchHandler,
+		blocksHandler,
+		statusHandler,
+		transactionsHandler,
+		blockEventsHandler,
+		validatorsHandler,
+		accountTransactionsHandler,
+		accountMessagesHandler,
+		accountsHandler,
+		proposalsHandler,
+		nftsHandler,
+		supplyHandler,
+		communityPoolHandler,
+		vestingHandler,
+		multisigAccountsHandler,
+		pubKeysHandler,
+		accountExportHandler,
+		replicaHandler,
+		openAPIHandler,
+		maybeGraphQLHandler,
+	).WithProjections(server.projections)
+	for _, routeFactory := range server.routeFactories {
+		extraRoutes, routeErr := routeFactory(RouteParams{
+			Logger:          server.logger,
+			RDbConn:         server.rdbConn,
+			CosmosAppClient: server.cosmosAppClient,
+
+			AccountAddressPrefix:   server.accountAddressPrefix,
+			ValidatorAddressPrefix: server.validatorAddressPrefix,
+			ConNodeAddressPrefix:   server.conNodeAddressPrefix,
+		})
+		if routeErr != nil {
+			return fmt.Errorf("error creating extra routes: %v", routeErr)
+		}
+		routeRegistry = routeRegistry.WithExtraRoutes(extraRoutes)
+	}
+	routeRegistry.Register(httpServer, server.routePrefix)
+
+	server.logger.Infof("server start listening on: %s", server.listeningAddress)
+	if err := httpServer.ListenAndServe(); err != nil {
+		return fmt.Errorf("error listening and serving HTTP API server: %v", err)
+	}
+
+	return nil
+}
+
+// setupRateLimit creates the rate limit middleware and starts writing the API key usage counters
+// periodically
+func (server *HTTPAPIServer) setupRateLimit() (*ratelimit.Middleware, error) {
+	keyCacheTTL := DEFAULT_KEY_CACHE_TTL
+	if server.rateLimit.KeyCacheTTL != "" {
+		var err error
+		keyCacheTTL, err = time.ParseDuration(server.rateLimit.KeyCacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing API key cache TTL: %v", err)
+		}
+	}
+	usageFlushInterval := DEFAULT_USAGE_FLUSH_INTERVAL
+	if server.rateLimit.UsageFlushInterval != "" {
+		var err error
+		usageFlushInterval, err = time.ParseDuration(server.rateLimit.UsageFlushInterval)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing API key usage flush interval: %v", err)
+		}
+	}
+
+	apiKeyStore := apikey.NewStore(server.rdbConn.ToHandle())
+	usageRecorder := apikey.NewUsageRecorder(apiKeyStore)
+	middleware, err := ratelimit.NewMiddleware(
+		server.logger,
+		ratelimit.Config{
+			Tiers:             server.rateLimit.RateLimitTiers(),
+			AnonymousTier:     server.rateLimit.AnonymousTier,
+			RequireAPIKey:     server.rateLimit.RequireAPIKey,
+			APIKeyHeader:      server.rateLimit.APIKeyHeader,
+			TrustForwardedFor: server.rateLimit.TrustForwardedFor,
+		},
+		apikey.NewCachedResolver(apiKeyStore, keyCacheTTL),
+		usageRecorder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for range time.Tick(usageFlushInterval) {
+			if flushErr := usageRecorder.Flush(); flushErr != nil {
+				server.logger.Errorf("error writing API key usages: %v", flushErr)
+			}
+		}
+	}()
+
+	return middleware, nil
+}
+
+func (server *HTTPAPIServer) setupHTTPCache() (*cache.Middleware, error) {
+	var maxAge time.Duration
+	if server.httpCache.MaxAge != "" {
+		var err error
+		maxAge, err = time.ParseDuration(server.httpCache.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP cache max age: %v", err)
+		}
+	}
+	heightRefreshInterval := DEFAULT_CACHE_HEIGHT_REFRESH_INTERVAL
+	if server.httpCache.HeightRefreshInterval != "" {
+		var err error
+		heightRefreshInterval, err = time.ParseDuration(server.httpCache.HeightRefreshInterval)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP cache height refresh interval: %v", err)
+		}
+	}
+	maxEntries := DEFAULT_CACHE_MAX_ENTRIES
+	if server.httpCache.MaxEntries > 0 {
+		maxEntries = server.httpCache.MaxEntries
+	}
+
+	return cache.NewMiddleware(
+		server.logger,
+		cache.NewLRU(maxEntries),
+		cache.NewProjectionHeights(server.rdbConn.ToHandle(), heightRefreshInterval),
+		server.routePrefix,
+		routes.CacheRules(),
+		maxAge,
+	), nil
+}
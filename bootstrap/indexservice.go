@@ -0,0 +1,340 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strconv"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	eventhandler_interface "github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+)
+
+type IndexService struct {
+	logger      applogger.Logger
+	rdbConn     rdb.Conn
+	projections []projection_entity.Projection
+	// Optional. The sync and the projections only run while holding their leases when set.
+	maybeSyncLease   *rdb.Lease
+	projectionLeases map[string]*rdb.Lease
+	// Dedicated connection listening to the heights committed to the event store
+	listenerConnConfig *pg.ConnConfig
+
+	systemMode               string
+	accountAddressPrefix     string
+	consNodeAddressPrefix    string
+	bondingDenom             string
+	windowSize               int
+	tendermintHTTPRPCURL     string
+	insecureTendermintClient bool
+	strictGenesisParsing     bool
+	eventStoreConfig         EventStoreConfig
+	blobStoreConfig          BlobStoreConfig
+	projectionConfig         ProjectionConfig
+}
+
+// NewIndexService creates a new server instance for polling and indexing
+func NewIndexService(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	projections []projection_entity.Projection,
+) *IndexService {
+	return &IndexService{
+		logger:      logger,
+		rdbConn:     rdbConn,
+		projections: projections,
+
+		projectionLeases:   make(map[string]*rdb.Lease),
+		listenerConnConfig: pgConnConfig(config),
+
+		systemMode:               config.System.Mode,
+		consNodeAddressPrefix:    config.Blockchain.ConNodeAddressPrefix,
+		accountAddressPrefix:     config.Blockchain.AccountAddressPrefix,
+		bondingDenom:             config.Blockchain.BondingDenom,
+		windowSize:               config.Sync.WindowSize,
+		tendermintHTTPRPCURL:     config.Tendermint.HTTPRPCUrl,
+		insecureTendermintClient: config.Tendermint.Insecure,
+		strictGenesisParsing:     config.Tendermint.StrictGenesisParsing,
+		eventStoreConfig:         config.EventStore,
+		blobStoreConfig:          config.BlobStore,
+		projectionConfig:         config.Projection,
+	}
+}
+
+// WithLeases guards the sync and the projections by their leases, keyed by projection Id, so that
+// replicas of the process can stand by
+func (service *IndexService) WithLeases(
+	maybeSyncLease *rdb.Lease,
+	projectionLeases map[string]*rdb.Lease,
+) *IndexService {
+	service.maybeSyncLease = maybeSyncLease
+	service.projectionLeases = projectionLeases
+	return service
+}
+
+// Run runs both the sync and the projections
+func (service *IndexService) Run() error {
+	service.runInfoManager()
+
+	switch service.systemMode {
+	case SYSTEM_MODE_EVENT_STORE:
+		return service.RunEventStoreMode()
+	case SYSTEM_MODE_TENDERMINT_DIRECT:
+		return service.RunTendermintDirectMode()
+	default:
+		return fmt.Errorf("unsupported system mode: %s", service.systemMode)
+	}
+}
+
+// RunSync only syncs the blocks to the event store, for the projections run by other processes
+func (service *IndexService) RunSync() error {
+	if service.systemMode != SYSTEM_MODE_EVENT_STORE {
+		return fmt.Errorf("sync only runs in %s system mode", SYSTEM_MODE_EVENT_STORE)
+	}
+	service.runInfoManager()
+
+	eventRegistry := service.newEventRegistry()
+	eventStore, err := service.newEventStore(eventRegistry)
+	if err != nil {
+		return err
+	}
+	return service.runSyncManager(eventRegistry, eventStore)
+}
+
+// RunProjections only runs the projections. In event store mode, they handle the events synced by
+// another process. In Tendermint direct mode, each projection syncs the blocks on its own.
+func (service *IndexService) RunProjections() error {
+	switch service.systemMode {
+	case SYSTEM_MODE_EVENT_STORE:
+		eventStore, err := service.newEventStore(service.newEventRegistry())
+		if err != nil {
+			return err
+		}
+		if err := service.runProjectionManager(eventStore); err != nil {
+			return err
+		}
+		select {}
+	case SYSTEM_MODE_TENDERMINT_DIRECT:
+		service.runInfoManager()
+		return service.RunTendermintDirectMode()
+	default:
+		return fmt.Errorf("unsupported system mode: %s", service.systemMode)
+	}
+}
+
+// runInfoManager polls Tendermint status and updates the view tables directly
+func (service *IndexService) runInfoManager() {
+	infoManager := NewInfoManager(
+		service.logger,
+		service.rdbConn,
+		service.tendermintHTTPRPCURL,
+		service.insecureTendermintClient,
+		service.strictGenesisParsing,
+	)
+	infoManager.Run()
+}
+
+// readerConfig returns the shared event reader config, with the defaults in place of the values not
+// configured
+func (service *IndexService) readerConfig() projection_entity.ReaderConfig {
+	readerConfig := projection_entity.DefaultReaderConfig()
+	if service.projectionConfig.ReadBatchSize > 0 {
+		readerConfig.BatchSize = service.projectionConfig.ReadBatchSize
+	}
+	if service.projectionConfig.ReadBufferSize > 0 {
+		readerConfig.BufferSize = service.projectionConfig.ReadBufferSize
+	}
+	if service.projectionConfig.MaxHeightsPerTransaction > 0 {
+		readerConfig.MaxHandleBatchSize = service.projectionConfig.MaxHeightsPerTransaction
+	}
+	return readerConfig
+}
+
+func (service *IndexService) RunEventStoreMode() error {
+	eventRegistry := service.newEventRegistry()
+	eventStore, err := service.newEventStore(eventRegistry)
+	if err != nil {
+		return err
+	}
+
+	if err := service.runProjectionManager(eventStore); err != nil {
+		return err
+	}
+	return service.runSyncManager(eventRegistry, eventStore)
+}
+
+func (service *IndexService) newEventRegistry() *event.Registry {
+	eventRegistry := event.NewRegistry()
+	event_usecase.RegisterEvents(eventRegistry)
+	return eventRegistry
+}
+
+func (service *IndexService) newEventStore(eventRegistry *event.Registry) (*event_interface.RDbStore, error) {
+	eventStore, err := NewRDbEventStore(
+		service.rdbConn, eventRegistry, service.eventStoreConfig, service.blobStoreConfig,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating event store: %v", err)
+	}
+	return eventStore, nil
+}
+
+func (service *IndexService) runProjectionManager(eventStore *event_interface.RDbStore) error {
+	projectionManager := projection_entity.NewStoreBasedManager(
+		service.logger, eventStore,
+	).WithReaderConfig(
+		service.readerConfig(),
+	).WithHeightNotifications(
+		service.runHeightListener(),
+	).WithCommittedHeightNotifications(
+		service.runCommittedHeightListener(),
+	)
+	for projectionId, lease := range service.projectionLeases {
+		projectionManager.WithLease(projectionId, lease)
+	}
+
+	for _, projection := range service.projections {
+		if err := projectionManager.RegisterProjection(projection); err != nil {
+			return fmt.Errorf("error registering projection `%s` to manager %v", projection.Id(), err)
+		}
+	}
+	if _, err := projectionManager.DependencyGraph(); err != nil {
+		return fmt.Errorf("error resolving projection dependencies: %v", err)
+	}
+	projectionManager.RunInBackground()
+
+	return nil
+}
+
+// runHeightListener listens to the heights committed by the sync, which may run in another process.
+// The projections keep polling for the heights committed while the listener reconnects.
+func (service *IndexService) runHeightListener() <-chan int64 {
+	listener := pg.NewListener(
+		service.logger, service.listenerConnConfig, eventhandler_interface.DEFAULT_HEIGHT_NOTIFICATION_CHANNEL,
+	)
+	listener.RunInBackground()
+
+	heights := make(chan int64, 1)
+	go func() {
+		for payload := range listener.Payloads() {
+			height, err := strconv.ParseInt(payload, 10, 64)
+			if err != nil {
+				service.logger.Errorf("error parsing notified height %q: %v", payload, err)
+				continue
+			}
+			select {
+			case heights <- height:
+			default:
+			}
+		}
+	}()
+	return heights
+}
+
+// runCommittedHeightListener listens to the heights committed by the projections, which may run in
+// other processes. The dependent projections keep polling their dependencies while the listener
+// reconnects.
+func (service *IndexService) runCommittedHeightListener() <-chan projection_entity.CommittedHeight {
+	listener := pg.NewListener(
+		service.logger, service.listenerConnConfig, rdbprojectionbase.HEIGHT_NOTIFICATION_CHANNEL,
+	)
+	listener.RunInBackground()
+
+	committedHeights := make(chan projection_entity.CommittedHeight)
+	go func() {
+		for payload := range listener.Payloads() {
+			projectionId, height, err := rdbprojectionbase.ParseHeightNotification(payload)
+			if err != nil {
+				service.logger.Errorf("error parsing notified projection height: %v", err)
+				continue
+			}
+			committedHeights <- projection_entity.CommittedHeight{
+				ProjectionId: projectionId,
+				Height:       height,
+			}
+		}
+	}()
+	return committedHeights
+}
+
+func (service *IndexService) runSyncManager(
+	eventRegistry *event.Registry,
+	eventStore *event_interface.RDbStore,
+) error {
+	// The events and the synced height are only committed while holding the sync lease
+	syncRDbConn := service.rdbConn
+	if service.maybeSyncLease != nil {
+		syncRDbConn = service.maybeSyncLease.Fence(service.rdbConn)
+	}
+	eventStoreHandler := eventhandler_interface.NewRDbEventStoreHandler(
+		service.logger,
+		syncRDbConn,
+		eventRegistry,
+	).WithEventStore(
+		eventStore,
+	).WithHeightNotification(
+		eventhandler_interface.DEFAULT_HEIGHT_NOTIFICATION_CHANNEL,
+	)
+	txDecoder := parser.NewTxDecoder()
+	syncManager := NewSyncManager(
+		SyncManagerParams{
+			Logger:    service.logger,
+			RDbConn:   service.rdbConn,
+			TxDecoder: txDecoder,
+			Config: SyncManagerConfig{
+				WindowSize:               service.windowSize,
+				TendermintRPCUrl:         service.tendermintHTTPRPCURL,
+				InsecureTendermintClient: service.insecureTendermintClient,
+				StrictGenesisParsing:     service.strictGenesisParsing,
+				AccountAddressPrefix:     service.accountAddressPrefix,
+				StakingDenom:             service.bondingDenom,
+			},
+		},
+		eventStoreHandler,
+	)
+	if service.maybeSyncLease != nil {
+		syncManager.WithLease(service.maybeSyncLease)
+	}
+	if err := syncManager.Run(); err != nil {
+		return fmt.Errorf("error running sync manager %v", err)
+	}
+
+	return nil
+}
+
+func (service *IndexService) RunTendermintDirectMode() error {
+	txDecoder := parser.NewTxDecoder()
+
+	for i := range service.projections {
+		go func(projection projection_entity.Projection) {
+			syncManager := NewSyncManager(SyncManagerParams{
+				Logger: service.logger.WithFields(applogger.LogFields{
+					"projection": projection.Id(),
+				}),
+				RDbConn:   service.rdbConn,
+				TxDecoder: txDecoder,
+				Config: SyncManagerConfig{
+					WindowSize:               service.windowSize,
+					TendermintRPCUrl:         service.tendermintHTTPRPCURL,
+					InsecureTendermintClient: service.insecureTendermintClient,
+					AccountAddressPrefix:     service.accountAddressPrefix,
+					StakingDenom:             service.bondingDenom,
+				},
+			}, eventhandler_interface.NewProjectionHandler(service.logger, projection))
+			if lease, ok := service.projectionLeases[projection.Id()]; ok {
+				syncManager.WithLease(lease)
+			}
+			if err := syncManager.Run(); err != nil {
+				panic(fmt.Sprintf("error running sync manager %v", err))
+			}
+		}(service.projections[i])
+	}
+	select {}
+}
@@ -0,0 +1,445 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	gomigrate "github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/urfave/cli/v2"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	"github.com/crypto-com/chain-indexing/projection"
+)
+
+const DEFAULT_MIGRATIONS_FOLDER = "./migrations"
+
+// migrationTarget is a set of migrations with its own version table
+type migrationTarget struct {
+	name string
+	// Id of the projection owning the migrations, empty for the core migrations
+	projectionId string
+	// Tables written by the projection, empty for the core migrations
+	tables     projection.ProjectionTables
+	newMigrate func() (*pg.Migrate, error)
+}
+
+// migrateCommand is the admin command to apply the core migrations in the migrations folder and the
+// migrations owned by the projections
+func (app *App) migrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "Migrate the core tables and the tables of the enabled projections",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "source",
+				Value: DEFAULT_MIGRATIONS_FOLDER,
+				Usage: "`FOLDER` of the core migrations",
+			},
+			&cli.BoolFlag{
+				Name:  "core",
+				Usage: "Only migrate the core migrations",
+			},
+			&cli.StringFlag{
+				Name:  "projection",
+				Usage: "Only migrate the migrations owned by the projection `ID`, which can be disabled",
+			},
+		},
+		Subcommands: []*cli.Command{
+			{
+				Name:  "up",
+				Usage: "Apply all up migrations",
+				Action: func(ctx *cli.Context) error {
+					config, err := loadConfig(ctx)
+					if err != nil {
+						return err
+					}
+					if err := upgradeMigrationsLayout(config, ctx.String("source")); err != nil {
+						return fmt.Errorf("error upgrading migrations layout: %v", err)
+					}
+
+					return app.forEachMigrationTarget(ctx, false, func(target migrationTarget, m *pg.Migrate) error {
+						if err := m.Up(); err != nil {
+							return err
+						}
+						fmt.Printf("Migrated %s up\n", target.name)
+						return nil
+					})
+				},
+			},
+			{
+				Name:      "down",
+				Usage:     "Apply all or N down migrations of --core or --projection",
+				ArgsUsage: "[N]",
+				Action: func(ctx *cli.Context) error {
+					var maybeSteps *int
+					if ctx.Args().Len() > 0 {
+						steps, err := strconv.Atoi(ctx.Args().First())
+						if err != nil || steps <= 0 {
+							return fmt.Errorf("invalid number of down migrations: %s", ctx.Args().First())
+						}
+						maybeSteps = &steps
+					}
+
+					return app.forEachMigrationTarget(ctx, true, func(target migrationTarget, m *pg.Migrate) error {
+						if maybeSteps != nil {
+							if err := m.Steps(-*maybeSteps); err != nil {
+								return err
+							}
+							fmt.Printf("Migrated %s down by %d migrations\n", target.name, *maybeSteps)
+							return nil
+						}
+
+						if err := m.Down(); err != nil {
+							return err
+						}
+						// The projection handles the events from the beginning once its tables are
+						// created again
+						if target.projectionId != "" {
+							if err := app.deleteLastHandledEventHeight(ctx, target.projectionId); err != nil {
+								return err
+							}
+						}
+						fmt.Printf("Migrated %s down\n", target.name)
+						return nil
+					})
+				},
+			},
+			{
+				Name:  "version",
+				Usage: "Print the current migration versions",
+				Action: func(ctx *cli.Context) error {
+					return app.forEachMigrationTarget(ctx, false, func(target migrationTarget, m *pg.Migrate) error {
+						version, dirty, err := m.Version()
+						if err != nil {
+							if errors.Is(err, gomigrate.ErrNilVersion) {
+								fmt.Printf("%s: no migration applied\n", target.name)
+								return nil
+							}
+							return err
+						}
+						if dirty {
+							fmt.Printf("%s: version %d (dirty)\n", target.name, version)
+						} else {
+							fmt.Printf("%s: version %d\n", target.name, version)
+						}
+						return nil
+					})
+				},
+			},
+			{
+				Name:      "force",
+				Usage:     "Set the migration version of --core or --projection without running migrations",
+				ArgsUsage: "VERSION",
+				Action: func(ctx *cli.Context) error {
+					if ctx.Args().Len() != 1 {
+						return errors.New("missing VERSION")
+					}
+					version, err := strconv.Atoi(ctx.Args().First())
+					if err != nil {
+						return fmt.Errorf("invalid version: %s", ctx.Args().First())
+					}
+
+					return app.forEachMigrationTarget(ctx, true, func(target migrationTarget, m *pg.Migrate) error {
+						if err := m.Force(version); err != nil {
+							return err
+						}
+						fmt.Printf("Forced %s to version %d\n", target.name, version)
+						return nil
+					})
+				},
+			},
+		},
+	}
+}
+
+// forEachMigrationTarget runs the action on the selected migration targets in order, the core
+// migrations first. When requireSelection is true, one of --core and --projection must be set.
+func (app *App) forEachMigrationTarget(
+	ctx *cli.Context,
+	requireSelection bool,
+	action func(target migrationTarget, m *pg.Migrate) error,
+) error {
+	config, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	targets, err := app.migrationTargets(ctx, config, requireSelection)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		m, err := target.newMigrate()
+		if err != nil {
+			return fmt.Errorf("error creating migrate of %s: %v", target.name, err)
+		}
+		actionErr := action(target, m)
+		_, _ = m.Close()
+		if actionErr != nil {
+			return fmt.Errorf("error migrating %s: %v", target.name, actionErr)
+		}
+	}
+
+	return nil
+}
+
+func (app *App) migrationTargets(
+	ctx *cli.Context,
+	config *Config,
+	requireSelection bool,
+) ([]migrationTarget, error) {
+	connConfig := pgConnConfig(config)
+	coreTarget := migrationTarget{
+		name: "core",
+		newMigrate: func() (*pg.Migrate, error) {
+			return pg.NewMigrate(connConfig, ctx.String("source"))
+		},
+	}
+
+	switch {
+	case ctx.Bool("core") && ctx.IsSet("projection"):
+		return nil, errors.New("only one of --core and --projection can be set")
+	case ctx.Bool("core"):
+		return []migrationTarget{coreTarget}, nil
+	case ctx.IsSet("projection"):
+		target, err := app.projectionMigrationTarget(config, ctx.String("projection"))
+		if err != nil {
+			return nil, err
+		}
+		if target == nil {
+			return nil, fmt.Errorf("projection %s has no migrations", ctx.String("projection"))
+		}
+		// The version table and the tables are shared with the other projections writing to the
+		// same tables, so they cannot be reverted or forced for one of them alone
+		if requireSelection {
+			if err := app.checkNoEnabledProjectionSharingTables(config, target); err != nil {
+				return nil, err
+			}
+		}
+		return []migrationTarget{*target}, nil
+	case requireSelection:
+		return nil, errors.New("one of --core and --projection must be set")
+	}
+
+	if err := app.projectionRegistry.CheckSharedTables(
+		config.Projection.Enables, config.Projection.Config,
+	); err != nil {
+		return nil, err
+	}
+	targets := []migrationTarget{coreTarget}
+	for _, projectionId := range config.Projection.Enables {
+		target, err := app.projectionMigrationTarget(config, projectionId)
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			targets = append(targets, *target)
+		}
+	}
+	return targets, nil
+}
+
+// projectionMigrationTarget returns the migrations of the tables written by the projection, which
+// are owned by the table owner of its type, nil when there is none
+func (app *App) projectionMigrationTarget(config *Config, projectionId string) (*migrationTarget, error) {
+	projectionConfig := config.Projection.Config[projectionId]
+	tables, err := app.projectionRegistry.ResolveTables(projectionId, projectionConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving tables of projection %s: %v", projectionId, err)
+	}
+	migrations, err := app.projectionRegistry.ProjectionMigrations(projectionId, projectionConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving migrations of projection %s: %v", projectionId, err)
+	}
+	if migrations == nil {
+		return nil, nil
+	}
+
+	connConfig := pgConnConfig(config)
+	return &migrationTarget{
+		name:         fmt.Sprintf("projection %s", projectionId),
+		projectionId: projectionId,
+		tables:       tables,
+		newMigrate: func() (*pg.Migrate, error) {
+			return pg.NewMigrateWithMigrations(connConfig, migrations, projection.MigrationsTable(tables))
+		},
+	}, nil
+}
+
+// checkNoEnabledProjectionSharingTables returns error when an enabled projection other than the one
+// of the target writes to the tables of the target
+func (app *App) checkNoEnabledProjectionSharingTables(config *Config, target *migrationTarget) error {
+	for _, projectionId := range config.Projection.Enables {
+		if projectionId == target.projectionId {
+			continue
+		}
+		tables, err := app.projectionRegistry.ResolveTables(
+			projectionId, config.Projection.Config[projectionId],
+		)
+		if err != nil {
+			return fmt.Errorf("error resolving tables of projection %s: %v", projectionId, err)
+		}
+		if tables == target.tables {
+			return fmt.Errorf(
+				"%s shares the tables of projection type `%s` with the enabled projection %s",
+				target.name, target.tables.Owner, projectionId,
+			)
+		}
+	}
+	return nil
+}
+
+// upgradeMigrationsLayout moves a database migrated when the core migrations still created the view
+// tables to the current layout. The core version of such database is one of the view table
+// migrations, which are now owned by the projections of this repository and missing from the core
+// migrations. The version of each of these projections is set to its last migration already applied
+// by the core migrations, then the core version is set back to the last core migration before it.
+func upgradeMigrationsLayout(config *Config, sourceFolder string) error {
+	connConfig := pgConnConfig(config)
+	coreMigrate, err := pg.NewMigrate(connConfig, sourceFolder)
+	if err != nil {
+		return fmt.Errorf("error creating migrate of core: %v", err)
+	}
+	defer func() {
+		_, _ = coreMigrate.Close()
+	}()
+
+	coreVersion, dirty, err := coreMigrate.Version()
+	if err != nil {
+		if errors.Is(err, gomigrate.ErrNilVersion) {
+			return nil
+		}
+		return fmt.Errorf("error getting core migration version: %v", err)
+	}
+	sourceVersions, err := readMigrationsFolderVersions(sourceFolder)
+	if err != nil {
+		return err
+	}
+	maybeCoreBaseline := lastVersionUpTo(sourceVersions, coreVersion)
+	if maybeCoreBaseline != nil && *maybeCoreBaseline == coreVersion {
+		return nil
+	}
+	if dirty {
+		return fmt.Errorf("core migration version %d is dirty", coreVersion)
+	}
+	if maybeCoreBaseline == nil {
+		return fmt.Errorf("no core migration up to version %d in %s", coreVersion, sourceFolder)
+	}
+
+	coreRegistry := projection.NewCoreRegistry()
+	for _, ownerType := range coreRegistry.MigrationOwners() {
+		migrations := coreRegistry.Migrations(ownerType)
+		versions := make([]uint, 0, len(migrations))
+		for _, migration := range migrations {
+			versions = append(versions, migration.Version)
+		}
+		maybeBaseline := lastVersionUpTo(versions, coreVersion)
+		if maybeBaseline == nil {
+			continue
+		}
+
+		if err := forceMigrationsBaseline(connConfig, ownerType, migrations, *maybeBaseline); err != nil {
+			return err
+		}
+	}
+
+	if err := coreMigrate.Force(int(*maybeCoreBaseline)); err != nil {
+		return fmt.Errorf("error forcing core to version %d: %v", *maybeCoreBaseline, err)
+	}
+	fmt.Printf("Moved core from version %d to %d\n", coreVersion, *maybeCoreBaseline)
+	return nil
+}
+
+// forceMigrationsBaseline sets the version of the migrations of the projection type to the baseline,
+// unless a version is already set
+func forceMigrationsBaseline(
+	connConfig *pg.ConnConfig,
+	ownerType string,
+	migrations []rdb.Migration,
+	baseline uint,
+) error {
+	m, err := pg.NewMigrateWithMigrations(
+		connConfig, migrations, projection.MigrationsTable(projection.ProjectionTables{Owner: ownerType}),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating migrate of projection type %s: %v", ownerType, err)
+	}
+	defer func() {
+		_, _ = m.Close()
+	}()
+
+	if _, _, err = m.Version(); err == nil {
+		return nil
+	} else if !errors.Is(err, gomigrate.ErrNilVersion) {
+		return fmt.Errorf("error getting migration version of projection type %s: %v", ownerType, err)
+	}
+	if err = m.Force(int(baseline)); err != nil {
+		return fmt.Errorf("error forcing projection type %s to version %d: %v", ownerType, baseline, err)
+	}
+	fmt.Printf("Forced projection type %s to version %d applied by the core migrations\n", ownerType, baseline)
+	return nil
+}
+
+// readMigrationsFolderVersions returns the versions of the migrations in the folder
+func readMigrationsFolderVersions(folder string) ([]uint, error) {
+	fileInfos, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return nil, fmt.Errorf("error reading migrations folder: %v", err)
+	}
+
+	versions := make([]uint, 0, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() {
+			continue
+		}
+		migration, parseErr := source.DefaultParse(fileInfo.Name())
+		if parseErr != nil {
+			continue
+		}
+		versions = append(versions, migration.Version)
+	}
+	return versions, nil
+}
+
+// lastVersionUpTo returns the greatest of the versions not greater than the version, nil when there is
+// none
+func lastVersionUpTo(versions []uint, version uint) *uint {
+	var maybeLastVersion *uint
+	for i := range versions {
+		if versions[i] <= version && (maybeLastVersion == nil || versions[i] > *maybeLastVersion) {
+			maybeLastVersion = &versions[i]
+		}
+	}
+	return maybeLastVersion
+}
+
+func (app *App) deleteLastHandledEventHeight(ctx *cli.Context, projectionId string) error {
+	config, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+	rdbConn, err := SetupRDbConn(config, newLogger(config))
+	if err != nil {
+		return fmt.Errorf("error setting up RDb connection: %v", err)
+	}
+
+	return rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE).DeleteLastHandledEventHeight(
+		rdbConn.ToHandle(), projectionId,
+	)
+}
+
+func pgConnConfig(config *Config) *pg.ConnConfig {
+	return &pg.ConnConfig{
+		Host:          config.Database.Host,
+		Port:          config.Database.Port,
+		MaybeUsername: &config.Database.Username,
+		MaybePassword: &config.Database.Password,
+		Database:      config.Database.Name,
+		SSL:           config.Database.SSL,
+	}
+}
@@ -0,0 +1,90 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	cosmosapp_infrastructure "github.com/crypto-com/chain-indexing/infrastructure/cosmosapp"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/projection"
+)
+
+func initProjections(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	projectionRegistry *projection.Registry,
+	projectionLeases map[string]*rdb.Lease,
+) ([]projection_entity.Projection, error) {
+	var cosmosAppClient cosmosapp.Client
+	if config.CosmosApp.Insecure {
+		cosmosAppClient = cosmosapp_infrastructure.NewInsecureHTTPClient(
+			config.CosmosApp.HTTPRPCUrl, config.Blockchain.BondingDenom,
+		)
+	} else {
+		cosmosAppClient = cosmosapp_infrastructure.NewHTTPClient(
+			config.CosmosApp.HTTPRPCUrl, config.Blockchain.BondingDenom,
+		)
+	}
+
+	for projectionId := range config.Projection.Config {
+		if !containsProjectionId(config.Projection.Enables, projectionId) {
+			return nil, fmt.Errorf("[projection.config.%s] is not an enabled projection", projectionId)
+		}
+	}
+	if err := projectionRegistry.CheckSharedTables(
+		config.Projection.Enables, config.Projection.Config,
+	); err != nil {
+		return nil, err
+	}
+
+	projections := make([]projection_entity.Projection, 0, len(config.Projection.Enables))
+	for _, projectionId := range config.Projection.Enables {
+		// A projection guarded by a lease only commits while holding the lease
+		projectionRdbConn := rdbConn
+		if lease, ok := projectionLeases[projectionId]; ok {
+			projectionRdbConn = lease.Fence(rdbConn)
+		}
+		initParams := projection.InitParams{
+			Logger:  logger,
+			RdbConn: projectionRdbConn,
+
+			CosmosAppClient:       cosmosAppClient,
+			AccountAddressPrefix:  config.Blockchain.AccountAddressPrefix,
+			ConsNodeAddressPrefix: config.Blockchain.ConNodeAddressPrefix,
+
+			Config: config.Projection.Config[projectionId],
+		}
+		projection, err := projectionRegistry.InitProjection(
+			projectionId, initParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating projection %s: %v", projectionId, err)
+		}
+		if onInitErr := projection.OnInit(); onInitErr != nil {
+			logger.Errorf(
+				"error initializing projection %s, system will attempt to initialize the projection again on next restart: %v",
+				projection.Id(), onInitErr,
+			)
+			continue
+		}
+		projections = append(projections, projection)
+	}
+
+	logger.Infof("Enabled the follow projections: [%s]", strings.Join(config.Projection.Enables, ", "))
+
+	return projections, nil
+}
+
+func containsProjectionId(projectionIds []string, target string) bool {
+	for _, projectionId := range projectionIds {
+		if projectionId == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package bootstrap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	"github.com/crypto-com/chain-indexing/infrastructure/replica"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_REPLICA_LAG_CHECK_INTERVAL = 5 * time.Second
+
+func SetupRDbConn(config *Config, logger applogger.Logger) (rdb.Conn, error) {
+	return setupPgxConnPool(config, config.Database.Host, config.Database.Port, logger)
+}
+
+// SetupReplicaRDbConn connects to the read replica. It returns nil when no replica is configured.
+func SetupReplicaRDbConn(config *Config, logger applogger.Logger) (rdb.Conn, error) {
+	if config.Database.ReplicaHost == "" {
+		return nil, nil
+	}
+
+	port := config.Database.ReplicaPort
+	if port == 0 {
+		port = config.Database.Port
+	}
+	return setupPgxConnPool(config, config.Database.ReplicaHost, port, logger.WithFields(applogger.LogFields{
+		"database": "replica",
+	}))
+}
+
+func setupPgxConnPool(config *Config, host string, port int32, logger applogger.Logger) (rdb.Conn, error) {
+	var pgxConnPool *pg.PgxConn
+	var err error
+
+	// GetFee duration strings to duration
+	maxConnLifeTime, err := time.ParseDuration(config.Postgres.MaxConnLifeTime)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing MaxConnLifeTime string to duration %v", err)
+	}
+	maxConnIdleTime, err := time.ParseDuration(config.Postgres.MaxConnIdleTime)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing MaxConnIdleTime string to duration %v", err)
+	}
+	healthCheckInterval, err := time.ParseDuration(config.Postgres.HealthCheckInterval)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing HealthCheckInterval string to duration %v", err)
+	}
+
+	for pgxConnPool == nil {
+		pgxConnPool, err = pg.NewPgxConnPool(&pg.PgxConnPoolConfig{
+			ConnConfig: pg.ConnConfig{
+				Host:          host,
+				Port:          port,
+				MaybeUsername: &config.Database.Username,
+				MaybePassword: &config.Database.Password,
+				Database:      config.Database.Name,
+				SSL:           config.Database.SSL,
+			},
+			MaybeMaxConns:          &config.Postgres.MaxConns,
+			MaybeMinConns:          &config.Postgres.MinConns,
+			MaybeMaxConnLifeTime:   &maxConnLifeTime,
+			MaybeMaxConnIdleTime:   &maxConnIdleTime,
+			MaybeHealthCheckPeriod: &healthCheckInterval,
+		}, logger)
+
+		if err != nil {
+			logger.Errorf("error setting up connection to database, will retry in 5 seconds: %v", err)
+			<-time.After(5 * time.Second)
+		}
+	}
+
+	logger.Info("successfully setup database connection")
+	return pgxConnPool, nil
+}
+
+// SetupAPIRDbConn returns the connection serving the API queries. When a read replica is
+// configured, it is a replica.Router which sends reads to the replica while its lag is within the
+// threshold, and the router is returned as well.
+func SetupAPIRDbConn(config *Config, logger applogger.Logger, rdbConn rdb.Conn) (rdb.Conn, *replica.Router, error) {
+	replicaRDbConn, err := SetupReplicaRDbConn(config, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error setting up replica RDb connection: %v", err)
+	}
+	if replicaRDbConn == nil {
+		return rdbConn, nil, nil
+	}
+
+	lagCheckInterval := DEFAULT_REPLICA_LAG_CHECK_INTERVAL
+	if config.Postgres.ReplicaLagCheckInterval != "" {
+		lagCheckInterval, err = time.ParseDuration(config.Postgres.ReplicaLagCheckInterval)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing ReplicaLagCheckInterval string to duration %v", err)
+		}
+	}
+
+	replicaRouter := replica.NewRouter(logger, rdbConn, replicaRDbConn, config.Postgres.ReplicaMaxLag)
+	go replicaRouter.Run(lagCheckInterval)
+
+	return replicaRouter, replicaRouter, nil
+}
@@ -0,0 +1,227 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+// Roles select the components started by a process. Processes of different roles run against the
+// same database, so that the API servers can scale separately from the indexer.
+const (
+	// HTTP API and gRPC API servers, which are stateless
+	ROLE_SERVE_API = "serve-api"
+	// Tendermint status polling and block sync to the event store
+	ROLE_SYNC = "sync"
+	// Enabled projections
+	ROLE_PROJECT = "project"
+	ROLE_ALL     = "all"
+)
+
+const SYNC_LEASE_NAME = "sync"
+const PROJECTION_LEASE_NAME_PREFIX = "projection/"
+
+func (app *App) roleCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  ROLE_SERVE_API,
+			Usage: "Only serve the HTTP API and the gRPC API",
+			Action: func(ctx *cli.Context) error {
+				return app.runRole(ctx, ROLE_SERVE_API)
+			},
+		},
+		{
+			Name:  ROLE_SYNC,
+			Usage: "Only sync the blocks to the event store (EVENT_STORE system mode only)",
+			Action: func(ctx *cli.Context) error {
+				return app.runRole(ctx, ROLE_SYNC)
+			},
+		},
+		{
+			Name:  ROLE_PROJECT,
+			Usage: "Only run the enabled projections",
+			Action: func(ctx *cli.Context) error {
+				return app.runRole(ctx, ROLE_PROJECT)
+			},
+		},
+		{
+			Name:  ROLE_ALL,
+			Usage: "Run all components in one process, the same as running without command",
+			Action: func(ctx *cli.Context) error {
+				return app.runRole(ctx, ROLE_ALL)
+			},
+		},
+	}
+}
+
+// runRole starts the components of the role and blocks forever. The sync and each projection are
+// guarded by a lease, so that they are run by one process at a time while the replicas stand by.
+func (app *App) runRole(ctx *cli.Context, role string) error {
+	if args := ctx.Args(); args.Len() > 0 {
+		return fmt.Errorf("Unexpected arguments: %q", args.Get(0))
+	}
+
+	config, err := loadConfig(ctx)
+	if err != nil {
+		return err
+	}
+	logger := newLogger(config)
+
+	// Setup system
+	if config.System.Mode != SYSTEM_MODE_EVENT_STORE && config.System.Mode != SYSTEM_MODE_TENDERMINT_DIRECT {
+		logger.Panicf("unrecognized system mode: %s", config.System.Mode)
+	}
+	if role == ROLE_SYNC && config.System.Mode != SYSTEM_MODE_EVENT_STORE {
+		logger.Panicf(
+			"%s role only runs in %s system mode, projections sync on their own in %s system mode",
+			ROLE_SYNC, SYSTEM_MODE_EVENT_STORE, SYSTEM_MODE_TENDERMINT_DIRECT,
+		)
+	}
+	shouldServeAPI := role == ROLE_SERVE_API || role == ROLE_ALL
+	shouldSync := role == ROLE_SYNC || role == ROLE_ALL
+	shouldProject := role == ROLE_PROJECT || role == ROLE_ALL
+
+	rdbConn, err := SetupRDbConn(config, logger)
+	if err != nil {
+		logger.Panicf("error setting up RDb connection: %v", err)
+	}
+
+	var projectionIds []string
+	if shouldProject {
+		projectionIds = config.Projection.Enables
+	}
+	maybeSyncLease, projectionLeases, err := newRoleLeases(logger, rdbConn, config, shouldSync, projectionIds)
+	if err != nil {
+		logger.Panicf("error creating leases of %s role: %v", role, err)
+	}
+
+	// Projection configs are validated before serving anything. The projections write through
+	// connections fenced by their leases.
+	var projections []projection_entity.Projection
+	if shouldProject {
+		projections, err = initProjections(logger, rdbConn, config, app.projectionRegistry, projectionLeases)
+		if err != nil {
+			logger.Panicf("error initializing projections: %v", err)
+		}
+	}
+
+	// Only the leases of the running projections are competed for, so that a projection failing to
+	// initialize is left to the standbys
+	if maybeSyncLease != nil {
+		maybeSyncLease.RunInBackground()
+	}
+	runningProjectionLeases := make(map[string]*rdb.Lease, len(projections))
+	for _, projection := range projections {
+		lease := projectionLeases[projection.Id()]
+		lease.RunInBackground()
+		runningProjectionLeases[projection.Id()] = lease
+	}
+
+	if shouldServeAPI {
+		// API queries are served by the read replica when it is configured
+		apiRDbConn, maybeReplicaRouter, setupErr := SetupAPIRDbConn(config, logger, rdbConn)
+		if setupErr != nil {
+			logger.Panicf("error setting up API RDb connection: %v", setupErr)
+		}
+
+		// Only the views of the enabled projections have tables to read
+		servedProjections, servedErr := app.projectionRegistry.ServedProjections(
+			config.Projection.Enables, config.Projection.Config,
+		)
+		if servedErr != nil {
+			logger.Panicf("error resolving projections served by the API: %v", servedErr)
+		}
+
+		httpAPIServer := NewHTTPAPIServer(
+			logger, apiRDbConn, maybeReplicaRouter, config,
+		).WithRoutes(app.routeFactories).WithProjections(servedProjections)
+		go func() {
+			if runErr := httpAPIServer.Run(); runErr != nil {
+				logger.Panicf("%v", runErr)
+			}
+		}()
+
+		if config.GRPC.Enable {
+			grpcServer := NewGRPCServer(logger, apiRDbConn, config).WithProjections(servedProjections)
+			go func() {
+				if runErr := grpcServer.Run(); runErr != nil {
+					logger.Panicf("%v", runErr)
+				}
+			}()
+		}
+	}
+
+	if shouldSync || shouldProject {
+		indexService := NewIndexService(
+			logger, rdbConn, config, projections,
+		).WithLeases(maybeSyncLease, runningProjectionLeases)
+		go func() {
+			var runErr error
+			switch {
+			case shouldSync && shouldProject:
+				runErr = indexService.Run()
+			case shouldSync:
+				runErr = indexService.RunSync()
+			default:
+				runErr = indexService.RunProjections()
+			}
+			if runErr != nil {
+				logger.Panicf("%v", runErr)
+			}
+		}()
+	}
+
+	select {}
+}
+
+// newRoleLeases creates the leases guarding the sync and the projections run by a process, which
+// are run once the components are created. The sync lease is nil when the process does not sync to
+// the event store.
+func newRoleLeases(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	config *Config,
+	shouldSync bool,
+	projectionIds []string,
+) (*rdb.Lease, map[string]*rdb.Lease, error) {
+	leaseTTL := rdb.DEFAULT_LEASE_TTL
+	if config.LeaderElection.LeaseTTL != "" {
+		var err error
+		leaseTTL, err = time.ParseDuration(config.LeaderElection.LeaseTTL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing lease TTL: %v", err)
+		}
+	}
+	holder, err := leaseHolder()
+	if err != nil {
+		return nil, nil, err
+	}
+	newLease := func(name string) *rdb.Lease {
+		return rdb.NewLease(logger, rdbConn.ToHandle(), name, holder).WithTTL(leaseTTL)
+	}
+
+	var maybeSyncLease *rdb.Lease
+	if shouldSync && config.System.Mode == SYSTEM_MODE_EVENT_STORE {
+		maybeSyncLease = newLease(SYNC_LEASE_NAME)
+	}
+	projectionLeases := make(map[string]*rdb.Lease, len(projectionIds))
+	for _, projectionId := range projectionIds {
+		projectionLeases[projectionId] = newLease(PROJECTION_LEASE_NAME_PREFIX + projectionId)
+	}
+	return maybeSyncLease, projectionLeases, nil
+}
+
+// leaseHolder identifies the process holding a lease
+func leaseHolder() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("error getting hostname: %v", err)
+	}
+	return fmt.Sprintf("%s/%d", hostname, os.Getpid()), nil
+}
@@ -1,4 +1,4 @@
-package main
+package bootstrap
 
 import (
 	"fmt"
@@ -31,6 +31,8 @@ type SyncManager struct {
 	windowSyncStrategy *syncstrategy.Window
 
 	eventHandler eventhandler_interface.Handler
+	// Optional. Blocks are only synced while the lease is held when set.
+	maybeLease *rdb.Lease
 
 	// SyncManager state
 	latestBlockHeight *int64
@@ -94,6 +96,12 @@ func NewSyncManager(
 	}
 }
 
+// WithLease only syncs the blocks while the lease is held, so that replicas of the process can stand by
+func (manager *SyncManager) WithLease(lease *rdb.Lease) *SyncManager {
+	manager.maybeLease = lease
+	return manager
+}
+
 // SyncBlocks makes request to tendermint, create and dispatch notifications
 func (manager *SyncManager) SyncBlocks(latestHeight int64) error {
 	maybeLastIndexedHeight, err := manager.eventHandler.GetLastHandledEventHeight()
@@ -131,6 +139,10 @@ func (manager *SyncManager) SyncBlocks(latestHeight int64) error {
 				events = append(events, event)
 			}
 
+			// Another process may take over the sync once the lease is lost
+			if manager.maybeLease != nil && !manager.maybeLease.IsHeld() {
+				return fmt.Errorf("lost sync lease before handling height %d", blockHeight)
+			}
 			err := manager.eventHandler.HandleEvents(blockHeight, events)
 			if err != nil {
 				return fmt.Errorf("error handling events: %v", err)
@@ -204,6 +216,12 @@ func (manager *SyncManager) Run() error {
 	tracker.Subscribe(blockHeightCh)
 
 	for {
+		if manager.maybeLease != nil && !manager.maybeLease.IsHeld() {
+			manager.logger.Infof("standing by until the sync lease is held")
+			manager.maybeLease.WaitUntilHeld()
+			manager.logger.Infof("sync lease is held")
+		}
+
 		if manager.latestBlockHeight == nil {
 			manager.logger.Info("the chain has no block yet")
 		} else {
@@ -3,10 +3,12 @@ package main
 import (
 	"fmt"
 	"os"
+
+	"github.com/crypto-com/chain-indexing/bootstrap"
 )
 
 func main() {
-	if err := CliApp(os.Args); err != nil {
+	if err := bootstrap.CliApp(os.Args); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -0,0 +1,140 @@
+package event
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores contents addressed by the hash of the content
+type BlobStore interface {
+	// Put stores the content and returns its reference. Putting the same content again returns the
+	// same reference.
+	Put(content []byte) (string, error)
+
+	// Get returns the content of the reference, ErrBlobNotFound when there is no such content
+	Get(ref string) ([]byte, error)
+}
+
+// BlobRef is stored in place of an event payload moved to a BlobStore. It keeps the event header, so
+// that the event can be filtered without fetching the payload.
+type BlobRef struct {
+	Ref     string `json:"blobRef"`
+	Size    int    `json:"blobSize"`
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+	Height  int64  `json:"height"`
+	UUID    string `json:"uuid"`
+}
+
+func NewBlobRef(ref string, size int, event Event) *BlobRef {
+	return &BlobRef{
+		Ref:     ref,
+		Size:    size,
+		Name:    event.Name(),
+		Version: event.Version(),
+		Height:  event.Height(),
+		UUID:    event.UUID(),
+	}
+}
+
+func (blobRef *BlobRef) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(blobRef)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// ParseBlobRef returns the blob reference when the encoded event payload is one
+func ParseBlobRef(encoded []byte) (*BlobRef, bool) {
+	if !bytes.Contains(encoded, []byte(`"blobRef"`)) {
+		return nil, false
+	}
+
+	var blobRef BlobRef
+	if err := jsoniter.Unmarshal(encoded, &blobRef); err != nil || blobRef.Ref == "" {
+		return nil, false
+	}
+	return &blobRef, true
+}
+
+var _ Event = &LazyEvent{}
+
+// LazyEvent is an event whose payload is in a BlobStore. The payload is fetched and decoded on the
+// first Resolve.
+type LazyEvent struct {
+	blobRef   BlobRef
+	blobStore BlobStore
+	decoder   Decoder
+
+	mutex         sync.Mutex
+	maybeResolved Event
+}
+
+func (event *LazyEvent) Height() int64 {
+	return event.blobRef.Height
+}
+
+func (event *LazyEvent) Name() string {
+	return event.blobRef.Name
+}
+
+func (event *LazyEvent) Version() int {
+	return event.blobRef.Version
+}
+
+func (event *LazyEvent) UUID() string {
+	return event.blobRef.UUID
+}
+
+// ToJSON encodes the blob reference, so that storing the event again does not fetch the payload
+func (event *LazyEvent) ToJSON() (string, error) {
+	return event.blobRef.ToJSON()
+}
+
+func (event *LazyEvent) String() string {
+	return fmt.Sprintf("%sV%d(%s) at %s", event.blobRef.Name, event.blobRef.Version, event.blobRef.UUID, event.blobRef.Ref)
+}
+
+func (event *LazyEvent) BlobRef() BlobRef {
+	return event.blobRef
+}
+
+// Resolve fetches the payload from the BlobStore and decodes it
+func (event *LazyEvent) Resolve() (Event, error) {
+	event.mutex.Lock()
+	defer event.mutex.Unlock()
+
+	if event.maybeResolved != nil {
+		return event.maybeResolved, nil
+	}
+
+	encoded, err := event.blobStore.Get(event.blobRef.Ref)
+	if err != nil {
+		return nil, fmt.Errorf("error getting blob %s: %v", event.blobRef.Ref, err)
+	}
+	resolved, err := event.decoder(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding event: %v", err)
+	}
+
+	event.maybeResolved = resolved
+	return resolved, nil
+}
+
+// Resolve returns the resolved event when the event is a LazyEvent, otherwise the event itself
+func Resolve(event Event) (Event, error) {
+	lazyEvent, ok := event.(*LazyEvent)
+	if !ok {
+		return event, nil
+	}
+
+	return lazyEvent.Resolve()
+}
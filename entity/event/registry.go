@@ -9,6 +9,8 @@ var ErrMismatchEvent = errors.New("mismatched event")
 
 type Registry struct {
 	decoders map[string]Decoder
+
+	maybeBlobStore BlobStore
 }
 
 func NewRegistry() *Registry {
@@ -17,6 +19,13 @@ func NewRegistry() *Registry {
 	}
 }
 
+// WithBlobStore resolves the payloads moved to the blob store. Events with such payloads are decoded
+// into LazyEvent, which fetches the payload only when it is resolved.
+func (registry *Registry) WithBlobStore(blobStore BlobStore) *Registry {
+	registry.maybeBlobStore = blobStore
+	return registry
+}
+
 // Register add a mapping of "event name ane version" to Decoder to the registry. It will overwrite
 // existing registration if any.
 func (registry *Registry) Register(eventName string, eventVersion int, decoder Decoder) {
@@ -37,6 +46,17 @@ func (registry *Registry) DecodeByType(eventName string, eventVersion int, encod
 	}
 
 	decoder := registry.decoders[eventType(eventName, eventVersion)]
+	if blobRef, isBlobRef := ParseBlobRef(encoded); isBlobRef {
+		if registry.maybeBlobStore == nil {
+			return nil, fmt.Errorf("error decoding event: no blob store to resolve blob %s", blobRef.Ref)
+		}
+		return &LazyEvent{
+			blobRef:   *blobRef,
+			blobStore: registry.maybeBlobStore,
+			decoder:   decoder,
+		}, nil
+	}
+
 	var event Event
 	if event, err = decoder(encoded); err != nil {
 		return nil, fmt.Errorf("error decoding event: %v", err)
@@ -3,6 +3,7 @@ package event_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -61,6 +62,41 @@ var _ = Describe("eventRegistry", func() {
 			Expect(ok).To(BeTrue())
 			Expect(typedEvent).To(Equal(newSimpleJSONEvent()))
 		})
+
+		It("should return error when the payload is a blob reference but there is no blob store", func() {
+			registry := event.NewRegistry()
+			registry.Register(simpleJSONEventName, simpleJSONEventVersion, decodeSimpleJSONEvent)
+			encodedBlobRef, _ := event.NewBlobRef("sha256:abc", 15, newSimpleJSONEvent()).ToJSON()
+
+			_, err := registry.DecodeByType(simpleJSONEventName, simpleJSONEventVersion, []byte(encodedBlobRef))
+			Expect(err).To(MatchError("error decoding event: no blob store to resolve blob sha256:abc"))
+		})
+
+		It("should return a lazy event fetching the payload from the blob store on resolve", func() {
+			blobStore := newFakeBlobStore()
+			encoded, _ := json.Marshal(newSimpleJSONEvent())
+			ref, _ := blobStore.Put(encoded)
+
+			registry := event.NewRegistry().WithBlobStore(blobStore)
+			registry.Register(simpleJSONEventName, simpleJSONEventVersion, decodeSimpleJSONEvent)
+			encodedBlobRef, _ := event.NewBlobRef(ref, len(encoded), newSimpleJSONEvent()).ToJSON()
+
+			actual, err := registry.DecodeByType(simpleJSONEventName, simpleJSONEventVersion, []byte(encodedBlobRef))
+			Expect(err).To(BeNil())
+			lazyEvent, ok := actual.(*event.LazyEvent)
+			Expect(ok).To(BeTrue())
+			Expect(lazyEvent.Name()).To(Equal(simpleJSONEventName))
+			Expect(lazyEvent.Height()).To(Equal(int64(1)))
+			Expect(lazyEvent.UUID()).To(Equal("simple-json-event-id"))
+			Expect(blobStore.getCount).To(Equal(0))
+
+			resolved, err := event.Resolve(actual)
+			Expect(err).To(BeNil())
+			Expect(resolved).To(Equal(newSimpleJSONEvent()))
+			_, err = event.Resolve(actual)
+			Expect(err).To(BeNil())
+			Expect(blobStore.getCount).To(Equal(1))
+		})
 	})
 })
 
@@ -91,3 +127,27 @@ func decodeSimpleJSONEvent(eventBytes []byte) (event.Event, error) {
 	}
 	return event, nil
 }
+
+type fakeBlobStore struct {
+	blobs    map[string][]byte
+	getCount int
+}
+
+func newFakeBlobStore() *fakeBlobStore {
+	return &fakeBlobStore{
+		blobs: make(map[string][]byte),
+	}
+}
+func (store *fakeBlobStore) Put(content []byte) (string, error) {
+	ref := fmt.Sprintf("fake:%d", len(store.blobs))
+	store.blobs[ref] = content
+	return ref, nil
+}
+func (store *fakeBlobStore) Get(ref string) ([]byte, error) {
+	store.getCount += 1
+	content, exist := store.blobs[ref]
+	if !exist {
+		return nil, event.ErrBlobNotFound
+	}
+	return content, nil
+}
@@ -6,6 +6,10 @@ type Store interface {
 
 	GetAllByHeight(height int64) ([]Event, error)
 
+	// GetAllByHeightRange returns the events of the heights from fromHeight to toHeight inclusively
+	// by height. Heights without events may be missing from the result.
+	GetAllByHeightRange(fromHeight int64, toHeight int64) (map[int64][]Event, error)
+
 	Insert(evt Event) error
 
 	// InsertAll insert all events into store. It will rollback when the insert fails at any point.
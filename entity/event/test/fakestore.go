@@ -18,6 +18,14 @@ func (manager *FakeEventStore) GetAllByHeight(seq int64) ([]entity_event.Event,
 	return []entity_event.Event{NewFakeEvent()}, nil
 }
 
+func (manager *FakeEventStore) GetAllByHeightRange(fromHeight int64, toHeight int64) (map[int64][]entity_event.Event, error) {
+	eventsByHeight := make(map[int64][]entity_event.Event)
+	for height := fromHeight; height <= toHeight; height++ {
+		eventsByHeight[height] = []entity_event.Event{NewFakeEvent()}
+	}
+	return eventsByHeight, nil
+}
+
 func (manager *FakeEventStore) Insert(evt entity_event.Event) error {
 	return nil
 }
@@ -25,6 +25,19 @@ func (manager *MockEventStore) GetAllByHeight(height int64) ([]entity_event.Even
 	return mockArgs.Get(0).([]entity_event.Event), mockArgs.Error(1)
 }
 
+// GetAllByHeightRange is answered by the GetAllByHeight mocks of each height in the range
+func (manager *MockEventStore) GetAllByHeightRange(fromHeight int64, toHeight int64) (map[int64][]entity_event.Event, error) {
+	eventsByHeight := make(map[int64][]entity_event.Event)
+	for height := fromHeight; height <= toHeight; height++ {
+		events, err := manager.GetAllByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		eventsByHeight[height] = events
+	}
+	return eventsByHeight, nil
+}
+
 func (manager *MockEventStore) Insert(evt entity_event.Event) error {
 	mockArgs := manager.Called(evt)
 
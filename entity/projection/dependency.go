@@ -0,0 +1,202 @@
+package projection
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DependentProjection is a projection reading the views of other projections. It never handles a
+// height before all its dependencies have committed the height.
+type DependentProjection interface {
+	Projection
+
+	// Returns the Ids of the projections whose views are read when handling events
+	GetDependencies() []string
+}
+
+// DependencyGraph is the resolved dependencies between the registered projections
+type DependencyGraph struct {
+	// Projection Ids in an order where every projection comes after its dependencies
+	Order []string
+	// Dependencies of each projection Id
+	Dependencies map[string][]string
+}
+
+// NewDependencyGraph resolves the dependencies between the projections. It returns error when the
+// dependencies form a cycle or a dependency is not among the projections.
+func NewDependencyGraph(projections []Projection) (*DependencyGraph, error) {
+	return resolveDependencyGraph(projections, true)
+}
+
+func resolveDependencyGraph(projections []Projection, requireDependencies bool) (*DependencyGraph, error) {
+	graph := &DependencyGraph{
+		Order:        make([]string, 0, len(projections)),
+		Dependencies: make(map[string][]string, len(projections)),
+	}
+	for _, projection := range projections {
+		graph.Dependencies[projection.Id()] = dependenciesOf(projection)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[string]int, len(projections))
+	path := make([]string, 0)
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch states[id] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := append(path[indexOf(path, id):], id)
+			return fmt.Errorf("projection dependencies form a cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		states[id] = visiting
+		path = append(path, id)
+		for _, dependency := range graph.Dependencies[id] {
+			if _, ok := graph.Dependencies[dependency]; !ok {
+				if requireDependencies {
+					return fmt.Errorf("projection `%s` depends on unregistered projection `%s`", id, dependency)
+				}
+				continue
+			}
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		states[id] = visited
+
+		graph.Order = append(graph.Order, id)
+		return nil
+	}
+	for _, projection := range projections {
+		if err := visit(projection.Id()); err != nil {
+			return nil, err
+		}
+	}
+
+	return graph, nil
+}
+
+// String describes the projections in order with their dependencies, e.g. `Validator, Account <- [Validator]`
+func (graph *DependencyGraph) String() string {
+	descriptions := make([]string, 0, len(graph.Order))
+	for _, id := range graph.Order {
+		dependencies := graph.Dependencies[id]
+		if len(dependencies) == 0 {
+			descriptions = append(descriptions, id)
+			continue
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s <- [%s]", id, strings.Join(dependencies, ", ")))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func dependenciesOf(projection Projection) []string {
+	dependentProjection, ok := projection.(DependentProjection)
+	if !ok {
+		return []string{}
+	}
+	return dependentProjection.GetDependencies()
+}
+
+func indexOf(ids []string, id string) int {
+	for i, candidate := range ids {
+		if candidate == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// CommittedHeight is the last handled event height committed by a projection
+type CommittedHeight struct {
+	ProjectionId string
+	Height       int64
+}
+
+// committedHeights keeps the last height committed by each running projection, so that dependent
+// projections can wait for their dependencies
+type committedHeights struct {
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	heights map[string]int64
+}
+
+func newCommittedHeights() *committedHeights {
+	committedHeights := &committedHeights{
+		heights: make(map[string]int64),
+	}
+	committedHeights.cond = sync.NewCond(&committedHeights.mutex)
+	return committedHeights
+}
+
+// commit records the projection has committed all heights up to the height
+func (committedHeights *committedHeights) commit(id string, height int64) {
+	committedHeights.mutex.Lock()
+	defer committedHeights.mutex.Unlock()
+
+	if committedHeight, ok := committedHeights.heights[id]; ok && committedHeight >= height {
+		return
+	}
+	committedHeights.heights[id] = height
+	committedHeights.cond.Broadcast()
+}
+
+// min returns the lowest height committed by all the projections, -1 when any of them has not
+// committed a height
+func (committedHeights *committedHeights) min(ids []string) int64 {
+	committedHeights.mutex.Lock()
+	defer committedHeights.mutex.Unlock()
+
+	return committedHeights.minLocked(ids)
+}
+
+func (committedHeights *committedHeights) minLocked(ids []string) int64 {
+	var minHeight *int64
+	for _, id := range ids {
+		committedHeight, ok := committedHeights.heights[id]
+		if !ok {
+			return -1
+		}
+		if minHeight == nil || committedHeight < *minHeight {
+			height := committedHeight
+			minHeight = &height
+		}
+	}
+	if minHeight == nil {
+		return -1
+	}
+	return *minHeight
+}
+
+// waitUntil blocks until all the projections have committed the height or the timeout passes, and
+// returns the lowest height committed by them
+func (committedHeights *committedHeights) waitUntil(ids []string, height int64, timeout time.Duration) int64 {
+	committedHeights.mutex.Lock()
+	defer committedHeights.mutex.Unlock()
+
+	hasTimedOut := false
+	timer := time.AfterFunc(timeout, func() {
+		committedHeights.mutex.Lock()
+		defer committedHeights.mutex.Unlock()
+
+		hasTimedOut = true
+		committedHeights.cond.Broadcast()
+	})
+	defer timer.Stop()
+
+	for {
+		minHeight := committedHeights.minLocked(ids)
+		if minHeight >= height || hasTimedOut {
+			return minHeight
+		}
+		committedHeights.cond.Wait()
+	}
+}
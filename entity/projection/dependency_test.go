@@ -0,0 +1,143 @@
+package projection_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/crypto-com/chain-indexing/entity/event/test"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/entity/projection"
+)
+
+var _ = Describe("Projection dependencies", func() {
+	It("should resolve the projections in an order where dependencies come first", func() {
+		manager := projection.NewStoreBasedManager(NewFakeLogger(), NewFakeEventStore())
+		Expect(manager.RegisterProjection(newDependentProjection("Proposal", "Validator"))).To(Succeed())
+		Expect(manager.RegisterProjection(newDependentProjection("Account", "Validator", "Block"))).To(Succeed())
+		Expect(manager.RegisterProjection(newDependentProjection("Validator"))).To(Succeed())
+		Expect(manager.RegisterProjection(newDependentProjection("Block"))).To(Succeed())
+
+		dependencyGraph, err := manager.DependencyGraph()
+		Expect(err).To(BeNil())
+		Expect(dependencyGraph.Order).To(Equal([]string{"Validator", "Proposal", "Block", "Account"}))
+		Expect(dependencyGraph.String()).To(Equal(
+			"Validator, Proposal <- [Validator], Block, Account <- [Validator, Block]",
+		))
+	})
+
+	It("should reject a projection forming a dependency cycle at registration", func() {
+		manager := projection.NewStoreBasedManager(NewFakeLogger(), NewFakeEventStore())
+		Expect(manager.RegisterProjection(newDependentProjection("A", "B"))).To(Succeed())
+		Expect(manager.RegisterProjection(newDependentProjection("B", "C"))).To(Succeed())
+
+		Expect(manager.RegisterProjection(newDependentProjection("C", "A"))).To(MatchError(
+			"error registering projection `C`: projection dependencies form a cycle: A -> B -> C -> A",
+		))
+		Expect(manager.RegisterProjection(newDependentProjection("D", "D"))).To(MatchError(
+			"error registering projection `D`: projection dependencies form a cycle: D -> D",
+		))
+		Expect(manager.IsProjectionRegistered(newDependentProjection("C"))).To(BeFalse())
+	})
+
+	It("should return error when a dependency is not registered", func() {
+		manager := projection.NewStoreBasedManager(NewFakeLogger(), NewFakeEventStore())
+		Expect(manager.RegisterProjection(newDependentProjection("Proposal", "Validator"))).To(Succeed())
+
+		_, err := manager.DependencyGraph()
+		Expect(err).To(MatchError("projection `Proposal` depends on unregistered projection `Validator`"))
+	})
+
+	It("should not let a dependent projection handle a height before its dependency", func() {
+		eventStore := newRangeEventStore(9)
+		manager := projection.NewStoreBasedManager(
+			NewFakeLogger(), eventStore,
+		).WithReaderConfig(projection.ReaderConfig{
+			BatchSize:          5,
+			BufferSize:         10,
+			MaxHandleBatchSize: 4,
+			PollInterval:       50 * time.Millisecond,
+		})
+		dependency := newRecordingProjection("Dependency", nil)
+		dependency.handleDelay = 10 * time.Millisecond
+		dependent := &orderCheckingProjection{
+			recordingProjection: newRecordingProjection("Dependent", nil),
+			dependency:          dependency,
+		}
+		Expect(manager.RegisterProjection(dependent)).To(Succeed())
+		Expect(manager.RegisterProjection(dependency)).To(Succeed())
+
+		manager.RunInBackground()
+
+		allHeights := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		Eventually(dependent.HandledHeights).Should(Equal(allHeights))
+		Expect(dependent.HeightsHandledEarly()).To(BeEmpty())
+	})
+})
+
+// dependentProjection only declares its dependencies
+type dependentProjection struct {
+	id           string
+	dependencies []string
+}
+
+func newDependentProjection(id string, dependencies ...string) *dependentProjection {
+	return &dependentProjection{
+		id:           id,
+		dependencies: dependencies,
+	}
+}
+
+func (projection *dependentProjection) Id() string {
+	return projection.id
+}
+func (projection *dependentProjection) GetDependencies() []string {
+	return projection.dependencies
+}
+func (projection *dependentProjection) GetEventsToListen() []string {
+	return []string{}
+}
+func (projection *dependentProjection) GetLastHandledEventHeight() (*int64, error) {
+	return nil, nil
+}
+func (projection *dependentProjection) OnInit() error {
+	return nil
+}
+func (projection *dependentProjection) HandleEvents(_ int64, _ []entity_event.Event) error {
+	return nil
+}
+
+// orderCheckingProjection records the heights it handles before the dependency has handled them
+type orderCheckingProjection struct {
+	*recordingProjection
+
+	dependency *recordingProjection
+
+	earlyMutex          sync.Mutex
+	heightsHandledEarly []int64
+}
+
+func (projection *orderCheckingProjection) GetDependencies() []string {
+	return []string{projection.dependency.Id()}
+}
+
+func (projection *orderCheckingProjection) HandleEvents(height int64, events []entity_event.Event) error {
+	dependencyHandledHeights := projection.dependency.HandledHeights()
+	if len(dependencyHandledHeights) == 0 || dependencyHandledHeights[len(dependencyHandledHeights)-1] < height {
+		projection.earlyMutex.Lock()
+		projection.heightsHandledEarly = append(projection.heightsHandledEarly, height)
+		projection.earlyMutex.Unlock()
+	}
+
+	return projection.recordingProjection.HandleEvents(height, events)
+}
+
+func (projection *orderCheckingProjection) HeightsHandledEarly() []int64 {
+	projection.earlyMutex.Lock()
+	defer projection.earlyMutex.Unlock()
+
+	return append([]int64{}, projection.heightsHandledEarly...)
+}
@@ -0,0 +1,10 @@
+package projection
+
+// Lease is held by at most one of the processes running a projection, so that replicas of the
+// process can stand by for the projection. The runner of the projection only handles events while
+// the lease is held.
+type Lease interface {
+	IsHeld() bool
+	// Blocks until the lease is held
+	WaitUntilHeld()
+}
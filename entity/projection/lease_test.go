@@ -0,0 +1,140 @@
+package projection_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/projection"
+)
+
+var _ = Describe("Projection lease", func() {
+	It("should only handle events once the lease is held, without holding back other projections", func() {
+		eventStore := newRangeEventStore(4)
+		lease := newFakeLease()
+		manager := projection.NewStoreBasedManager(
+			NewFakeLogger(), eventStore,
+		).WithReaderConfig(projection.ReaderConfig{
+			BatchSize:          5,
+			BufferSize:         10,
+			MaxHandleBatchSize: 1,
+			PollInterval:       50 * time.Millisecond,
+		}).WithLease("Standby", lease)
+		standby := newRecordingProjection("Standby", nil)
+		unguarded := newRecordingProjection("Unguarded", nil)
+		Expect(manager.RegisterProjection(standby)).To(Succeed())
+		Expect(manager.RegisterProjection(unguarded)).To(Succeed())
+
+		manager.RunInBackground()
+
+		allHeights := []int64{0, 1, 2, 3, 4}
+		Eventually(unguarded.HandledHeights).Should(Equal(allHeights))
+		Consistently(standby.HandledHeights, 200*time.Millisecond).Should(BeEmpty())
+
+		lease.Hold()
+		Eventually(standby.HandledHeights).Should(Equal(allHeights))
+	})
+
+	It("should handle the heights committed by a dependency whose lease is held by another process", func() {
+		eventStore := newRangeEventStore(4)
+		manager := projection.NewStoreBasedManager(
+			NewFakeLogger(), eventStore,
+		).WithReaderConfig(projection.ReaderConfig{
+			BatchSize:          5,
+			BufferSize:         10,
+			MaxHandleBatchSize: 1,
+			PollInterval:       50 * time.Millisecond,
+		}).WithLease("Dependency", newFakeLease())
+		dependency := newRecordingProjection("Dependency", nil)
+		dependent := &leaseDependentProjection{
+			recordingProjection: newRecordingProjection("Dependent", nil),
+			dependencies:        []string{"Dependency"},
+		}
+		Expect(manager.RegisterProjection(dependency)).To(Succeed())
+		Expect(manager.RegisterProjection(dependent)).To(Succeed())
+
+		manager.RunInBackground()
+
+		Consistently(dependent.HandledHeights, 200*time.Millisecond).Should(BeEmpty())
+
+		dependency.SetLastHandledEventHeight(2)
+		Eventually(dependent.HandledHeights).Should(Equal([]int64{0, 1, 2}))
+		Consistently(dependent.HandledHeights, 200*time.Millisecond).Should(Equal([]int64{0, 1, 2}))
+		Expect(dependency.HandledHeights()).To(BeEmpty())
+	})
+
+	It("should handle the heights notified as committed by a dependency in another process without polling", func() {
+		eventStore := newRangeEventStore(4)
+		committedHeights := make(chan projection.CommittedHeight)
+		manager := projection.NewStoreBasedManager(
+			NewFakeLogger(), eventStore,
+		).WithReaderConfig(projection.ReaderConfig{
+			BatchSize:          5,
+			BufferSize:         10,
+			MaxHandleBatchSize: 1,
+			PollInterval:       time.Hour,
+		}).WithLease(
+			"Dependency", newFakeLease(),
+		).WithCommittedHeightNotifications(committedHeights)
+		dependency := newRecordingProjection("Dependency", nil)
+		dependent := &leaseDependentProjection{
+			recordingProjection: newRecordingProjection("Dependent", nil),
+			dependencies:        []string{"Dependency"},
+		}
+		Expect(manager.RegisterProjection(dependency)).To(Succeed())
+		Expect(manager.RegisterProjection(dependent)).To(Succeed())
+
+		manager.RunInBackground()
+
+		Consistently(dependent.HandledHeights, 200*time.Millisecond).Should(BeEmpty())
+
+		committedHeights <- projection.CommittedHeight{ProjectionId: "Dependency", Height: 2}
+		Eventually(dependent.HandledHeights).Should(Equal([]int64{0, 1, 2}))
+		committedHeights <- projection.CommittedHeight{ProjectionId: "Dependency", Height: 4}
+		Eventually(dependent.HandledHeights).Should(Equal([]int64{0, 1, 2, 3, 4}))
+	})
+})
+
+type leaseDependentProjection struct {
+	*recordingProjection
+
+	dependencies []string
+}
+
+func (projection *leaseDependentProjection) GetDependencies() []string {
+	return projection.dependencies
+}
+
+type fakeLease struct {
+	mutex  sync.Mutex
+	isHeld bool
+	heldCh chan struct{}
+}
+
+func newFakeLease() *fakeLease {
+	return &fakeLease{
+		heldCh: make(chan struct{}),
+	}
+}
+
+func (lease *fakeLease) Hold() {
+	lease.mutex.Lock()
+	defer lease.mutex.Unlock()
+
+	lease.isHeld = true
+	close(lease.heldCh)
+}
+
+func (lease *fakeLease) IsHeld() bool {
+	lease.mutex.Lock()
+	defer lease.mutex.Unlock()
+
+	return lease.isHeld
+}
+
+func (lease *fakeLease) WaitUntilHeld() {
+	<-lease.heldCh
+}
@@ -2,6 +2,7 @@ package projection
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	entity_event "github.com/crypto-com/chain-indexing/entity/event"
@@ -13,7 +14,15 @@ type StoreBasedManager struct {
 	logger     applogger.Logger
 	eventStore entity_event.Store
 
-	projections []Projection
+	projections  []Projection
+	leases       map[string]Lease
+	readerConfig ReaderConfig
+	// Optional. Heights committed to the event store, which wake the reader before the poll interval.
+	maybeHeightNotifications <-chan int64
+	// Optional. Heights committed by the projections of any process, which wake the dependent
+	// projections before the poll interval.
+	maybeCommittedHeightNotifications <-chan CommittedHeight
+	committedHeights                  *committedHeights
 }
 
 func NewStoreBasedManager(logger applogger.Logger, eventStore entity_event.Store) *StoreBasedManager {
@@ -23,14 +32,51 @@ func NewStoreBasedManager(logger applogger.Logger, eventStore entity_event.Store
 		}),
 		eventStore: eventStore,
 
-		projections: make([]Projection, 0),
+		projections:      make([]Projection, 0),
+		leases:           make(map[string]Lease),
+		readerConfig:     DefaultReaderConfig(),
+		committedHeights: newCommittedHeights(),
 	}
 }
 
+func (manager *StoreBasedManager) WithReaderConfig(config ReaderConfig) *StoreBasedManager {
+	manager.readerConfig = config
+	return manager
+}
+
+// WithHeightNotifications wakes the projections on the heights committed to the event store. The
+// reader keeps polling in case the notifications stop.
+func (manager *StoreBasedManager) WithHeightNotifications(heights <-chan int64) *StoreBasedManager {
+	manager.maybeHeightNotifications = heights
+	return manager
+}
+
+// WithCommittedHeightNotifications wakes the dependent projections on the heights committed by their
+// dependencies, which may run in another process. The dependencies keep being polled in case the
+// notifications stop.
+func (manager *StoreBasedManager) WithCommittedHeightNotifications(
+	heights <-chan CommittedHeight,
+) *StoreBasedManager {
+	manager.maybeCommittedHeightNotifications = heights
+	return manager
+}
+
+// WithLease guards the runner of the projection by the lease. The runner waits for the lease before
+// handling any event, and panics when the lease is lost, so that the process restarts as a standby.
+func (manager *StoreBasedManager) WithLease(projectionId string, lease Lease) *StoreBasedManager {
+	manager.leases[projectionId] = lease
+	return manager
+}
+
 func (manager *StoreBasedManager) RegisterProjection(projection Projection) error {
 	if manager.IsProjectionRegistered(projection) {
 		return fmt.Errorf("projection `%s` already registered", projection.Id())
 	}
+	// Dependencies may be registered later, but must not form a cycle with the registered ones
+	projections := append(append([]Projection{}, manager.projections...), projection)
+	if _, err := resolveDependencyGraph(projections, false); err != nil {
+		return fmt.Errorf("error registering projection `%s`: %v", projection.Id(), err)
+	}
 	manager.projections = append(manager.projections, projection)
 	return nil
 }
@@ -44,14 +90,72 @@ func (manager *StoreBasedManager) IsProjectionRegistered(projection Projection)
 	return false
 }
 
-// Starts projectionManager by running all registered projection.
+// registeredProjection returns the registered projection of the Id, nil when there is none
+func (manager *StoreBasedManager) registeredProjection(id string) Projection {
+	for _, registeredProjection := range manager.projections {
+		if registeredProjection.Id() == id {
+			return registeredProjection
+		}
+	}
+	return nil
+}
+
+// DependencyGraph resolves the dependencies between the registered projections. It returns error when
+// a dependency is not registered.
+func (manager *StoreBasedManager) DependencyGraph() (*DependencyGraph, error) {
+	return NewDependencyGraph(manager.projections)
+}
+
+// Starts projectionManager by running all registered projection. The events are read once for all
+// projections by a SharedEventReader. It panics when a dependency is not registered, which can be
+// checked beforehand with DependencyGraph().
 func (manager *StoreBasedManager) RunInBackground() {
+	dependencyGraph, err := manager.DependencyGraph()
+	if err != nil {
+		manager.logger.Panicf("error resolving projection dependencies: %v", err)
+	}
+	manager.logger.WithFields(applogger.LogFields{
+		"order": dependencyGraph.Order,
+	}).Infof("resolved projection dependency graph: %s", dependencyGraph)
+
+	reader := NewSharedEventReader(
+		manager.logger, manager.eventStore, manager.readerConfig,
+	).WithHeightNotifications(manager.maybeHeightNotifications)
+
+	if manager.maybeCommittedHeightNotifications != nil {
+		go func() {
+			for committedHeight := range manager.maybeCommittedHeightNotifications {
+				manager.committedHeights.commit(committedHeight.ProjectionId, committedHeight.Height)
+			}
+		}()
+	}
+
+	// The reader starts once all projections have subscribed, so that projections at the same
+	// position share the reads from the beginning
+	var subscribedWaitGroup sync.WaitGroup
+	subscribedWaitGroup.Add(len(manager.projections))
 	for _, projection := range manager.projections {
-		go manager.projectionRunner(projection)
+		go manager.projectionRunner(
+			reader,
+			projection,
+			dependencyGraph.Dependencies[projection.Id()],
+			manager.leases[projection.Id()],
+			&subscribedWaitGroup,
+		)
 	}
+	go func() {
+		subscribedWaitGroup.Wait()
+		reader.Run()
+	}()
 }
 
-func (manager *StoreBasedManager) projectionRunner(projection Projection) {
+func (manager *StoreBasedManager) projectionRunner(
+	reader *SharedEventReader,
+	projection Projection,
+	dependencies []string,
+	maybeLease Lease,
+	subscribedWaitGroup *sync.WaitGroup,
+) {
 	eventsToListen := projection.GetEventsToListen()
 	logger := manager.logger.WithFields(applogger.LogFields{
 		"projection": projection.Id(),
@@ -59,8 +163,20 @@ func (manager *StoreBasedManager) projectionRunner(projection Projection) {
 
 	logger.WithFields(applogger.LogFields{
 		"eventsToListen": eventsToListen,
+		"dependencies":   dependencies,
 	}).Infof("projection start running")
 
+	// A standby subscribes once it takes over, without holding back the other projections
+	hasLeftWaitGroup := false
+	if maybeLease != nil && !maybeLease.IsHeld() {
+		subscribedWaitGroup.Done()
+		hasLeftWaitGroup = true
+
+		logger.Infof("standing by until the projection lease is held")
+		maybeLease.WaitUntilHeld()
+		logger.Infof("projection lease is held")
+	}
+
 	var lastHandledEventHeight *int64
 	for {
 		var err error
@@ -79,55 +195,181 @@ func (manager *StoreBasedManager) projectionRunner(projection Projection) {
 	} else {
 		nextEventHeight = *lastHandledEventHeight + 1
 	}
+	manager.committedHeights.commit(projection.Id(), nextEventHeight-1)
 
+	subscription := reader.Subscribe(nextEventHeight)
+	if !hasLeftWaitGroup {
+		subscribedWaitGroup.Done()
+	}
+	batchProjection, isBatchProjection := projection.(BatchProjection)
+	// Heights received but not yet handled successfully
+	pendingBatch := make([]HeightEvents, 0)
 	for {
-		latestEventHeight, _ := manager.eventStore.GetLatestHeight()
-		if latestEventHeight == nil {
-			logger.Debugf("no event in in the system yet")
-			<-waitFor(5 * time.Second)
-			continue
+		if len(pendingBatch) == 0 {
+			pendingBatch = append(pendingBatch, subscription.Receive())
+		}
+		// Heights already buffered are handled together when the projection is catching up
+		if isBatchProjection {
+			for len(pendingBatch) < manager.readerConfig.MaxHandleBatchSize {
+				heightEvents, ok := subscription.TryReceive()
+				if !ok {
+					break
+				}
+				pendingBatch = append(pendingBatch, heightEvents)
+			}
 		}
-		for nextEventHeight <= *latestEventHeight {
-			var err error
 
+		// Only the heights committed by all dependencies are handled
+		batch := pendingBatch
+		if len(dependencies) > 0 {
+			committedHeight := manager.committedHeights.min(dependencies)
+			if committedHeight < pendingBatch[0].Height {
+				logger.WithFields(applogger.LogFields{
+					"height": pendingBatch[0].Height,
+				}).Infof("waiting for dependencies to commit height")
+				committedHeight = manager.waitForDependencies(logger, dependencies, pendingBatch[0].Height)
+			}
+			batch = heightsUpTo(pendingBatch, committedHeight)
+		}
+
+		// Another process may take over the projection once the lease is lost
+		if maybeLease != nil && !maybeLease.IsHeld() {
+			logger.Panicf("lost projection lease before handling height %d", batch[0].Height)
+		}
+
+		var err error
+		if len(batch) == 1 {
 			eventLogger := logger.WithFields(applogger.LogFields{
-				"height": nextEventHeight,
+				"height": batch[0].Height,
+			})
+			err = handleEvents(eventLogger, projection, eventsToListen, batch[0].Height, batch[0].Events)
+		} else {
+			batchLogger := logger.WithFields(applogger.LogFields{
+				"fromHeight": batch[0].Height,
+				"toHeight":   batch[len(batch)-1].Height,
 			})
+			err = handleEventsBatch(batchLogger, batchProjection, eventsToListen, batch)
+		}
+		if err != nil {
+			<-waitFor(5 * time.Second)
+			continue
+		}
+
+		manager.committedHeights.commit(projection.Id(), batch[len(batch)-1].Height)
+		pendingBatch = append(pendingBatch[:0], pendingBatch[len(batch):]...)
+	}
+}
 
-			var eventsAtHeight []entity_event.Event
-			if eventsAtHeight, err = manager.eventStore.GetAllByHeight(nextEventHeight); err != nil {
-				eventLogger.Errorf("error getting all events by height: %v", err)
-				<-waitFor(time.Second)
+// waitForDependencies blocks until all the dependencies have committed the height, and returns the
+// lowest height committed by them. A dependency may be run by another process holding its lease, so
+// the heights committed by the other processes wake the wait when they are notified, and the last
+// handled event heights of the dependencies are read from the projections every poll interval in case
+// a notification is missed.
+func (manager *StoreBasedManager) waitForDependencies(
+	logger applogger.Logger,
+	dependencies []string,
+	height int64,
+) int64 {
+	for {
+		for _, dependencyId := range dependencies {
+			lastHandledEventHeight, err := manager.registeredProjection(dependencyId).GetLastHandledEventHeight()
+			if err != nil {
+				logger.Errorf("error getting last handled event height of dependency %s: %v", dependencyId, err)
 				continue
 			}
-
-			var events = make([]entity_event.Event, 0)
-			for _, event := range eventsAtHeight {
-				if !isListeningEvent(event, eventsToListen) {
-					//eventLogger.WithFields(applogger.LogFields{
-					//	"eventName": event.Name(),
-					//}).Debugf("skipping because event is not one of the listening events")
-					continue
-				}
-				events = append(events, event)
+			if lastHandledEventHeight != nil {
+				manager.committedHeights.commit(dependencyId, *lastHandledEventHeight)
 			}
+		}
 
-			eventLogger = eventLogger.WithFields(applogger.LogFields{
-				"eventCount": len(events),
-			})
-			if err = projection.HandleEvents(nextEventHeight, events); err != nil {
-				eventLogger.WithFields(applogger.LogFields{
-					"events": events,
-				}).Errorf("error handling events: %v", err)
-				<-waitFor(5 * time.Second)
-				continue
-			}
+		committedHeight := manager.committedHeights.waitUntil(
+			dependencies, height, manager.readerConfig.PollInterval,
+		)
+		if committedHeight >= height {
+			return committedHeight
+		}
+	}
+}
 
-			eventLogger.Infof("successfully handled events")
-			nextEventHeight += 1
+// heightsUpTo returns the leading heights of the batch up to the height
+func heightsUpTo(batch []HeightEvents, height int64) []HeightEvents {
+	for i, heightEvents := range batch {
+		if heightEvents.Height > height {
+			return batch[:i]
 		}
-		<-waitFor(5 * time.Second)
 	}
+	return batch
+}
+
+// SyncProjection synchronously feeds the projection with the events of all the heights after its
+// last handled event height up to the latest height in the event store. Unlike the background
+// runner, it returns the first error instead of retrying, and does not wait for the dependencies of
+// the projection. It is intended for tests and tools.
+func (manager *StoreBasedManager) SyncProjection(projection Projection) error {
+	eventsToListen := projection.GetEventsToListen()
+	logger := manager.logger.WithFields(applogger.LogFields{
+		"projection": projection.Id(),
+	})
+
+	lastHandledEventHeight, err := projection.GetLastHandledEventHeight()
+	if err != nil {
+		return fmt.Errorf("error getting last handled event height from projection: %v", err)
+	}
+	var nextEventHeight int64
+	if lastHandledEventHeight != nil {
+		nextEventHeight = *lastHandledEventHeight + 1
+	}
+
+	latestEventHeight, err := manager.eventStore.GetLatestHeight()
+	if err != nil {
+		return fmt.Errorf("error getting latest event height: %v", err)
+	}
+	if latestEventHeight == nil {
+		return nil
+	}
+
+	for ; nextEventHeight <= *latestEventHeight; nextEventHeight++ {
+		eventLogger := logger.WithFields(applogger.LogFields{
+			"height": nextEventHeight,
+		})
+
+		eventsAtHeight, err := manager.eventStore.GetAllByHeight(nextEventHeight)
+		if err != nil {
+			return fmt.Errorf("error getting all events by height %d: %v", nextEventHeight, err)
+		}
+		if err = handleEvents(eventLogger, projection, eventsToListen, nextEventHeight, eventsAtHeight); err != nil {
+			return fmt.Errorf("error handling events at height %d: %v", nextEventHeight, err)
+		}
+	}
+
+	return nil
+}
+
+// handleEvents passes the listening events at the height to the projection
+func handleEvents(
+	eventLogger applogger.Logger,
+	projection Projection,
+	eventsToListen []string,
+	height int64,
+	eventsAtHeight []entity_event.Event,
+) error {
+	events, err := listeningEvents(eventLogger, eventsAtHeight, eventsToListen)
+	if err != nil {
+		return err
+	}
+
+	eventLogger = eventLogger.WithFields(applogger.LogFields{
+		"eventCount": len(events),
+	})
+	if err := projection.HandleEvents(height, events); err != nil {
+		eventLogger.WithFields(applogger.LogFields{
+			"events": events,
+		}).Errorf("error handling events: %v", err)
+		return err
+	}
+
+	eventLogger.Infof("successfully handled events")
+	return nil
 }
 
 func isListeningEvent(event entity_event.Event, eventsToListen []string) bool {
@@ -141,6 +383,65 @@ func isListeningEvent(event entity_event.Event, eventsToListen []string) bool {
 	return false
 }
 
+// listeningEvents returns the events the projection listens to
+func listeningEvents(
+	eventLogger applogger.Logger,
+	eventsAtHeight []entity_event.Event,
+	eventsToListen []string,
+) ([]entity_event.Event, error) {
+	var events = make([]entity_event.Event, 0)
+	for _, event := range eventsAtHeight {
+		if !isListeningEvent(event, eventsToListen) {
+			//eventLogger.WithFields(applogger.LogFields{
+			//	"eventName": event.Name(),
+			//}).Debugf("skipping because event is not one of the listening events")
+			continue
+		}
+		// Payloads in the blob store are only fetched for the projections listening to them
+		resolvedEvent, err := entity_event.Resolve(event)
+		if err != nil {
+			eventLogger.Errorf("error resolving event %s: %v", event.UUID(), err)
+			return nil, fmt.Errorf("error resolving event %s: %v", event.UUID(), err)
+		}
+		events = append(events, resolvedEvent)
+	}
+
+	return events, nil
+}
+
+// handleEventsBatch passes the listening events of the heights to the projection at once
+func handleEventsBatch(
+	batchLogger applogger.Logger,
+	projection BatchProjection,
+	eventsToListen []string,
+	batch []HeightEvents,
+) error {
+	listeningBatch := make([]HeightEvents, 0, len(batch))
+	eventCount := 0
+	for _, heightEvents := range batch {
+		events, err := listeningEvents(batchLogger, heightEvents.Events, eventsToListen)
+		if err != nil {
+			return err
+		}
+		listeningBatch = append(listeningBatch, HeightEvents{
+			Height: heightEvents.Height,
+			Events: events,
+		})
+		eventCount += len(events)
+	}
+
+	batchLogger = batchLogger.WithFields(applogger.LogFields{
+		"eventCount": eventCount,
+	})
+	if err := projection.HandleEventsBatch(listeningBatch); err != nil {
+		batchLogger.Errorf("error handling events batch: %v", err)
+		return err
+	}
+
+	batchLogger.Infof("successfully handled events batch")
+	return nil
+}
+
 func waitFor(wait time.Duration) <-chan time.Time {
 	return time.After(wait)
 }
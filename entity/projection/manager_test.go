@@ -1,6 +1,7 @@
 package projection_test
 
 import (
+	"errors"
 	"time"
 
 	. "github.com/crypto-com/chain-indexing/entity/event/test"
@@ -242,6 +243,69 @@ var _ = Describe("StoreBasedManager", func() {
 			mockProjection.AssertExpectations(GinkgoT())
 		})
 	})
+
+	Describe("SyncProjection", func() {
+		It("should pass the events of all unhandled heights up to the latest height to projection", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+
+			anyEvent := newAnyEvent()
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(
+				primptr.Int64(int64(1)), nil,
+			)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(3)), nil)
+			mockEventStore.On("GetAllByHeight", int64(2)).Return(
+				[]entity_event.Event{newAnyOtherEvent()}, nil,
+			)
+			mockEventStore.On("GetAllByHeight", int64(3)).Return(
+				[]entity_event.Event{anyEvent}, nil,
+			)
+
+			mockProjection.On("HandleEvents", int64(2), mock.MatchedBy(func(events interface{}) bool {
+				typedEvents, _ := events.([]entity_event.Event)
+				return len(typedEvents) == 0
+			})).Once().Return(nil)
+			mockProjection.On("HandleEvents", int64(3), mock.MatchedBy(func(events interface{}) bool {
+				typedEvents, _ := events.([]entity_event.Event)
+				return len(typedEvents) == 1 && typedEvents[0].Name() == anyEvent.Name()
+			})).Once().Return(nil)
+
+			Expect(manager.SyncProjection(mockProjection)).To(Succeed())
+
+			mockProjection.AssertExpectations(GinkgoT())
+		})
+
+		It("should stop and return error when projection fails to handle events", func() {
+			mockEventStore := NewMockEventStore()
+			manager := projection.NewStoreBasedManager(NewFakeLogger(), mockEventStore)
+			mockProjection := NewMockProjection()
+
+			anyEvent := newAnyEvent()
+			mockProjection.On("Id").Return("ANY_PROJECTION_ID")
+			mockProjection.On("GetEventsToListen").Return([]string{anyEvent.Name()})
+			mockProjection.On("GetLastHandledEventHeight").Return(
+				(*int64)(nil), nil,
+			)
+
+			mockEventStore.On("GetLatestHeight").Return(primptr.Int64(int64(1)), nil)
+			mockEventStore.On("GetAllByHeight", int64(0)).Return(
+				[]entity_event.Event{anyEvent}, nil,
+			)
+
+			mockProjection.On("HandleEvents", int64(0), mock.Anything).Once().Return(errors.New("any error"))
+
+			Expect(manager.SyncProjection(mockProjection)).To(
+				MatchError("error handling events at height 0: any error"),
+			)
+
+			mockProjection.AssertExpectations(GinkgoT())
+			mockEventStore.AssertNotCalled(GinkgoT(), "GetAllByHeight", int64(1))
+		})
+	})
 })
 
 func newAnyEvent() entity_event.Event {
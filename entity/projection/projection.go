@@ -26,3 +26,14 @@ type Projection interface {
 	// projection. It is also responsible to update the last handled event height.
 	HandleEvents(height int64, events []entity_event.Event) error
 }
+
+// BatchProjection is a projection which can also handle the events of consecutive heights at once,
+// e.g. in a single DB transaction. It is passed multiple heights when it is catching up.
+type BatchProjection interface {
+	Projection
+
+	// Handle the events of the heights in order that match `GetEventsToListen()`, as if they were
+	// passed to `HandleEvents()` one height after another. Either all or none of the heights must be
+	// handled, and the last handled event height is updated to the last height.
+	HandleEventsBatch(batch []HeightEvents) error
+}
@@ -0,0 +1,247 @@
+package projection
+
+import (
+	"sync"
+	"time"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+// HeightEvents are the events of a height
+type HeightEvents struct {
+	Height int64
+	Events []entity_event.Event
+}
+
+type ReaderConfig struct {
+	// Number of heights read from the event store at once
+	BatchSize int64
+	// Number of heights buffered for each projection. A projection with a full buffer does not hold
+	// back the other projections.
+	BufferSize int
+	// Maximum number of heights passed to a BatchProjection at once
+	MaxHandleBatchSize int
+	// Interval to check for new heights once all projections have caught up. The reader also checks
+	// on height notifications when they are set, in which case polling is only the fallback.
+	PollInterval time.Duration
+}
+
+func DefaultReaderConfig() ReaderConfig {
+	return ReaderConfig{
+		BatchSize:          100,
+		BufferSize:         1000,
+		MaxHandleBatchSize: 100,
+		PollInterval:       5 * time.Second,
+	}
+}
+
+// SharedEventReader reads the events of consecutive heights from the event store in batches and fans
+// them out to the subscribed projections, so that the events of a height are queried and decoded
+// once for all projections at the same position. Projections at different positions are served in
+// turn, so that a projection catching up from the beginning does not delay the others.
+type SharedEventReader struct {
+	logger     applogger.Logger
+	eventStore entity_event.Store
+	config     ReaderConfig
+
+	mutex           sync.Mutex
+	subscriptions   []*ReaderSubscription
+	lastServedIndex int
+
+	wakeCh chan struct{}
+	// Optional. Nil channel never wakes the reader.
+	maybeHeightNotifications <-chan int64
+}
+
+func NewSharedEventReader(
+	logger applogger.Logger,
+	eventStore entity_event.Store,
+	config ReaderConfig,
+) *SharedEventReader {
+	return &SharedEventReader{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "sharedEventReader",
+		}),
+		eventStore: eventStore,
+		config:     config,
+
+		subscriptions:   make([]*ReaderSubscription, 0),
+		lastServedIndex: -1,
+
+		wakeCh: make(chan struct{}, 1),
+	}
+}
+
+// WithHeightNotifications wakes the reader on the heights committed to the event store, so that the
+// projections do not wait for the poll interval
+func (reader *SharedEventReader) WithHeightNotifications(heights <-chan int64) *SharedEventReader {
+	reader.maybeHeightNotifications = heights
+	return reader
+}
+
+// ReaderSubscription receives the events of the heights from the height it is subscribed at onward
+type ReaderSubscription struct {
+	reader *SharedEventReader
+
+	// Next height to be put in the buffer, guarded by the reader mutex
+	nextHeight int64
+	buffer     chan HeightEvents
+}
+
+// Subscribe returns a subscription receiving the events of the heights from nextHeight onward
+func (reader *SharedEventReader) Subscribe(nextHeight int64) *ReaderSubscription {
+	subscription := &ReaderSubscription{
+		reader: reader,
+
+		nextHeight: nextHeight,
+		buffer:     make(chan HeightEvents, reader.config.BufferSize),
+	}
+
+	reader.mutex.Lock()
+	reader.subscriptions = append(reader.subscriptions, subscription)
+	reader.mutex.Unlock()
+
+	reader.wake()
+	return subscription
+}
+
+// Receive waits for the events of the next height
+func (subscription *ReaderSubscription) Receive() HeightEvents {
+	heightEvents := <-subscription.buffer
+	subscription.reader.wake()
+	return heightEvents
+}
+
+// TryReceive returns the events of the next height if they are already buffered
+func (subscription *ReaderSubscription) TryReceive() (HeightEvents, bool) {
+	select {
+	case heightEvents := <-subscription.buffer:
+		subscription.reader.wake()
+		return heightEvents, true
+	default:
+		return HeightEvents{}, false
+	}
+}
+
+func (reader *SharedEventReader) wake() {
+	select {
+	case reader.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// Run reads and fans out the events forever
+func (reader *SharedEventReader) Run() {
+	for {
+		latestEventHeight, err := reader.eventStore.GetLatestHeight()
+		if err != nil {
+			reader.logger.Errorf("error getting latest event height: %v", err)
+			<-waitFor(time.Second)
+			continue
+		}
+
+		subscription := reader.nextSubscriptionToServe(latestEventHeight)
+		if subscription == nil {
+			select {
+			case <-reader.wakeCh:
+			case height := <-reader.maybeHeightNotifications:
+				reader.logger.Debugf("notified of committed height %d", height)
+			case <-waitFor(reader.config.PollInterval):
+			}
+			continue
+		}
+
+		// Heights the subscription has no buffer space for would be read again later
+		reader.mutex.Lock()
+		fromHeight := subscription.nextHeight
+		bufferSpace := int64(cap(subscription.buffer) - len(subscription.buffer))
+		reader.mutex.Unlock()
+		toHeight := fromHeight + reader.config.BatchSize - 1
+		if toHeight > fromHeight+bufferSpace-1 {
+			toHeight = fromHeight + bufferSpace - 1
+		}
+		if toHeight > *latestEventHeight {
+			toHeight = *latestEventHeight
+		}
+
+		eventsByHeight, err := reader.eventStore.GetAllByHeightRange(fromHeight, toHeight)
+		if err != nil {
+			reader.logger.WithFields(applogger.LogFields{
+				"fromHeight": fromHeight,
+				"toHeight":   toHeight,
+			}).Errorf("error getting all events by height range: %v", err)
+			<-waitFor(time.Second)
+			continue
+		}
+
+		reader.fanOut(fromHeight, toHeight, eventsByHeight)
+	}
+}
+
+// nextSubscriptionToServe returns the subscription after the last served one with buffer space and
+// heights to read, nil when there is none
+func (reader *SharedEventReader) nextSubscriptionToServe(latestEventHeight *int64) *ReaderSubscription {
+	if latestEventHeight == nil {
+		return nil
+	}
+
+	reader.mutex.Lock()
+	defer reader.mutex.Unlock()
+
+	subscriptionCount := len(reader.subscriptions)
+	for i := 1; i <= subscriptionCount; i++ {
+		index := (reader.lastServedIndex + i) % subscriptionCount
+		subscription := reader.subscriptions[index]
+		if subscription.nextHeight > *latestEventHeight {
+			continue
+		}
+		if len(subscription.buffer) == cap(subscription.buffer) {
+			continue
+		}
+
+		reader.lastServedIndex = index
+		return subscription
+	}
+	return nil
+}
+
+// fanOut buffers the read heights for every subscription whose next height is in the range, as many
+// heights as its buffer has space for
+func (reader *SharedEventReader) fanOut(
+	fromHeight int64,
+	toHeight int64,
+	eventsByHeight map[int64][]entity_event.Event,
+) {
+	reader.mutex.Lock()
+	defer reader.mutex.Unlock()
+
+	for _, subscription := range reader.subscriptions {
+		if subscription.nextHeight < fromHeight || subscription.nextHeight > toHeight {
+			continue
+		}
+
+		for height := subscription.nextHeight; height <= toHeight; height++ {
+			events := eventsByHeight[height]
+			if events == nil {
+				events = make([]entity_event.Event, 0)
+			}
+			if !subscription.tryBuffer(HeightEvents{
+				Height: height,
+				Events: events,
+			}) {
+				break
+			}
+			subscription.nextHeight = height + 1
+		}
+	}
+}
+
+func (subscription *ReaderSubscription) tryBuffer(heightEvents HeightEvents) bool {
+	select {
+	case subscription.buffer <- heightEvents:
+		return true
+	default:
+		return false
+	}
+}
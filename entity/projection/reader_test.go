@@ -0,0 +1,251 @@
+package projection_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/crypto-com/chain-indexing/entity/event/test"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+)
+
+var _ = Describe("SharedEventReader", func() {
+	anyReaderConfig := projection.ReaderConfig{
+		BatchSize:          5,
+		BufferSize:         10,
+		MaxHandleBatchSize: 4,
+		PollInterval:       50 * time.Millisecond,
+	}
+
+	It("should read each height range once for all projections at the same position", func() {
+		eventStore := newRangeEventStore(9)
+		manager := projection.NewStoreBasedManager(
+			NewFakeLogger(), eventStore,
+		).WithReaderConfig(anyReaderConfig)
+		anyProjection := newRecordingProjection("AnyProjection", nil)
+		anyOtherProjection := newRecordingProjection("AnyOtherProjection", nil)
+		Expect(manager.RegisterProjection(anyProjection)).To(Succeed())
+		Expect(manager.RegisterProjection(anyOtherProjection)).To(Succeed())
+
+		manager.RunInBackground()
+
+		allHeights := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		Eventually(anyProjection.HandledHeights).Should(Equal(allHeights))
+		Eventually(anyOtherProjection.HandledHeights).Should(Equal(allHeights))
+		Expect(eventStore.ReadRanges()).To(Equal([][2]int64{{0, 4}, {5, 9}}))
+	})
+
+	It("should pass the buffered heights to a BatchProjection at once", func() {
+		eventStore := newRangeEventStore(9)
+		manager := projection.NewStoreBasedManager(
+			NewFakeLogger(), eventStore,
+		).WithReaderConfig(anyReaderConfig)
+		batchProjection := &recordingBatchProjection{
+			recordingProjection: newRecordingProjection("BatchProjection", primptr.Int64(1)),
+		}
+		Expect(manager.RegisterProjection(batchProjection)).To(Succeed())
+
+		manager.RunInBackground()
+
+		Eventually(batchProjection.HandledHeights).Should(Equal([]int64{2, 3, 4, 5, 6, 7, 8, 9}))
+		batchSizes := batchProjection.BatchSizes()
+		Expect(len(batchSizes)).To(BeNumerically("<", 8))
+		for _, batchSize := range batchSizes {
+			Expect(batchSize).To(BeNumerically("<=", 4))
+		}
+	})
+
+	It("should not hold back a projection ahead of a projection with a full buffer", func() {
+		eventStore := newRangeEventStore(1000)
+		reader := projection.NewSharedEventReader(NewFakeLogger(), eventStore, projection.ReaderConfig{
+			BatchSize:    100,
+			BufferSize:   2,
+			PollInterval: 50 * time.Millisecond,
+		})
+		laggingSubscription := reader.Subscribe(0)
+		go reader.Run()
+
+		// The lagging subscription does not receive, so its buffer stays full
+		Eventually(eventStore.ReadRanges).Should(ContainElement([2]int64{0, 1}))
+
+		leadingSubscription := reader.Subscribe(1000)
+		heightEvents := leadingSubscription.Receive()
+		Expect(heightEvents.Height).To(Equal(int64(1000)))
+		Expect(heightEvents.Events).To(HaveLen(1))
+
+		Expect(laggingSubscription.Receive().Height).To(Equal(int64(0)))
+		Expect(laggingSubscription.Receive().Height).To(Equal(int64(1)))
+	})
+
+	It("should read the notified heights without waiting for the poll interval", func() {
+		eventStore := newRangeEventStore(0)
+		heightNotifications := make(chan int64)
+		reader := projection.NewSharedEventReader(NewFakeLogger(), eventStore, projection.ReaderConfig{
+			BatchSize:    100,
+			BufferSize:   10,
+			PollInterval: time.Hour,
+		}).WithHeightNotifications(heightNotifications)
+		subscription := reader.Subscribe(0)
+		go reader.Run()
+		Expect(subscription.Receive().Height).To(Equal(int64(0)))
+
+		eventStore.SetLatestHeight(1)
+		Eventually(heightNotifications).Should(BeSent(int64(1)))
+
+		Eventually(func() bool {
+			heightEvents, ok := subscription.TryReceive()
+			return ok && heightEvents.Height == 1
+		}, time.Second).Should(BeTrue())
+	})
+})
+
+// rangeEventStore has a FakeEvent at every height up to the latest height and records the height
+// ranges read
+type rangeEventStore struct {
+	mutex        sync.Mutex
+	latestHeight int64
+	readRanges   [][2]int64
+}
+
+func newRangeEventStore(latestHeight int64) *rangeEventStore {
+	return &rangeEventStore{
+		latestHeight: latestHeight,
+		readRanges:   make([][2]int64, 0),
+	}
+}
+
+func (store *rangeEventStore) ReadRanges() [][2]int64 {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return append([][2]int64{}, store.readRanges...)
+}
+
+func (store *rangeEventStore) SetLatestHeight(latestHeight int64) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.latestHeight = latestHeight
+}
+
+func (store *rangeEventStore) GetLatestHeight() (*int64, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	return primptr.Int64(store.latestHeight), nil
+}
+
+func (store *rangeEventStore) GetAllByHeight(_ int64) ([]entity_event.Event, error) {
+	panic("events must be read by height range")
+}
+
+func (store *rangeEventStore) GetAllByHeightRange(
+	fromHeight int64,
+	toHeight int64,
+) (map[int64][]entity_event.Event, error) {
+	store.mutex.Lock()
+	store.readRanges = append(store.readRanges, [2]int64{fromHeight, toHeight})
+	store.mutex.Unlock()
+
+	eventsByHeight := make(map[int64][]entity_event.Event)
+	for height := fromHeight; height <= toHeight; height++ {
+		eventsByHeight[height] = []entity_event.Event{NewFakeEvent()}
+	}
+	return eventsByHeight, nil
+}
+
+func (store *rangeEventStore) Insert(_ entity_event.Event) error {
+	return nil
+}
+
+func (store *rangeEventStore) InsertAll(_ []entity_event.Event) error {
+	return nil
+}
+
+type recordingProjection struct {
+	mutex sync.Mutex
+
+	id                     string
+	lastHandledEventHeight *int64
+	handleDelay            time.Duration
+	handledHeights         []int64
+	batchSizes             []int
+}
+
+func newRecordingProjection(id string, lastHandledEventHeight *int64) *recordingProjection {
+	return &recordingProjection{
+		id:                     id,
+		lastHandledEventHeight: lastHandledEventHeight,
+		handledHeights:         make([]int64, 0),
+		batchSizes:             make([]int, 0),
+	}
+}
+
+func (projection *recordingProjection) HandledHeights() []int64 {
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	return append([]int64{}, projection.handledHeights...)
+}
+
+func (projection *recordingProjection) BatchSizes() []int {
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	return append([]int{}, projection.batchSizes...)
+}
+
+func (projection *recordingProjection) Id() string {
+	return projection.id
+}
+func (projection *recordingProjection) GetEventsToListen() []string {
+	return []string{NewFakeEvent().Name()}
+}
+func (projection *recordingProjection) GetLastHandledEventHeight() (*int64, error) {
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	return projection.lastHandledEventHeight, nil
+}
+
+// SetLastHandledEventHeight stands for the heights handled by another process
+func (projection *recordingProjection) SetLastHandledEventHeight(height int64) {
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	projection.lastHandledEventHeight = &height
+}
+func (projection *recordingProjection) OnInit() error {
+	return nil
+}
+func (projection *recordingProjection) HandleEvents(height int64, events []entity_event.Event) error {
+	<-time.After(projection.handleDelay)
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	Expect(events).To(HaveLen(1))
+	projection.handledHeights = append(projection.handledHeights, height)
+	projection.batchSizes = append(projection.batchSizes, 1)
+	return nil
+}
+
+type recordingBatchProjection struct {
+	*recordingProjection
+}
+
+func (projection *recordingBatchProjection) HandleEventsBatch(batch []projection.HeightEvents) error {
+	projection.mutex.Lock()
+	defer projection.mutex.Unlock()
+
+	for _, heightEvents := range batch {
+		Expect(heightEvents.Events).To(HaveLen(1))
+		projection.handledHeights = append(projection.handledHeights, heightEvents.Height)
+	}
+	projection.batchSizes = append(projection.batchSizes, len(batch))
+	return nil
+}
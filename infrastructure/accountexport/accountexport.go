@@ -0,0 +1,154 @@
+package accountexport
+
+import (
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+// AccountExport streams the ledger of an account from the account messages, joined with the fee of
+// their transactions. Rows are converted and written as they are read from the database.
+type AccountExport struct {
+	rdb *rdb.Handle
+
+	accountAddressPrefix string
+}
+
+func NewAccountExport(handle *rdb.Handle, accountAddressPrefix string) *AccountExport {
+	return &AccountExport{
+		handle,
+
+		accountAddressPrefix,
+	}
+}
+
+type Filter struct {
+	// Required account filter
+	Account string
+
+	// Optional block time range. From is inclusive and To is exclusive.
+	MaybeFromTime *utctime.UTCTime
+	MaybeToTime   *utctime.UTCTime
+}
+
+// Export writes the header and the ledger entries of the account in chronological order. The
+// writer is not closed.
+func (accountExport *AccountExport) Export(filter Filter, writer RecordWriter) error {
+	stmtBuilder := accountExport.rdb.StmtBuilder.Select(
+		"view_account_messages.block_height",
+		"view_account_messages.block_time",
+		"view_account_messages.transaction_hash",
+		"view_account_messages.success",
+		"view_account_messages.message_index",
+		"view_account_messages.message_type",
+		"view_account_messages.data",
+		"view_account_transaction_data.fee",
+		"view_account_transaction_data.fee_payer",
+		"view_account_transaction_data.messages -> 0 -> 'content' AS first_message_content",
+	).From(
+		"view_account_messages",
+	).LeftJoin(
+		"view_account_transaction_data ON "+
+			"view_account_messages.block_height = view_account_transaction_data.block_height AND "+
+			"view_account_messages.transaction_hash = view_account_transaction_data.hash",
+	).Where(
+		"view_account_messages.account = ?", filter.Account,
+	)
+	if filter.MaybeFromTime != nil {
+		stmtBuilder = stmtBuilder.Where(
+			"view_account_messages.block_time >= ?", accountExport.rdb.Tton(filter.MaybeFromTime),
+		)
+	}
+	if filter.MaybeToTime != nil {
+		stmtBuilder = stmtBuilder.Where(
+			"view_account_messages.block_time < ?", accountExport.rdb.Tton(filter.MaybeToTime),
+		)
+	}
+	stmtBuilder = stmtBuilder.OrderBy("view_account_messages.id")
+
+	sql, sqlArgs, err := stmtBuilder.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building account export SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if err = writer.Write(COLUMNS); err != nil {
+		return fmt.Errorf("error writing account export header: %v", err)
+	}
+
+	rowsResult, err := accountExport.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error executing account export SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	builder := NewLedgerBuilder(filter.Account, accountExport.accountAddressPrefix)
+	// An account appears twice on a message it both sends and receives, and on every message of a
+	// transaction it is involved in multiple times. The rows of a transaction are consecutive.
+	lastTransactionHash := ""
+	lastMessageIndex := FEE_MESSAGE_INDEX
+	for rowsResult.Next() {
+		var message Message
+		var dataJSON string
+		var maybeFeeJSON *string
+		var maybeFeePayer *string
+		var maybeFirstMessageContentJSON *string
+		blockTimeReader := accountExport.rdb.NtotReader()
+
+		if err = rowsResult.Scan(
+			&message.BlockHeight,
+			blockTimeReader.ScannableArg(),
+			&message.TransactionHash,
+			&message.Success,
+			&message.MessageIndex,
+			&message.MessageType,
+			&dataJSON,
+			&maybeFeeJSON,
+			&maybeFeePayer,
+			&maybeFirstMessageContentJSON,
+		); err != nil {
+			return fmt.Errorf("error scanning account export row: %v: %w", err, rdb.ErrQuery)
+		}
+		blockTime, parseErr := blockTimeReader.Parse()
+		if parseErr != nil {
+			return fmt.Errorf("error parsing account export block time: %v: %w", parseErr, rdb.ErrQuery)
+		}
+		message.BlockTime = *blockTime
+		message.Data = []byte(dataJSON)
+
+		var entries []LedgerEntry
+		if message.TransactionHash != lastTransactionHash {
+			if maybeFeeJSON != nil {
+				if unmarshalErr := jsoniter.UnmarshalFromString(*maybeFeeJSON, &message.Fee); unmarshalErr != nil {
+					return fmt.Errorf("error unmarshalling account export fee JSON: %v: %w", unmarshalErr, rdb.ErrQuery)
+				}
+				message.FeePayer = *maybeFeePayer
+				if maybeFirstMessageContentJSON != nil {
+					message.MaybeFirstMessageContent = []byte(*maybeFirstMessageContentJSON)
+				}
+			}
+
+			if entries, err = builder.FeeEntries(&message); err != nil {
+				return fmt.Errorf("error building fee entries of transaction %s: %v", message.TransactionHash, err)
+			}
+		} else if message.MessageIndex == lastMessageIndex {
+			continue
+		}
+		lastTransactionHash = message.TransactionHash
+		lastMessageIndex = message.MessageIndex
+
+		messageEntries, messageErr := builder.MessageEntries(&message)
+		if messageErr != nil {
+			return fmt.Errorf("error building entries of transaction %s: %v", message.TransactionHash, messageErr)
+		}
+		for _, entry := range append(entries, messageEntries...) {
+			if err = writer.Write(entry.Record()); err != nil {
+				return fmt.Errorf("error writing account export entry: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package accountexport_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAccountExport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AccountExport Suite")
+}
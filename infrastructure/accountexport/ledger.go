@@ -0,0 +1,462 @@
+package accountexport
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+const (
+	CATEGORY_TRANSFER       = "transfer"
+	CATEGORY_FEE            = "fee"
+	CATEGORY_REWARD         = "reward"
+	CATEGORY_COMMISSION     = "commission"
+	CATEGORY_DELEGATE       = "delegate"
+	CATEGORY_UNDELEGATE     = "undelegate"
+	CATEGORY_COMMUNITY_POOL = "community_pool"
+	CATEGORY_DEPOSIT        = "deposit"
+	CATEGORY_NFT_TRANSFER   = "nft_transfer"
+)
+
+const (
+	DIRECTION_IN  = "in"
+	DIRECTION_OUT = "out"
+)
+
+// FEE_MESSAGE_INDEX is the message index of the fee entry of a transaction
+const FEE_MESSAGE_INDEX = -1
+
+// COLUMNS are the header of the exported ledger, in the order of LedgerEntry.Record
+var COLUMNS = []string{
+	"height",
+	"time",
+	"transaction_hash",
+	"message_index",
+	"message_type",
+	"category",
+	"direction",
+	"counterparty",
+	"amount",
+	"denom",
+	"nft_denom_id",
+	"nft_token_id",
+	"settled_at",
+}
+
+// LedgerEntry is a balance-affecting item of an account. Amounts of multiple denominations are
+// split into one entry per denomination.
+type LedgerEntry struct {
+	BlockHeight     int64
+	BlockTime       utctime.UTCTime
+	TransactionHash string
+	MessageIndex    int
+	MessageType     string
+	Category        string
+	Direction       string
+	Counterparty    string
+	Amount          string
+	Denom           string
+	NFTDenomId      string
+	NFTTokenId      string
+	// Time the amount is settled in the balance when it is later than the block time, e.g. the
+	// unbonding completion time of an undelegation. The entry is pending until then.
+	MaybeSettledAt *utctime.UTCTime
+}
+
+func (entry *LedgerEntry) Record() []string {
+	messageIndex := ""
+	if entry.MessageIndex != FEE_MESSAGE_INDEX {
+		messageIndex = strconv.Itoa(entry.MessageIndex)
+	}
+	settledAt := ""
+	if entry.MaybeSettledAt != nil {
+		settledAt = formatTime(*entry.MaybeSettledAt)
+	}
+
+	return []string{
+		strconv.FormatInt(entry.BlockHeight, 10),
+		formatTime(entry.BlockTime),
+		entry.TransactionHash,
+		messageIndex,
+		entry.MessageType,
+		entry.Category,
+		entry.Direction,
+		entry.Counterparty,
+		entry.Amount,
+		entry.Denom,
+		entry.NFTDenomId,
+		entry.NFTTokenId,
+		settledAt,
+	}
+}
+
+func formatTime(value utctime.UTCTime) string {
+	return time.Unix(0, value.UnixNano()).UTC().Format(time.RFC3339)
+}
+
+// Message is a message involving the account, with the fee of its transaction
+type Message struct {
+	BlockHeight     int64
+	BlockTime       utctime.UTCTime
+	TransactionHash string
+	Success         bool
+	MessageIndex    int
+	MessageType     string
+	Data            []byte
+
+	Fee      coin.Coins
+	FeePayer string
+	// Content of the first message of the transaction, which determines the fee payer when it is
+	// not explicitly set
+	MaybeFirstMessageContent []byte
+}
+
+// LedgerBuilder converts the messages of an account to ledger entries
+type LedgerBuilder struct {
+	account              string
+	accountAddressPrefix string
+}
+
+func NewLedgerBuilder(account string, accountAddressPrefix string) *LedgerBuilder {
+	return &LedgerBuilder{
+		account,
+		accountAddressPrefix,
+	}
+}
+
+// FeeEntries returns the fee entries of the message transaction when the account paid for it. The
+// fee is charged even when the transaction failed.
+func (builder *LedgerBuilder) FeeEntries(message *Message) ([]LedgerEntry, error) {
+	feePayer := message.FeePayer
+	if feePayer == "" {
+		if message.MaybeFirstMessageContent == nil {
+			return nil, nil
+		}
+
+		var err error
+		if feePayer, err = builder.signer(message.MaybeFirstMessageContent); err != nil {
+			return nil, fmt.Errorf("error determining fee payer: %v", err)
+		}
+	}
+	if feePayer != builder.account {
+		return nil, nil
+	}
+
+	return builder.coinEntries(message, CATEGORY_FEE, DIRECTION_OUT, "", message.Fee), nil
+}
+
+// MessageEntries returns the entries of the message. Failed messages have no effect on the balance.
+func (builder *LedgerBuilder) MessageEntries(message *Message) ([]LedgerEntry, error) {
+	if !message.Success {
+		return nil, nil
+	}
+
+	var entries []LedgerEntry
+	var err error
+	switch message.MessageType {
+	case event_usecase.MSG_SEND:
+		var msg event_usecase.MsgSend
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		entries = builder.transferEntries(message, CATEGORY_TRANSFER, msg.FromAddress, msg.ToAddress, msg.Amount)
+
+	case event_usecase.MSG_CREATE_VESTING_ACCOUNT:
+		var msg event_usecase.MsgCreateVestingAccount
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		entries = builder.transferEntries(message, CATEGORY_TRANSFER, msg.FromAddress, msg.ToAddress, msg.Amount)
+
+	case event_usecase.MSG_MULTI_SEND:
+		var msg event_usecase.MsgMultiSend
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		// The counterparty is only known when there is a single input or output
+		inputCounterparty := ""
+		if len(msg.Outputs) == 1 {
+			inputCounterparty = msg.Outputs[0].Address
+		}
+		outputCounterparty := ""
+		if len(msg.Inputs) == 1 {
+			outputCounterparty = msg.Inputs[0].Address
+		}
+		for _, input := range msg.Inputs {
+			if input.Address == builder.account {
+				entries = append(entries, builder.coinEntries(
+					message, CATEGORY_TRANSFER, DIRECTION_OUT, inputCounterparty, input.Amount,
+				)...)
+			}
+		}
+		for _, output := range msg.Outputs {
+			if output.Address == builder.account {
+				entries = append(entries, builder.coinEntries(
+					message, CATEGORY_TRANSFER, DIRECTION_IN, outputCounterparty, output.Amount,
+				)...)
+			}
+		}
+
+	case event_usecase.MSG_WITHDRAW_DELEGATOR_REWARD:
+		var msg event_usecase.MsgWithdrawDelegatorReward
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		recipient := msg.RecipientAddress
+		if recipient == "" {
+			recipient = msg.DelegatorAddress
+		}
+		if recipient == builder.account {
+			entries = builder.coinEntries(message, CATEGORY_REWARD, DIRECTION_IN, msg.ValidatorAddress, msg.Amount)
+		}
+
+	case event_usecase.MSG_WITHDRAW_VALIDATOR_COMMISSION:
+		var msg event_usecase.MsgWithdrawValidatorCommission
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.RecipientAddress == builder.account {
+			entries = builder.coinEntries(
+				message, CATEGORY_COMMISSION, DIRECTION_IN, msg.ValidatorAddress, msg.Amount,
+			)
+		}
+
+	case event_usecase.MSG_DELEGATE:
+		var msg event_usecase.MsgDelegate
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.DelegatorAddress == builder.account {
+			entries = builder.coinEntries(
+				message, CATEGORY_DELEGATE, DIRECTION_OUT, msg.ValidatorAddress, coin.NewCoins(msg.Amount),
+			)
+		}
+
+	case event_usecase.MSG_CREATE_VALIDATOR:
+		var msg event_usecase.MsgCreateValidator
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.DelegatorAddress == builder.account {
+			entries = builder.coinEntries(
+				message, CATEGORY_DELEGATE, DIRECTION_OUT, msg.ValidatorAddress, coin.NewCoins(msg.Amount),
+			)
+		}
+
+	case event_usecase.MSG_UNDELEGATE:
+		var msg event_usecase.MsgUndelegate
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.DelegatorAddress == builder.account {
+			// The undelegated amount only returns to the balance once the unbonding completes
+			undelegateEntries := builder.coinEntries(
+				message, CATEGORY_UNDELEGATE, DIRECTION_IN, msg.ValidatorAddress, coin.NewCoins(msg.Amount),
+			)
+			for i := range undelegateEntries {
+				undelegateEntries[i].MaybeSettledAt = msg.MaybeUnbondCompleteAt
+			}
+			entries = append(
+				undelegateEntries,
+				builder.autoClaimedRewardEntries(message, msg.ValidatorAddress, msg.AutoClaimedRewards)...,
+			)
+		}
+
+	case event_usecase.MSG_BEGIN_REDELEGATE:
+		var msg event_usecase.MsgBeginRedelegate
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		// Redelegation moves the bonded amount between validators, only the rewards are withdrawn
+		if msg.DelegatorAddress == builder.account {
+			entries = builder.autoClaimedRewardEntries(message, msg.ValidatorSrcAddress, msg.AutoClaimedRewards)
+		}
+
+	case event_usecase.MSG_FUND_COMMUNITY_POOL:
+		var msg event_usecase.MsgFundCommunityPool
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.Depositor == builder.account {
+			entries = builder.coinEntries(message, CATEGORY_COMMUNITY_POOL, DIRECTION_OUT, "", msg.Amount)
+		}
+
+	case event_usecase.MSG_DEPOSIT:
+		var msg event_usecase.MsgDeposit
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.Depositor == builder.account {
+			entries = builder.coinEntries(message, CATEGORY_DEPOSIT, DIRECTION_OUT, "", msg.Amount)
+		}
+
+	case event_usecase.MSG_SUBMIT_TEXT_PROPOSAL,
+		event_usecase.MSG_SUBMIT_PARAM_CHANGE_PROPOSAL,
+		event_usecase.MSG_SUBMIT_COMMUNITY_POOL_SPEND_PROPOSAL,
+		event_usecase.MSG_SUBMIT_SOFTWARE_UPGRADE_PROPOSAL,
+		event_usecase.MSG_SUBMIT_CANCEL_SOFTWARE_UPGRADE_PROPOSAL:
+		var msg struct {
+			ProposerAddress string     `json:"proposerAddress"`
+			InitialDeposit  coin.Coins `json:"initialDeposit"`
+		}
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.ProposerAddress == builder.account {
+			entries = builder.coinEntries(message, CATEGORY_DEPOSIT, DIRECTION_OUT, "", msg.InitialDeposit)
+		}
+
+	case event_usecase.MSG_NFT_TRANSFER_NFT:
+		var msg event_usecase.MsgNFTTransferNFT
+		if err = jsoniter.Unmarshal(message.Data, &msg); err != nil {
+			break
+		}
+		if msg.Sender == builder.account {
+			entries = append(entries, builder.nftEntry(
+				message, DIRECTION_OUT, msg.Recipient, msg.DenomId, msg.TokenId,
+			))
+		}
+		if msg.Recipient == builder.account {
+			entries = append(entries, builder.nftEntry(
+				message, DIRECTION_IN, msg.Sender, msg.DenomId, msg.TokenId,
+			))
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s message data: %v", message.MessageType, err)
+	}
+
+	return entries, nil
+}
+
+func (builder *LedgerBuilder) transferEntries(
+	message *Message,
+	category string,
+	fromAddress string,
+	toAddress string,
+	amount coin.Coins,
+) []LedgerEntry {
+	var entries []LedgerEntry
+	if fromAddress == builder.account {
+		entries = append(entries, builder.coinEntries(message, category, DIRECTION_OUT, toAddress, amount)...)
+	}
+	if toAddress == builder.account {
+		entries = append(entries, builder.coinEntries(message, category, DIRECTION_IN, fromAddress, amount)...)
+	}
+	return entries
+}
+
+func (builder *LedgerBuilder) autoClaimedRewardEntries(
+	message *Message,
+	validatorAddress string,
+	rewards coin.Coin,
+) []LedgerEntry {
+	if rewards.Amount.IsNil() || rewards.Amount.IsZero() {
+		return nil
+	}
+	return builder.coinEntries(message, CATEGORY_REWARD, DIRECTION_IN, validatorAddress, coin.NewCoins(rewards))
+}
+
+func (builder *LedgerBuilder) coinEntries(
+	message *Message,
+	category string,
+	direction string,
+	counterparty string,
+	amount coin.Coins,
+) []LedgerEntry {
+	messageIndex := message.MessageIndex
+	messageType := message.MessageType
+	if category == CATEGORY_FEE {
+		messageIndex = FEE_MESSAGE_INDEX
+		messageType = ""
+	}
+
+	entries := make([]LedgerEntry, 0, len(amount))
+	for _, amountCoin := range amount {
+		entries = append(entries, LedgerEntry{
+			BlockHeight:     message.BlockHeight,
+			BlockTime:       message.BlockTime,
+			TransactionHash: message.TransactionHash,
+			MessageIndex:    messageIndex,
+			MessageType:     messageType,
+			Category:        category,
+			Direction:       direction,
+			Counterparty:    counterparty,
+			Amount:          amountCoin.Amount.String(),
+			Denom:           amountCoin.Denom,
+		})
+	}
+	return entries
+}
+
+func (builder *LedgerBuilder) nftEntry(
+	message *Message,
+	direction string,
+	counterparty string,
+	denomId string,
+	tokenId string,
+) LedgerEntry {
+	return LedgerEntry{
+		BlockHeight:     message.BlockHeight,
+		BlockTime:       message.BlockTime,
+		TransactionHash: message.TransactionHash,
+		MessageIndex:    message.MessageIndex,
+		MessageType:     message.MessageType,
+		Category:        CATEGORY_NFT_TRANSFER,
+		Direction:       direction,
+		Counterparty:    counterparty,
+		Amount:          "1",
+		Denom:           "",
+		NFTDenomId:      denomId,
+		NFTTokenId:      tokenId,
+	}
+}
+
+// signer returns the account signing the message, which pays the fee when the fee payer is not
+// set. Validator operator addresses are converted to their account addresses.
+func (builder *LedgerBuilder) signer(content []byte) (string, error) {
+	var signers struct {
+		FromAddress      string `json:"fromAddress"`
+		DelegatorAddress string `json:"delegatorAddress"`
+		Depositor        string `json:"depositor"`
+		Voter            string `json:"voter"`
+		ProposerAddress  string `json:"proposerAddress"`
+		Sender           string `json:"sender"`
+		Inputs           []struct {
+			Address string `json:"address"`
+		} `json:"inputs"`
+		ValidatorAddress string `json:"validatorAddress"`
+	}
+	if err := jsoniter.Unmarshal(content, &signers); err != nil {
+		return "", err
+	}
+
+	for _, address := range []string{
+		signers.FromAddress,
+		signers.DelegatorAddress,
+		signers.Depositor,
+		signers.Voter,
+		signers.ProposerAddress,
+		signers.Sender,
+	} {
+		if address != "" {
+			return address, nil
+		}
+	}
+	if len(signers.Inputs) != 0 {
+		return signers.Inputs[0].Address, nil
+	}
+
+	if signers.ValidatorAddress != "" {
+		return tmcosmosutils.AccountAddressFromValidatorAddress(builder.accountAddressPrefix, signers.ValidatorAddress)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,185 @@
+package accountexport_test
+
+import (
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/accountexport"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("LedgerBuilder", func() {
+	const anyAccount = "tcro165tzcrh2yl83g8qeqxueg2g5gzgu57y3fe3kc3"
+	const anyOtherAccount = "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3"
+	const anyValidator = "tcrocncl1fmprm0sjy6lz9llv7rltn0v2azzwcwzvr4ufus"
+	const anyTxHash = "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+
+	anyBlockTime := utctime.FromUnixNano(int64(1609459200000000000))
+	anyMsgCommonParams := event_usecase.MsgCommonParams{
+		BlockHeight: 1000,
+		TxHash:      anyTxHash,
+		TxSuccess:   true,
+		MsgIndex:    1,
+	}
+
+	var builder *accountexport.LedgerBuilder
+	BeforeEach(func() {
+		builder = accountexport.NewLedgerBuilder(anyAccount, "tcro")
+	})
+
+	newMessage := func(messageType string, data interface{}, success bool) *accountexport.Message {
+		encoded, err := jsoniter.Marshal(data)
+		Expect(err).To(BeNil())
+
+		return &accountexport.Message{
+			BlockHeight:     1000,
+			BlockTime:       anyBlockTime,
+			TransactionHash: anyTxHash,
+			Success:         success,
+			MessageIndex:    1,
+			MessageType:     messageType,
+			Data:            encoded,
+		}
+	}
+
+	Describe("MessageEntries", func() {
+		It("should return one outgoing entry per denomination of a sent amount", func() {
+			message := newMessage(event_usecase.MSG_SEND, event_usecase.NewMsgSend(
+				anyMsgCommonParams,
+				event_usecase.MsgSendCreatedParams{
+					FromAddress: anyAccount,
+					ToAddress:   anyOtherAccount,
+					Amount:      coin.MustParseCoinsNormalized("100basetcro,2tcro"),
+				},
+			), true)
+
+			entries, err := builder.MessageEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].Record()).To(Equal([]string{
+				"1000",
+				"2021-01-01T00:00:00Z",
+				anyTxHash,
+				"1",
+				event_usecase.MSG_SEND,
+				accountexport.CATEGORY_TRANSFER,
+				accountexport.DIRECTION_OUT,
+				anyOtherAccount,
+				"100",
+				"basetcro",
+				"",
+				"",
+				"",
+			}))
+			Expect(entries[1].Direction).To(Equal(accountexport.DIRECTION_OUT))
+		})
+
+		It("should return incoming entries of a received amount", func() {
+			message := newMessage(event_usecase.MSG_SEND, event_usecase.NewMsgSend(
+				anyMsgCommonParams,
+				event_usecase.MsgSendCreatedParams{
+					FromAddress: anyOtherAccount,
+					ToAddress:   anyAccount,
+					Amount:      coin.MustParseCoinsNormalized("100basetcro"),
+				},
+			), true)
+
+			entries, err := builder.MessageEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Direction).To(Equal(accountexport.DIRECTION_IN))
+			Expect(entries[0].Counterparty).To(Equal(anyOtherAccount))
+		})
+
+		It("should return undelegated amount settled at unbonding completion and auto-claimed rewards", func() {
+			unbondCompleteAt := utctime.FromUnixNano(int64(1611792000000000000))
+			message := newMessage(event_usecase.MSG_UNDELEGATE, event_usecase.NewMsgUndelegate(
+				anyMsgCommonParams,
+				model.MsgUndelegateParams{
+					DelegatorAddress:      anyAccount,
+					ValidatorAddress:      anyValidator,
+					Amount:                coin.NewInt64Coin("basetcro", 500),
+					AutoClaimedRewards:    coin.NewInt64Coin("basetcro", 7),
+					MaybeUnbondCompleteAt: &unbondCompleteAt,
+				},
+			), true)
+
+			entries, err := builder.MessageEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].Category).To(Equal(accountexport.CATEGORY_UNDELEGATE))
+			Expect(entries[0].Amount).To(Equal("500"))
+			Expect(entries[0].Record()[1]).To(Equal("2021-01-01T00:00:00Z"))
+			Expect(entries[0].Record()[12]).To(Equal("2021-01-28T00:00:00Z"))
+			Expect(entries[1].Category).To(Equal(accountexport.CATEGORY_REWARD))
+			Expect(entries[1].MaybeSettledAt).To(BeNil())
+			Expect(entries[1].Amount).To(Equal("7"))
+			Expect(entries[1].Counterparty).To(Equal(anyValidator))
+		})
+
+		It("should return NFT transfer entry with the token", func() {
+			message := newMessage(event_usecase.MSG_NFT_TRANSFER_NFT, event_usecase.NewMsgNFTTransferNFT(
+				anyMsgCommonParams,
+				model.MsgNFTTransferNFTParams{
+					DenomId:   "denomid",
+					TokenId:   "tokenid",
+					Sender:    anyOtherAccount,
+					Recipient: anyAccount,
+				},
+			), true)
+
+			entries, err := builder.MessageEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Category).To(Equal(accountexport.CATEGORY_NFT_TRANSFER))
+			Expect(entries[0].Direction).To(Equal(accountexport.DIRECTION_IN))
+			Expect(entries[0].NFTDenomId).To(Equal("denomid"))
+			Expect(entries[0].NFTTokenId).To(Equal("tokenid"))
+		})
+
+		It("should return no entry for failed message", func() {
+			message := newMessage(event_usecase.MSG_SEND, event_usecase.NewMsgSend(
+				anyMsgCommonParams,
+				event_usecase.MsgSendCreatedParams{
+					FromAddress: anyAccount,
+					ToAddress:   anyOtherAccount,
+					Amount:      coin.MustParseCoinsNormalized("100basetcro"),
+				},
+			), false)
+
+			entries, err := builder.MessageEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+
+	Describe("FeeEntries", func() {
+		It("should charge the fee to the signer of the first message when fee payer is not set", func() {
+			message := newMessage(event_usecase.MSG_VOTE, struct{}{}, false)
+			message.Fee = coin.MustParseCoinsNormalized("5000basetcro")
+			message.MaybeFirstMessageContent = []byte(`{"delegatorAddress":"` + anyAccount + `"}`)
+
+			entries, err := builder.FeeEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Category).To(Equal(accountexport.CATEGORY_FEE))
+			Expect(entries[0].Direction).To(Equal(accountexport.DIRECTION_OUT))
+			Expect(entries[0].Amount).To(Equal("5000"))
+			Expect(entries[0].Record()[3]).To(BeEmpty())
+		})
+
+		It("should not charge the fee when the account is not the fee payer", func() {
+			message := newMessage(event_usecase.MSG_SEND, struct{}{}, true)
+			message.Fee = coin.MustParseCoinsNormalized("5000basetcro")
+			message.FeePayer = anyOtherAccount
+
+			entries, err := builder.FeeEntries(message)
+			Expect(err).To(BeNil())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
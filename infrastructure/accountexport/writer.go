@@ -0,0 +1,191 @@
+package accountexport
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+const (
+	FORMAT_CSV  = "csv"
+	FORMAT_XLSX = "xlsx"
+)
+
+var FORMATS = []string{
+	FORMAT_CSV,
+	FORMAT_XLSX,
+}
+
+// RecordWriter writes the records of a table to the underlying writer as they come. Close must be
+// called to complete the output.
+type RecordWriter interface {
+	Write(record []string) error
+	Close() error
+}
+
+// ContentType returns the MIME type of the format
+func ContentType(format string) (string, error) {
+	switch format {
+	case FORMAT_CSV:
+		return "text/csv; charset=utf-8", nil
+	case FORMAT_XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", nil
+	default:
+		return "", fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func NewRecordWriter(format string, writer io.Writer) (RecordWriter, error) {
+	switch format {
+	case FORMAT_CSV:
+		return NewCSVWriter(writer), nil
+	case FORMAT_XLSX:
+		return NewXLSXWriter(writer), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+type CSVWriter struct {
+	writer *csv.Writer
+}
+
+func NewCSVWriter(writer io.Writer) *CSVWriter {
+	return &CSVWriter{
+		csv.NewWriter(writer),
+	}
+}
+
+func (writer *CSVWriter) Write(record []string) error {
+	return writer.writer.Write(record)
+}
+
+func (writer *CSVWriter) Close() error {
+	writer.writer.Flush()
+	return writer.writer.Error()
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+		`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+		`Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Ledger" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" ` +
+		`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" ` +
+		`Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// XLSXWriter writes a single-sheet workbook. The rows are streamed into the zip archive, so the
+// workbook is never held in memory. Cells are written as inline strings to keep the amounts exact.
+type XLSXWriter struct {
+	zipWriter   *zip.Writer
+	sheetWriter io.Writer
+
+	err error
+}
+
+func NewXLSXWriter(writer io.Writer) *XLSXWriter {
+	return &XLSXWriter{
+		zipWriter: zip.NewWriter(writer),
+	}
+}
+
+func (writer *XLSXWriter) Write(record []string) error {
+	if writer.err != nil {
+		return writer.err
+	}
+	if writer.sheetWriter == nil {
+		if writer.err = writer.start(); writer.err != nil {
+			return writer.err
+		}
+	}
+
+	if _, writer.err = io.WriteString(writer.sheetWriter, "<row>"); writer.err != nil {
+		return writer.err
+	}
+	for _, value := range record {
+		if _, writer.err = io.WriteString(writer.sheetWriter, `<c t="inlineStr"><is><t>`); writer.err != nil {
+			return writer.err
+		}
+		if writer.err = xml.EscapeText(writer.sheetWriter, []byte(value)); writer.err != nil {
+			return writer.err
+		}
+		if _, writer.err = io.WriteString(writer.sheetWriter, "</t></is></c>"); writer.err != nil {
+			return writer.err
+		}
+	}
+	_, writer.err = io.WriteString(writer.sheetWriter, "</row>")
+	return writer.err
+}
+
+// start writes the workbook parts preceding the sheet and opens the sheet
+func (writer *XLSXWriter) start() error {
+	for _, part := range []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	} {
+		partWriter, err := writer.zipWriter.Create(part.name)
+		if err != nil {
+			return fmt.Errorf("error creating workbook part %s: %v", part.name, err)
+		}
+		if _, err = io.WriteString(partWriter, part.content); err != nil {
+			return fmt.Errorf("error writing workbook part %s: %v", part.name, err)
+		}
+	}
+
+	sheetWriter, err := writer.zipWriter.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return fmt.Errorf("error creating worksheet: %v", err)
+	}
+	if _, err = io.WriteString(sheetWriter, xlsxSheetHeader); err != nil {
+		return fmt.Errorf("error writing worksheet: %v", err)
+	}
+	writer.sheetWriter = sheetWriter
+
+	return nil
+}
+
+func (writer *XLSXWriter) Close() error {
+	if writer.err != nil {
+		return writer.err
+	}
+	if writer.sheetWriter == nil {
+		if err := writer.start(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(writer.sheetWriter, xlsxSheetFooter); err != nil {
+		return fmt.Errorf("error writing worksheet: %v", err)
+	}
+	return writer.zipWriter.Close()
+}
@@ -0,0 +1,59 @@
+package accountexport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/accountexport"
+)
+
+var _ = Describe("RecordWriter", func() {
+	It("should write CSV records", func() {
+		var buffer bytes.Buffer
+		writer, err := accountexport.NewRecordWriter(accountexport.FORMAT_CSV, &buffer)
+		Expect(err).To(BeNil())
+
+		Expect(writer.Write([]string{"height", "counterparty"})).To(Succeed())
+		Expect(writer.Write([]string{"1", "a,b"})).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+
+		Expect(buffer.String()).To(Equal("height,counterparty\n1,\"a,b\"\n"))
+	})
+
+	It("should write XLSX workbook with escaped inline strings", func() {
+		var buffer bytes.Buffer
+		writer, err := accountexport.NewRecordWriter(accountexport.FORMAT_XLSX, &buffer)
+		Expect(err).To(BeNil())
+
+		Expect(writer.Write([]string{"height", "counterparty"})).To(Succeed())
+		Expect(writer.Write([]string{"1", "<a&b>"})).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+
+		reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		Expect(err).To(BeNil())
+
+		files := make(map[string]string)
+		for _, file := range reader.File {
+			fileReader, openErr := file.Open()
+			Expect(openErr).To(BeNil())
+			content, readErr := ioutil.ReadAll(fileReader)
+			Expect(readErr).To(BeNil())
+			files[file.Name] = string(content)
+		}
+		Expect(files).To(HaveKey("[Content_Types].xml"))
+		Expect(files).To(HaveKey("xl/workbook.xml"))
+		Expect(files["xl/worksheets/sheet1.xml"]).To(ContainSubstring(
+			`<row><c t="inlineStr"><is><t>1</t></is></c><c t="inlineStr"><is><t>&lt;a&amp;b&gt;</t></is></c></row>`,
+		))
+		Expect(files["xl/worksheets/sheet1.xml"]).To(HaveSuffix("</sheetData></worksheet>"))
+	})
+
+	It("should reject unsupported format", func() {
+		_, err := accountexport.NewRecordWriter("pdf", &bytes.Buffer{})
+		Expect(err).NotTo(BeNil())
+	})
+})
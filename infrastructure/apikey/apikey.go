@@ -0,0 +1,56 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+// All generated keys start with KEY_PREFIX, which makes leaked keys easy to recognize
+const KEY_PREFIX = "ci_"
+
+// Number of leading characters of a key which are stored in plain text to identify the key
+const DISPLAY_PREFIX_LENGTH = 11
+
+const keyRandomBytes = 32
+
+type APIKey struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	// Leading characters of the key. The key itself is never stored.
+	KeyPrefix      string           `json:"keyPrefix"`
+	Tier           string           `json:"tier"`
+	CreatedAt      utctime.UTCTime  `json:"createdAt"`
+	MaybeRevokedAt *utctime.UTCTime `json:"revokedAt"`
+}
+
+func (apiKey *APIKey) IsRevoked() bool {
+	return apiKey.MaybeRevokedAt != nil
+}
+
+// GenerateKey returns a new random API key
+func GenerateKey() (string, error) {
+	randomBytes := make([]byte, keyRandomBytes)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", fmt.Errorf("error generating random API key: %v", err)
+	}
+
+	return KEY_PREFIX + hex.EncodeToString(randomBytes), nil
+}
+
+// HashKey returns the hash of the key persisted in the store
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// DisplayPrefix returns the leading characters of the key persisted in the store
+func DisplayPrefix(key string) string {
+	if len(key) <= DISPLAY_PREFIX_LENGTH {
+		return key
+	}
+	return key[:DISPLAY_PREFIX_LENGTH]
+}
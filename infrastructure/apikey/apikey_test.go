@@ -0,0 +1,155 @@
+package apikey_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/apikey"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+var _ = Describe("APIKey", func() {
+	Describe("GenerateKey", func() {
+		It("should generate distinct prefixed keys", func() {
+			key, err := apikey.GenerateKey()
+			Expect(err).To(BeNil())
+			anotherKey, err := apikey.GenerateKey()
+			Expect(err).To(BeNil())
+
+			Expect(key).To(HavePrefix(apikey.KEY_PREFIX))
+			Expect(key).To(HaveLen(len(apikey.KEY_PREFIX) + 64))
+			Expect(key).NotTo(Equal(anotherKey))
+			Expect(apikey.HashKey(key)).NotTo(Equal(apikey.HashKey(anotherKey)))
+			Expect(apikey.DisplayPrefix(key)).To(HaveLen(apikey.DISPLAY_PREFIX_LENGTH))
+		})
+	})
+
+	Describe("CachedResolver", func() {
+		It("should cache the lookups, including unknown keys, until the TTL expires", func() {
+			finder := &fakeKeyFinder{
+				apiKeys: map[string]*apikey.APIKey{
+					"ci_active": {Id: 1},
+				},
+			}
+			resolver := apikey.NewCachedResolver(finder, time.Minute)
+
+			apiKey, err := resolver.Resolve("ci_active")
+			Expect(err).To(BeNil())
+			Expect(apiKey.Id).To(Equal(int64(1)))
+			_, err = resolver.Resolve("ci_unknown")
+			Expect(err).To(Equal(apikey.ErrInvalidKey))
+
+			_, _ = resolver.Resolve("ci_active")
+			_, _ = resolver.Resolve("ci_unknown")
+			Expect(finder.findCount).To(Equal(2))
+		})
+
+		It("should cap the cached invalid keys", func() {
+			finder := &fakeKeyFinder{
+				apiKeys: map[string]*apikey.APIKey{
+					"ci_active": {Id: 1},
+				},
+			}
+			resolver := apikey.NewCachedResolver(finder, time.Minute).WithMaxCachedInvalidKeys(2)
+
+			_, _ = resolver.Resolve("ci_active")
+			for _, key := range []string{"ci_unknown", "ci_another_unknown", "ci_yet_another_unknown"} {
+				_, err := resolver.Resolve(key)
+				Expect(err).To(Equal(apikey.ErrInvalidKey))
+			}
+			Expect(resolver.Size()).To(Equal(3))
+			Expect(resolver.ResolveCached("ci_active").Id).To(Equal(int64(1)))
+			Expect(resolver.ResolveCached("ci_unknown")).To(BeNil())
+		})
+
+		It("should reject revoked key", func() {
+			revokedAt := utctime.Now()
+			resolver := apikey.NewCachedResolver(&fakeKeyFinder{
+				apiKeys: map[string]*apikey.APIKey{
+					"ci_revoked": {Id: 1, MaybeRevokedAt: &revokedAt},
+				},
+			}, time.Minute)
+
+			_, err := resolver.Resolve("ci_revoked")
+			Expect(err).To(Equal(apikey.ErrInvalidKey))
+		})
+	})
+
+	Describe("UsageRecorder", func() {
+		anyTime := utctime.FromUnixNano(int64(1609545599000000000))
+		anyNowFn := func() utctime.UTCTime {
+			return anyTime
+		}
+
+		It("should accumulate usages by date and write them on flush", func() {
+			store := &fakeUsageStore{}
+			recorder := apikey.NewUsageRecorder(store).WithNowFn(anyNowFn)
+
+			recorder.Record(1, false)
+			recorder.Record(1, true)
+			recorder.Record(2, false)
+			Expect(store.usages).To(BeEmpty())
+
+			Expect(recorder.Flush()).To(Succeed())
+			Expect(store.usages).To(Equal(map[string]map[int64]apikey.Usage{
+				"2021-01-01": {
+					1: {RequestCount: 2, RejectedCount: 1},
+					2: {RequestCount: 1, RejectedCount: 0},
+				},
+			}))
+
+			store.usages = nil
+			Expect(recorder.Flush()).To(Succeed())
+			Expect(store.usages).To(BeEmpty())
+		})
+
+		It("should keep the usages for the next flush when writing fails", func() {
+			store := &fakeUsageStore{
+				maybeErr: errors.New("connection lost"),
+			}
+			recorder := apikey.NewUsageRecorder(store).WithNowFn(anyNowFn)
+
+			recorder.Record(1, false)
+			Expect(recorder.Flush()).NotTo(Succeed())
+
+			recorder.Record(1, false)
+			store.maybeErr = nil
+			Expect(recorder.Flush()).To(Succeed())
+			Expect(store.usages["2021-01-01"][1].RequestCount).To(Equal(int64(2)))
+		})
+	})
+})
+
+type fakeKeyFinder struct {
+	apiKeys   map[string]*apikey.APIKey
+	findCount int
+}
+
+func (finder *fakeKeyFinder) FindByKey(key string) (*apikey.APIKey, error) {
+	finder.findCount += 1
+	apiKey, ok := finder.apiKeys[key]
+	if !ok {
+		return nil, rdb.ErrNoRows
+	}
+	return apiKey, nil
+}
+
+type fakeUsageStore struct {
+	usages   map[string]map[int64]apikey.Usage
+	maybeErr error
+}
+
+func (store *fakeUsageStore) IncrementUsages(date string, usages map[int64]apikey.Usage) error {
+	if store.maybeErr != nil {
+		return store.maybeErr
+	}
+	if store.usages == nil {
+		store.usages = make(map[string]map[int64]apikey.Usage)
+	}
+	store.usages[date] = usages
+	return nil
+}
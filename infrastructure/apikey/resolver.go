@@ -0,0 +1,164 @@
+package apikey
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+var ErrInvalidKey = errors.New("invalid API key")
+
+// KeyFinder looks up the API key record of a key. It is fulfilled by Store.
+type KeyFinder interface {
+	FindByKey(key string) (*APIKey, error)
+}
+
+// Default maximum number of unknown or revoked keys cached. Random keys evict each other beyond it,
+// so that they cannot grow the cache unbounded.
+const DEFAULT_MAX_CACHED_INVALID_KEYS = 10000
+
+// CachedResolver resolves keys to active API keys and caches the result, including unknown keys,
+// for a period so that the store is not queried on every request. Tier changes and revocations
+// take effect after the cache entry expires.
+type CachedResolver struct {
+	finder               KeyFinder
+	ttl                  time.Duration
+	maxCachedInvalidKeys int
+
+	mutex sync.Mutex
+	// key hash -> entry of active key
+	entries map[string]resolverEntry
+	// key hash -> expiry of unknown or revoked key
+	invalidKeyExpireAts map[string]time.Time
+	lastEvictedAt       time.Time
+
+	nowFn func() time.Time
+}
+
+type resolverEntry struct {
+	apiKey   *APIKey
+	expireAt time.Time
+}
+
+func NewCachedResolver(finder KeyFinder, ttl time.Duration) *CachedResolver {
+	return &CachedResolver{
+		finder:               finder,
+		ttl:                  ttl,
+		maxCachedInvalidKeys: DEFAULT_MAX_CACHED_INVALID_KEYS,
+
+		entries:             make(map[string]resolverEntry),
+		invalidKeyExpireAts: make(map[string]time.Time),
+
+		nowFn: time.Now,
+	}
+}
+
+func (resolver *CachedResolver) WithMaxCachedInvalidKeys(maxCachedInvalidKeys int) *CachedResolver {
+	resolver.maxCachedInvalidKeys = maxCachedInvalidKeys
+	return resolver
+}
+
+// ResolveCached returns the active API key of the key when it is cached, nil when the key is invalid
+// or has to be looked up in the store
+func (resolver *CachedResolver) ResolveCached(key string) *APIKey {
+	keyHash := HashKey(key)
+	now := resolver.nowFn()
+
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	entry, ok := resolver.entries[keyHash]
+	if !ok || now.After(entry.expireAt) {
+		return nil
+	}
+	return entry.apiKey
+}
+
+// Resolve returns the active API key of the key. It returns ErrInvalidKey when the key is unknown
+// or revoked.
+func (resolver *CachedResolver) Resolve(key string) (*APIKey, error) {
+	keyHash := HashKey(key)
+	now := resolver.nowFn()
+
+	resolver.mutex.Lock()
+	entry, isValidCached := resolver.entries[keyHash]
+	isValidCached = isValidCached && !now.After(entry.expireAt)
+	invalidExpireAt, isInvalidCached := resolver.invalidKeyExpireAts[keyHash]
+	isInvalidCached = isInvalidCached && !now.After(invalidExpireAt)
+	resolver.mutex.Unlock()
+	if isValidCached {
+		return entry.apiKey, nil
+	}
+	if isInvalidCached {
+		return nil, ErrInvalidKey
+	}
+
+	apiKey, err := resolver.finder.FindByKey(key)
+	if err != nil && !errors.Is(err, rdb.ErrNoRows) {
+		return nil, err
+	}
+	if apiKey != nil && apiKey.IsRevoked() {
+		apiKey = nil
+	}
+
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	resolver.evictExpired(now)
+	expireAt := now.Add(resolver.ttl)
+	if apiKey == nil {
+		delete(resolver.entries, keyHash)
+		resolver.cacheInvalidKey(keyHash, expireAt)
+		return nil, ErrInvalidKey
+	}
+
+	delete(resolver.invalidKeyExpireAts, keyHash)
+	resolver.entries[keyHash] = resolverEntry{
+		apiKey:   apiKey,
+		expireAt: expireAt,
+	}
+	return apiKey, nil
+}
+
+// cacheInvalidKey caches the invalid key, evicting other invalid keys when the cache is full.
+// Caller must hold the mutex.
+func (resolver *CachedResolver) cacheInvalidKey(keyHash string, expireAt time.Time) {
+	for cachedKeyHash := range resolver.invalidKeyExpireAts {
+		if len(resolver.invalidKeyExpireAts) < resolver.maxCachedInvalidKeys {
+			break
+		}
+		delete(resolver.invalidKeyExpireAts, cachedKeyHash)
+	}
+	if resolver.maxCachedInvalidKeys > 0 {
+		resolver.invalidKeyExpireAts[keyHash] = expireAt
+	}
+}
+
+// Size returns the number of keys cached
+func (resolver *CachedResolver) Size() int {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	return len(resolver.entries) + len(resolver.invalidKeyExpireAts)
+}
+
+// evictExpired removes the expired entries at most once per TTL. Caller must hold the mutex.
+func (resolver *CachedResolver) evictExpired(now time.Time) {
+	if now.Sub(resolver.lastEvictedAt) < resolver.ttl {
+		return
+	}
+	resolver.lastEvictedAt = now
+
+	for keyHash, entry := range resolver.entries {
+		if now.After(entry.expireAt) {
+			delete(resolver.entries, keyHash)
+		}
+	}
+	for keyHash, expireAt := range resolver.invalidKeyExpireAts {
+		if now.After(expireAt) {
+			delete(resolver.invalidKeyExpireAts, keyHash)
+		}
+	}
+}
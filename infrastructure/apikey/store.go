@@ -0,0 +1,270 @@
+package apikey
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const USAGE_DATE_LAYOUT = "2006-01-02"
+
+// Store persists API keys and their usage counters in the api_keys and api_key_usages tables
+type Store struct {
+	rdb *rdb.Handle
+}
+
+func NewStore(handle *rdb.Handle) *Store {
+	return &Store{
+		handle,
+	}
+}
+
+// Create generates and persists a new API key. The returned key is not recoverable afterwards.
+func (store *Store) Create(name string, tier string) (*APIKey, string, error) {
+	key, err := GenerateKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	createdAt := utctime.Now()
+	sql, sqlArgs, err := store.rdb.StmtBuilder.Insert(
+		"api_keys",
+	).Columns(
+		"name",
+		"key_prefix",
+		"key_hash",
+		"tier",
+		"created_at",
+	).Values(
+		name,
+		DisplayPrefix(key),
+		HashKey(key),
+		tier,
+		store.rdb.Tton(&createdAt),
+	).Suffix("RETURNING id").ToSql()
+	if err != nil {
+		return nil, "", fmt.Errorf("error building API key insertion SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	var id int64
+	if err = store.rdb.QueryRow(sql, sqlArgs...).Scan(&id); err != nil {
+		return nil, "", fmt.Errorf("error inserting API key: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return &APIKey{
+		Id:             id,
+		Name:           name,
+		KeyPrefix:      DisplayPrefix(key),
+		Tier:           tier,
+		CreatedAt:      createdAt,
+		MaybeRevokedAt: nil,
+	}, key, nil
+}
+
+// FindByKey returns the API key record of the key, including revoked ones. It returns
+// rdb.ErrNoRows when the key is unknown.
+func (store *Store) FindByKey(key string) (*APIKey, error) {
+	sql, sqlArgs, err := store.selectStmt().Where("key_hash = ?", HashKey(key)).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building API key selection SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	apiKey, err := store.scan(store.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning API key: %v: %w", err, rdb.ErrQuery)
+	}
+
+	return apiKey, nil
+}
+
+func (store *Store) FindById(id int64) (*APIKey, error) {
+	sql, sqlArgs, err := store.selectStmt().Where("id = ?", id).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building API key selection SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	apiKey, err := store.scan(store.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning API key: %v: %w", err, rdb.ErrQuery)
+	}
+
+	return apiKey, nil
+}
+
+func (store *Store) List() ([]APIKey, error) {
+	sql, sqlArgs, err := store.selectStmt().OrderBy("id").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building API keys selection SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := store.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing API keys selection SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	apiKeys := make([]APIKey, 0)
+	for rowsResult.Next() {
+		apiKey, scanErr := store.scan(rowsResult)
+		if scanErr != nil {
+			return nil, fmt.Errorf("error scanning API key row: %v: %w", scanErr, rdb.ErrQuery)
+		}
+		apiKeys = append(apiKeys, *apiKey)
+	}
+
+	return apiKeys, nil
+}
+
+func (store *Store) SetTier(id int64, tier string) error {
+	sql, sqlArgs, err := store.rdb.StmtBuilder.Update(
+		"api_keys",
+	).Set("tier", tier).Where("id = ?", id).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building API key tier update SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := store.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error updating API key tier: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error updating API key tier: no row updated: %w", rdb.ErrNoRows)
+	}
+
+	return nil
+}
+
+// Revoke revokes the API key. Revoking an already revoked key is a no-op.
+func (store *Store) Revoke(id int64) error {
+	revokedAt := utctime.Now()
+	sql, sqlArgs, err := store.rdb.StmtBuilder.Update(
+		"api_keys",
+	).Set(
+		"revoked_at", store.rdb.Tton(&revokedAt),
+	).Where(
+		"id = ? AND revoked_at IS NULL", id,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building API key revocation SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = store.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error revoking API key: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// IncrementUsages adds the usage counters to the daily counters of the API keys
+func (store *Store) IncrementUsages(date string, usages map[int64]Usage) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	stmtBuilder := store.rdb.StmtBuilder.Insert(
+		"api_key_usages AS usages",
+	).Columns(
+		"api_key_id",
+		"date",
+		"request_count",
+		"rejected_count",
+	)
+	for apiKeyId, usage := range usages {
+		stmtBuilder = stmtBuilder.Values(apiKeyId, date, usage.RequestCount, usage.RejectedCount)
+	}
+	sql, sqlArgs, err := stmtBuilder.Suffix(
+		"ON CONFLICT (api_key_id, date) DO UPDATE SET " +
+			"request_count = usages.request_count + EXCLUDED.request_count, " +
+			"rejected_count = usages.rejected_count + EXCLUDED.rejected_count",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building API key usages insertion SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = store.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error inserting API key usages: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+// ListUsages returns the daily usage counters of the API key since the date, latest first
+func (store *Store) ListUsages(apiKeyId int64, sinceDate string) ([]DailyUsage, error) {
+	sql, sqlArgs, err := store.rdb.StmtBuilder.Select(
+		"date::TEXT",
+		"request_count",
+		"rejected_count",
+	).From(
+		"api_key_usages",
+	).Where(
+		"api_key_id = ? AND date >= ?", apiKeyId, sinceDate,
+	).OrderBy("date DESC").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building API key usages selection SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := store.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing API key usages selection SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	usages := make([]DailyUsage, 0)
+	for rowsResult.Next() {
+		var usage DailyUsage
+		if scanErr := rowsResult.Scan(
+			&usage.Date,
+			&usage.RequestCount,
+			&usage.RejectedCount,
+		); scanErr != nil {
+			return nil, fmt.Errorf("error scanning API key usage row: %v: %w", scanErr, rdb.ErrQuery)
+		}
+		usages = append(usages, usage)
+	}
+
+	return usages, nil
+}
+
+func (store *Store) selectStmt() sq.SelectBuilder {
+	return store.rdb.StmtBuilder.Select(
+		"id",
+		"name",
+		"key_prefix",
+		"tier",
+		"created_at",
+		"revoked_at",
+	).From("api_keys")
+}
+
+func (store *Store) scan(row rdb.RowResult) (*APIKey, error) {
+	var apiKey APIKey
+	var createdAt int64
+	var maybeRevokedAt *int64
+	if err := row.Scan(
+		&apiKey.Id,
+		&apiKey.Name,
+		&apiKey.KeyPrefix,
+		&apiKey.Tier,
+		&createdAt,
+		&maybeRevokedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	apiKey.CreatedAt = utctime.FromUnixNano(createdAt)
+	if maybeRevokedAt != nil {
+		revokedAt := utctime.FromUnixNano(*maybeRevokedAt)
+		apiKey.MaybeRevokedAt = &revokedAt
+	}
+	return &apiKey, nil
+}
@@ -0,0 +1,108 @@
+package apikey
+
+import (
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+type Usage struct {
+	RequestCount  int64
+	RejectedCount int64
+}
+
+type DailyUsage struct {
+	Date          string `json:"date"`
+	RequestCount  int64  `json:"requestCount"`
+	RejectedCount int64  `json:"rejectedCount"`
+}
+
+// UsageStore is the persistence of the usage counters. It is fulfilled by Store.
+type UsageStore interface {
+	IncrementUsages(date string, usages map[int64]Usage) error
+}
+
+// UsageRecorder accumulates the usage counters of API keys in memory so that requests do not write
+// to the database. The counters are written to the store on Flush.
+type UsageRecorder struct {
+	store UsageStore
+
+	mutex sync.Mutex
+	// date -> API key id -> usage
+	pendingUsages map[string]map[int64]Usage
+
+	nowFn func() utctime.UTCTime
+}
+
+func NewUsageRecorder(store UsageStore) *UsageRecorder {
+	return &UsageRecorder{
+		store: store,
+
+		pendingUsages: make(map[string]map[int64]Usage),
+
+		nowFn: utctime.Now,
+	}
+}
+
+// WithNowFn overrides the clock used to decide the date of the usages
+func (recorder *UsageRecorder) WithNowFn(nowFn func() utctime.UTCTime) *UsageRecorder {
+	recorder.nowFn = nowFn
+	return recorder
+}
+
+// Record counts a request of the API key. A rejected request is counted in both counters.
+func (recorder *UsageRecorder) Record(apiKeyId int64, isRejected bool) {
+	date := time.Unix(0, recorder.nowFn().UnixNano()).UTC().Format(USAGE_DATE_LAYOUT)
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	usages, ok := recorder.pendingUsages[date]
+	if !ok {
+		usages = make(map[int64]Usage)
+		recorder.pendingUsages[date] = usages
+	}
+	usage := usages[apiKeyId]
+	usage.RequestCount += 1
+	if isRejected {
+		usage.RejectedCount += 1
+	}
+	usages[apiKeyId] = usage
+}
+
+// Flush writes the accumulated usages to the store. Usages failed to be written are kept for the
+// next flush.
+func (recorder *UsageRecorder) Flush() error {
+	recorder.mutex.Lock()
+	pendingUsages := recorder.pendingUsages
+	recorder.pendingUsages = make(map[string]map[int64]Usage)
+	recorder.mutex.Unlock()
+
+	for date, usages := range pendingUsages {
+		if err := recorder.store.IncrementUsages(date, usages); err != nil {
+			recorder.restore(pendingUsages)
+			return err
+		}
+		delete(pendingUsages, date)
+	}
+
+	return nil
+}
+
+func (recorder *UsageRecorder) restore(usagesByDate map[string]map[int64]Usage) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	for date, usages := range usagesByDate {
+		if _, ok := recorder.pendingUsages[date]; !ok {
+			recorder.pendingUsages[date] = make(map[int64]Usage)
+		}
+		for apiKeyId, usage := range usages {
+			pendingUsage := recorder.pendingUsages[date][apiKeyId]
+			pendingUsage.RequestCount += usage.RequestCount
+			pendingUsage.RejectedCount += usage.RejectedCount
+			recorder.pendingUsages[date][apiKeyId] = pendingUsage
+		}
+	}
+}
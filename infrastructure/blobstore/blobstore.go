@@ -0,0 +1,62 @@
+package blobstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+)
+
+const REF_PREFIX = "sha256:"
+
+var refPattern = regexp.MustCompile(`^sha256:([0-9a-f]{64})$`)
+
+// ContentRef returns the reference of the content, which is the SHA-256 hash of the uncompressed
+// content
+func ContentRef(content []byte) string {
+	hash := sha256.Sum256(content)
+	return REF_PREFIX + hex.EncodeToString(hash[:])
+}
+
+// parseRef returns the hex encoded hash of the reference
+func parseRef(ref string) (string, error) {
+	matches := refPattern.FindStringSubmatch(ref)
+	if matches == nil {
+		return "", fmt.Errorf("invalid blob reference %s", ref)
+	}
+
+	return matches[1], nil
+}
+
+func compress(content []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write(content); err != nil {
+		return nil, fmt.Errorf("error compressing blob: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error compressing blob: %v", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// decompress decompresses the stored blob and verifies it against the reference
+func decompress(ref string, compressed []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing blob %s: %v", ref, err)
+	}
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing blob %s: %v", ref, err)
+	}
+
+	if ContentRef(content) != ref {
+		return nil, fmt.Errorf("blob %s is corrupted: mismatched content hash", ref)
+	}
+	return content, nil
+}
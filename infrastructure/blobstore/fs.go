@@ -0,0 +1,99 @@
+package blobstore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+)
+
+var _ entity_event.BlobStore = &FSBlobStore{}
+
+// FSBlobStore stores gzip compressed blobs under a local directory. Blobs are spread over two levels
+// of sub-directories named after the first bytes of the hash, e.g. "ab/cd/abcd...gz".
+type FSBlobStore struct {
+	dir string
+}
+
+func NewFSBlobStore(dir string) (*FSBlobStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("error creating blob store directory: %v", err)
+	}
+
+	return &FSBlobStore{
+		dir: dir,
+	}, nil
+}
+
+func (store *FSBlobStore) Put(content []byte) (string, error) {
+	ref := ContentRef(content)
+	path, err := store.path(ref)
+	if err != nil {
+		return "", err
+	}
+	if _, statErr := os.Stat(path); statErr == nil {
+		return ref, nil
+	}
+
+	compressed, err := compress(content)
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("error creating blob directory: %v", err)
+	}
+	// Written to a temporary file first, so that a blob is never read partially written
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return "", fmt.Errorf("error creating temporary blob file: %v", err)
+	}
+	if _, err = tempFile.Write(compressed); err != nil {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+		return "", fmt.Errorf("error writing blob %s: %v", ref, err)
+	}
+	if err = tempFile.Sync(); err != nil {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+		return "", fmt.Errorf("error syncing blob %s: %v", ref, err)
+	}
+	if err = tempFile.Close(); err != nil {
+		_ = os.Remove(tempFile.Name())
+		return "", fmt.Errorf("error closing blob %s: %v", ref, err)
+	}
+	if err = os.Rename(tempFile.Name(), path); err != nil {
+		_ = os.Remove(tempFile.Name())
+		return "", fmt.Errorf("error moving blob %s: %v", ref, err)
+	}
+
+	return ref, nil
+}
+
+func (store *FSBlobStore) Get(ref string) ([]byte, error) {
+	path, err := store.path(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	compressed, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, entity_event.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("error reading blob %s: %v", ref, err)
+	}
+
+	return decompress(ref, compressed)
+}
+
+func (store *FSBlobStore) path(ref string) (string, error) {
+	hash, err := parseRef(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(store.dir, hash[0:2], hash[2:4], hash+".gz"), nil
+}
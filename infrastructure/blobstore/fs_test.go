@@ -0,0 +1,102 @@
+package blobstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/infrastructure/blobstore"
+)
+
+var _ = Describe("FSBlobStore", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "chain-indexing-blobstore")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	It("should return the content by its hash reference", func() {
+		store, err := blobstore.NewFSBlobStore(dir)
+		Expect(err).To(BeNil())
+
+		content := []byte(strings.Repeat(`{"rawBlock":{}}`, 1000))
+		ref, err := store.Put(content)
+		Expect(err).To(BeNil())
+		Expect(ref).To(Equal(blobstore.ContentRef(content)))
+		Expect(ref).To(HavePrefix("sha256:"))
+
+		Expect(store.Get(ref)).To(Equal(content))
+	})
+
+	It("should store the same content once compressed", func() {
+		store, err := blobstore.NewFSBlobStore(dir)
+		Expect(err).To(BeNil())
+
+		content := []byte(strings.Repeat("a", 10000))
+		ref, err := store.Put(content)
+		Expect(err).To(BeNil())
+		Expect(store.Put(content)).To(Equal(ref))
+
+		files := make([]string, 0)
+		Expect(filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if !info.IsDir() {
+				files = append(files, path)
+				Expect(info.Size()).To(BeNumerically("<", len(content)))
+			}
+			return err
+		})).To(Succeed())
+		Expect(files).To(HaveLen(1))
+	})
+
+	It("should return ErrBlobNotFound when the content is not stored", func() {
+		store, err := blobstore.NewFSBlobStore(dir)
+		Expect(err).To(BeNil())
+
+		_, err = store.Get(blobstore.ContentRef([]byte("missing")))
+		Expect(err).To(Equal(entity_event.ErrBlobNotFound))
+	})
+
+	It("should return error when the reference is invalid", func() {
+		store, err := blobstore.NewFSBlobStore(dir)
+		Expect(err).To(BeNil())
+
+		_, err = store.Get("sha256:../../etc/passwd")
+		Expect(err).To(MatchError("invalid blob reference sha256:../../etc/passwd"))
+	})
+
+	It("should return error when the stored content does not match the hash", func() {
+		store, err := blobstore.NewFSBlobStore(dir)
+		Expect(err).To(BeNil())
+		ref, err := store.Put([]byte("content"))
+		Expect(err).To(BeNil())
+		otherStore, err := blobstore.NewFSBlobStore(filepath.Join(dir, "other"))
+		Expect(err).To(BeNil())
+		otherRef, err := otherStore.Put([]byte("other content"))
+		Expect(err).To(BeNil())
+
+		// Overwrite the blob with the compressed other content
+		hash := strings.TrimPrefix(ref, "sha256:")
+		otherHash := strings.TrimPrefix(otherRef, "sha256:")
+		otherCompressed, err := ioutil.ReadFile(
+			filepath.Join(dir, "other", otherHash[0:2], otherHash[2:4], otherHash+".gz"),
+		)
+		Expect(err).To(BeNil())
+		Expect(ioutil.WriteFile(
+			filepath.Join(dir, hash[0:2], hash[2:4], hash+".gz"), otherCompressed, 0644,
+		)).To(Succeed())
+
+		_, err = store.Get(ref)
+		Expect(err).To(MatchError(ContainSubstring("is corrupted")))
+	})
+})
@@ -0,0 +1,282 @@
+package blobstore
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+)
+
+const S3_AMZ_DATE_LAYOUT = "20060102T150405Z"
+
+var _ entity_event.BlobStore = &S3BlobStore{}
+
+type S3Config struct {
+	// Endpoint of the S3-compatible service, e.g. "https://s3.ap-southeast-1.amazonaws.com" or
+	// "http://localhost:9000". Objects are addressed path-style, i.e. "<endpoint>/<bucket>/<key>".
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Prefix of the object keys
+	Prefix string
+
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3BlobStore stores gzip compressed blobs as objects of a bucket of an S3-compatible service.
+// Requests are signed with AWS Signature Version 4.
+type S3BlobStore struct {
+	config     S3Config
+	endpoint   *url.URL
+	httpClient *http.Client
+
+	nowFn func() time.Time
+}
+
+func NewS3BlobStore(config S3Config) (*S3BlobStore, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %s", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, errors.New("missing S3 bucket")
+	}
+	if config.Region == "" {
+		return nil, errors.New("missing S3 region")
+	}
+
+	return &S3BlobStore{
+		config:   config,
+		endpoint: endpoint,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+
+		nowFn: time.Now,
+	}, nil
+}
+
+func (store *S3BlobStore) WithNowFn(nowFn func() time.Time) *S3BlobStore {
+	store.nowFn = nowFn
+	return store
+}
+
+func (store *S3BlobStore) Put(content []byte) (string, error) {
+	ref := ContentRef(content)
+	objectURL, err := store.objectURL(ref)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := store.do(http.MethodHead, objectURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error checking blob %s: %v", ref, err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode == http.StatusOK {
+		return ref, nil
+	}
+	if response.StatusCode != http.StatusNotFound {
+		return "", fmt.Errorf("error checking blob %s: unexpected status %s", ref, response.Status)
+	}
+
+	compressed, err := compress(content)
+	if err != nil {
+		return "", err
+	}
+	response, err = store.do(http.MethodPut, objectURL, compressed)
+	if err != nil {
+		return "", fmt.Errorf("error putting blob %s: %v", ref, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error putting blob %s: %s", ref, readErrorResponse(response))
+	}
+
+	return ref, nil
+}
+
+func (store *S3BlobStore) Get(ref string) ([]byte, error) {
+	objectURL, err := store.objectURL(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := store.do(http.MethodGet, objectURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting blob %s: %v", ref, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return nil, entity_event.ErrBlobNotFound
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting blob %s: %s", ref, readErrorResponse(response))
+	}
+
+	compressed, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading blob %s: %v", ref, err)
+	}
+	return decompress(ref, compressed)
+}
+
+func (store *S3BlobStore) objectURL(ref string) (*url.URL, error) {
+	hash, err := parseRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	objectURL := *store.endpoint
+	objectURL.Path = fmt.Sprintf(
+		"%s/%s/%s%s/%s.gz", store.endpoint.Path, store.config.Bucket, store.config.Prefix, hash[0:2], hash,
+	)
+	return &objectURL, nil
+}
+
+func (store *S3BlobStore) do(method string, objectURL *url.URL, body []byte) (*http.Response, error) {
+	request, err := http.NewRequest(method, objectURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/gzip")
+	}
+
+	payloadHash := sha256.Sum256(body)
+	SignV4(
+		request,
+		hex.EncodeToString(payloadHash[:]),
+		store.config.AccessKeyID,
+		store.config.SecretAccessKey,
+		store.config.Region,
+		store.nowFn(),
+	)
+
+	return store.httpClient.Do(request)
+}
+
+func readErrorResponse(response *http.Response) string {
+	body, _ := ioutil.ReadAll(response.Body)
+	if len(body) > 512 {
+		body = body[:512]
+	}
+	return fmt.Sprintf("unexpected status %s: %s", response.Status, string(body))
+}
+
+// SignV4 signs the S3 request with AWS Signature Version 4. The host header, the "x-amz-" headers
+// and the content type and range headers are signed.
+func SignV4(
+	request *http.Request,
+	payloadHash string,
+	accessKeyID string,
+	secretAccessKey string,
+	region string,
+	now time.Time,
+) {
+	amzDate := now.UTC().Format(S3_AMZ_DATE_LAYOUT)
+	date := amzDate[:8]
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := request.Host
+	if host == "" {
+		host = request.URL.Host
+	}
+	headers := map[string]string{
+		"host": host,
+	}
+	for name, values := range request.Header {
+		lowerName := strings.ToLower(name)
+		if strings.HasPrefix(lowerName, "x-amz-") || lowerName == "content-type" || lowerName == "range" {
+			trimmedValues := make([]string, 0, len(values))
+			for _, value := range values {
+				trimmedValues = append(trimmedValues, strings.TrimSpace(value))
+			}
+			headers[lowerName] = strings.Join(trimmedValues, ",")
+		}
+	}
+	headerNames := make([]string, 0, len(headers))
+	for name := range headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range headerNames {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(headerNames, ";")
+
+	canonicalURI := request.URL.EscapedPath()
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		canonicalURI,
+		canonicalQueryString(request.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func canonicalQueryString(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(query))
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+func uriEncode(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,53 @@
+package grpcapi
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+)
+
+const DEFAULT_PAGINATION_LIMIT = int64(20)
+
+// ParsePagination converts the pagination request to offset pagination with the same defaults as
+// the REST API
+func ParsePagination(maybeRequest *pb.PaginationRequest) (*pagination_interface.Pagination, error) {
+	page := int64(1)
+	limit := DEFAULT_PAGINATION_LIMIT
+	if maybeRequest != nil {
+		if maybeRequest.Page < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page number")
+		}
+		if maybeRequest.Page > 0 {
+			page = maybeRequest.Page
+		}
+		if maybeRequest.Limit > 0 {
+			limit = maybeRequest.Limit
+		}
+	}
+
+	return pagination_interface.NewOffsetPagination(page, limit), nil
+}
+
+func PaginationResponseFromResult(result *pagination_interface.PaginationResult) *pb.PaginationResponse {
+	offsetResult := result.OffsetResult()
+	if offsetResult == nil {
+		return nil
+	}
+
+	return &pb.PaginationResponse{
+		TotalRecord: offsetResult.TotalRecord,
+		TotalPage:   offsetResult.TotalPage(),
+		CurrentPage: offsetResult.CurrentPage,
+		Limit:       offsetResult.Limit,
+	}
+}
+
+func ParseOrder(order pb.Order) view.ORDER {
+	if order == pb.Order_ORDER_DESC {
+		return view.ORDER_DESC
+	}
+	return view.ORDER_ASC
+}
@@ -0,0 +1,48 @@
+package grpcapi_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+)
+
+var _ = Describe("Pagination", func() {
+	Describe("ParsePagination", func() {
+		It("should return first page with default limit when pagination is not provided", func() {
+			pagination, err := grpcapi.ParsePagination(nil)
+			Expect(err).To(BeNil())
+			Expect(pagination).To(Equal(
+				pagination_interface.NewOffsetPagination(1, grpcapi.DEFAULT_PAGINATION_LIMIT),
+			))
+		})
+
+		It("should return the provided page and limit", func() {
+			pagination, err := grpcapi.ParsePagination(&pb.PaginationRequest{
+				Page:  3,
+				Limit: 50,
+			})
+			Expect(err).To(BeNil())
+			Expect(pagination).To(Equal(pagination_interface.NewOffsetPagination(3, 50)))
+		})
+
+		It("should return InvalidArgument error when page is negative", func() {
+			_, err := grpcapi.ParsePagination(&pb.PaginationRequest{
+				Page: -1,
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("ParseOrder", func() {
+		It("should default to ascending order", func() {
+			Expect(grpcapi.ParseOrder(pb.Order_ORDER_ASC)).To(Equal(view.ORDER_ASC))
+			Expect(grpcapi.ParseOrder(pb.Order_ORDER_DESC)).To(Equal(view.ORDER_DESC))
+		})
+	})
+})
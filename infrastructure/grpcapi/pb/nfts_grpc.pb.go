@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// NFTServiceClient is the client API for NFTService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NFTServiceClient interface {
+	GetDenom(ctx context.Context, in *GetDenomRequest, opts ...grpc.CallOption) (*Denom, error)
+	ListDenoms(ctx context.Context, in *ListDenomsRequest, opts ...grpc.CallOption) (*ListDenomsResponse, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*Token, error)
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error)
+}
+
+type nFTServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNFTServiceClient(cc grpc.ClientConnInterface) NFTServiceClient {
+	return &nFTServiceClient{cc}
+}
+
+func (c *nFTServiceClient) GetDenom(ctx context.Context, in *GetDenomRequest, opts ...grpc.CallOption) (*Denom, error) {
+	out := new(Denom)
+	err := c.cc.Invoke(ctx, "/chainindexing.v1.NFTService/GetDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nFTServiceClient) ListDenoms(ctx context.Context, in *ListDenomsRequest, opts ...grpc.CallOption) (*ListDenomsResponse, error) {
+	out := new(ListDenomsResponse)
+	err := c.cc.Invoke(ctx, "/chainindexing.v1.NFTService/ListDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nFTServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := c.cc.Invoke(ctx, "/chainindexing.v1.NFTService/GetToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nFTServiceClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error) {
+	out := new(ListTokensResponse)
+	err := c.cc.Invoke(ctx, "/chainindexing.v1.NFTService/ListTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NFTServiceServer is the server API for NFTService service.
+// All implementations must embed UnimplementedNFTServiceServer
+// for forward compatibility
+type NFTServiceServer interface {
+	GetDenom(context.Context, *GetDenomRequest) (*Denom, error)
+	ListDenoms(context.Context, *ListDenomsRequest) (*ListDenomsResponse, error)
+	GetToken(context.Context, *GetTokenRequest) (*Token, error)
+	ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error)
+	mustEmbedUnimplementedNFTServiceServer()
+}
+
+// UnimplementedNFTServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNFTServiceServer struct {
+}
+
+func (UnimplementedNFTServiceServer) GetDenom(context.Context, *GetDenomRequest) (*Denom, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDenom not implemented")
+}
+func (UnimplementedNFTServiceServer) ListDenoms(context.Context, *ListDenomsRequest) (*ListDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDenoms not implemented")
+}
+func (UnimplementedNFTServiceServer) GetToken(context.Context, *GetTokenRequest) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedNFTServiceServer) ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (UnimplementedNFTServiceServer) mustEmbedUnimplementedNFTServiceServer() {}
+
+// UnsafeNFTServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NFTServiceServer will
+// result in compilation errors.
+type UnsafeNFTServiceServer interface {
+	mustEmbedUnimplementedNFTServiceServer()
+}
+
+func RegisterNFTServiceServer(s grpc.ServiceRegistrar, srv NFTServiceServer) {
+	s.RegisterService(&NFTService_ServiceDesc, srv)
+}
+
+func _NFTService_GetDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).GetDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainindexing.v1.NFTService/GetDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).GetDenom(ctx, req.(*GetDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NFTService_ListDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).ListDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainindexing.v1.NFTService/ListDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).ListDenoms(ctx, req.(*ListDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NFTService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainindexing.v1.NFTService/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NFTService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NFTServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainindexing.v1.NFTService/ListTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NFTServiceServer).ListTokens(ctx, req.(*ListTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NFTService_ServiceDesc is the grpc.ServiceDesc for NFTService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NFTService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chainindexing.v1.NFTService",
+	HandlerType: (*NFTServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDenom",
+			Handler:    _NFTService_GetDenom_Handler,
+		},
+		{
+			MethodName: "ListDenoms",
+			Handler:    _NFTService_ListDenoms_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _NFTService_GetToken_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _NFTService_ListTokens_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chainindexing/v1/nfts.proto",
+}
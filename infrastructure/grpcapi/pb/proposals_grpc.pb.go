@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProposalServiceClient is the client API for ProposalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProposalServiceClient interface {
+	GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*Proposal, error)
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+}
+
+type proposalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProposalServiceClient(cc grpc.ClientConnInterface) ProposalServiceClient {
+	return &proposalServiceClient{cc}
+}
+
+func (c *proposalServiceClient) GetProposal(ctx context.Context, in *GetProposalRequest, opts ...grpc.CallOption) (*Proposal, error) {
+	out := new(Proposal)
+	err := c.cc.Invoke(ctx, "/chainindexing.v1.ProposalService/GetProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalServiceClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, "/chainindexing.v1.ProposalService/ListProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProposalServiceServer is the server API for ProposalService service.
+// All implementations must embed UnimplementedProposalServiceServer
+// for forward compatibility
+type ProposalServiceServer interface {
+	GetProposal(context.Context, *GetProposalRequest) (*Proposal, error)
+	ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error)
+	mustEmbedUnimplementedProposalServiceServer()
+}
+
+// UnimplementedProposalServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProposalServiceServer struct {
+}
+
+func (UnimplementedProposalServiceServer) GetProposal(context.Context, *GetProposalRequest) (*Proposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposal not implemented")
+}
+func (UnimplementedProposalServiceServer) ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedProposalServiceServer) mustEmbedUnimplementedProposalServiceServer() {}
+
+// UnsafeProposalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProposalServiceServer will
+// result in compilation errors.
+type UnsafeProposalServiceServer interface {
+	mustEmbedUnimplementedProposalServiceServer()
+}
+
+func RegisterProposalServiceServer(s grpc.ServiceRegistrar, srv ProposalServiceServer) {
+	s.RegisterService(&ProposalService_ServiceDesc, srv)
+}
+
+func _ProposalService_GetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).GetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainindexing.v1.ProposalService/GetProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).GetProposal(ctx, req.(*GetProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalService_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServiceServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainindexing.v1.ProposalService/ListProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServiceServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProposalService_ServiceDesc is the grpc.ServiceDesc for ProposalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProposalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chainindexing.v1.ProposalService",
+	HandlerType: (*ProposalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProposal",
+			Handler:    _ProposalService_GetProposal_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _ProposalService_ListProposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chainindexing/v1/proposals.proto",
+}
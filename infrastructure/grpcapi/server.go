@@ -0,0 +1,93 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+// Server is a gRPC server serving the query services. Panics in the services are recovered and
+// returned as internal errors.
+type Server struct {
+	listeningAddress string
+	logger           applogger.Logger
+
+	grpcServer *grpc.Server
+}
+
+func NewServer(listeningAddress string, logger applogger.Logger) *Server {
+	server := &Server{
+		listeningAddress: listeningAddress,
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "grpcapi",
+		}),
+	}
+	server.grpcServer = grpc.NewServer(
+		grpc.UnaryInterceptor(server.unaryInterceptor),
+		grpc.StreamInterceptor(server.streamInterceptor),
+	)
+
+	return server
+}
+
+// Register registers a service implementation. It must be called before ListenAndServe.
+func (server *Server) Register(register func(grpcServer *grpc.Server)) *Server {
+	register(server.grpcServer)
+	return server
+}
+
+func (server *Server) ListenAndServe() error {
+	listener, err := net.Listen("tcp", server.listeningAddress)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %v", server.listeningAddress, err)
+	}
+
+	return server.grpcServer.Serve(listener)
+}
+
+func (server *Server) GracefulStop() {
+	server.grpcServer.GracefulStop()
+}
+
+func (server *Server) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	startedAt := time.Now()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			server.logger.Errorf("panic handling %s: %v", info.FullMethod, recovered)
+			err = status.Error(codes.Internal, "internal server error")
+		}
+		server.logger.Infof("%s %s %s", info.FullMethod, status.Code(err), time.Since(startedAt))
+	}()
+
+	return handler(ctx, req)
+}
+
+func (server *Server) streamInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	startedAt := time.Now()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			server.logger.Errorf("panic handling %s: %v", info.FullMethod, recovered)
+			err = status.Error(codes.Internal, "internal server error")
+		}
+		server.logger.Infof("%s %s %s", info.FullMethod, status.Code(err), time.Since(startedAt))
+	}()
+
+	return handler(srv, stream)
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	account_view "github.com/crypto-com/chain-indexing/projection/account/view"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	account_transaction_view "github.com/crypto-com/chain-indexing/projection/account_transaction/view"
+)
+
+var _ pb.AccountServiceServer = &Accounts{}
+
+type Accounts struct {
+	pb.UnimplementedAccountServiceServer
+
+	logger applogger.Logger
+
+	accountsView            *account_view.Accounts
+	accountTransactionsView *account_transaction_view.AccountTransactions
+	accountMessagesView     *account_message_view.AccountMessages
+
+	projections view.Projections
+}
+
+func NewAccounts(logger applogger.Logger, rdbHandle *rdb.Handle) *Accounts {
+	return &Accounts{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "AccountsService",
+		}),
+
+		accountsView:            account_view.NewAccounts(rdbHandle),
+		accountTransactionsView: account_transaction_view.NewAccountTransactions(rdbHandle),
+		accountMessagesView:     account_message_view.NewAccountMessages(rdbHandle),
+	}
+}
+
+// WithProjections only serves the methods reading the views of the projections. The methods of all
+// projections are served by default.
+func (service *Accounts) WithProjections(projections view.Projections) *Accounts {
+	service.projections = projections
+	return service
+}
+
+func (service *Accounts) GetAccount(_ context.Context, req *pb.GetAccountRequest) (*pb.Account, error) {
+	if !service.projections.Serves("Account") {
+		return nil, ErrProjectionNotServed
+	}
+	account, err := service.accountsView.FindBy(&account_view.AccountIdentity{
+		Address: req.Address,
+	})
+	if err != nil {
+		return nil, findError(service.logger, err, "finding account")
+	}
+
+	return AccountToProto(account), nil
+}
+
+func (service *Accounts) ListAccounts(
+	_ context.Context,
+	req *pb.ListAccountsRequest,
+) (*pb.ListAccountsResponse, error) {
+	if !service.projections.Serves("Account") {
+		return nil, ErrProjectionNotServed
+	}
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, paginationResult, err := service.accountsView.List(account_view.AccountsListOrder{
+		AccountAddress: grpcapi.ParseOrder(req.Order),
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing accounts")
+	}
+
+	protoAccounts := make([]*pb.Account, 0, len(accounts))
+	for i := range accounts {
+		protoAccounts = append(protoAccounts, AccountToProto(&accounts[i]))
+	}
+
+	return &pb.ListAccountsResponse{
+		Accounts:   protoAccounts,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
+
+func (service *Accounts) ListAccountTransactions(
+	_ context.Context,
+	req *pb.ListAccountTransactionsRequest,
+) (*pb.ListAccountTransactionsResponse, error) {
+	if !service.projections.Serves("AccountTransaction") {
+		return nil, ErrProjectionNotServed
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, paginationResult, err := service.accountTransactionsView.List(
+		account_transaction_view.AccountTransactionsListFilter{
+			Account: req.Address,
+		},
+		account_transaction_view.AccountTransactionsListOrder{
+			Id: grpcapi.ParseOrder(req.Order),
+		},
+		pagination,
+	)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing account transactions")
+	}
+
+	protoTransactions := make([]*pb.AccountTransaction, 0, len(transactions))
+	for i := range transactions {
+		protoTransaction, err := AccountTransactionToProto(&transactions[i])
+		if err != nil {
+			return nil, internalError(service.logger, err, "converting account transaction")
+		}
+		protoTransactions = append(protoTransactions, protoTransaction)
+	}
+
+	return &pb.ListAccountTransactionsResponse{
+		Transactions: protoTransactions,
+		Pagination:   grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
+
+func (service *Accounts) ListAccountMessages(
+	_ context.Context,
+	req *pb.ListAccountMessagesRequest,
+) (*pb.ListAccountMessagesResponse, error) {
+	if !service.projections.Serves("AccountMessage") {
+		return nil, ErrProjectionNotServed
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := account_message_view.AccountMessagesListFilter{
+		Account:       req.Address,
+		MaybeMsgTypes: nil,
+	}
+	if len(req.MessageTypes) > 0 {
+		filter.MaybeMsgTypes = req.MessageTypes
+	}
+
+	messages, paginationResult, err := service.accountMessagesView.List(
+		filter,
+		account_message_view.AccountMessagesListOrder{
+			Id: grpcapi.ParseOrder(req.Order),
+		},
+		pagination,
+	)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing account messages")
+	}
+
+	protoMessages := make([]*pb.AccountMessage, 0, len(messages))
+	for i := range messages {
+		protoMessage, err := AccountMessageToProto(&messages[i])
+		if err != nil {
+			return nil, internalError(service.logger, err, "converting account message")
+		}
+		protoMessages = append(protoMessages, protoMessage)
+	}
+
+	return &pb.ListAccountMessagesResponse{
+		Messages:   protoMessages,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
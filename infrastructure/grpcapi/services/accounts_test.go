@@ -0,0 +1,31 @@
+package services_test
+
+import (
+	"context"
+
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/services"
+)
+
+var _ = Describe("Accounts", func() {
+	It("should not serve the methods reading the views of the projections not served", func() {
+		accounts := services.NewAccounts(NewFakeLogger(), &rdb.Handle{}).WithProjections(
+			view.Projections{"AccountTransaction"},
+		)
+
+		_, err := accounts.GetAccount(context.Background(), &pb.GetAccountRequest{
+			Address: "tcro15grftg88l0gdnvp5lgq8qdp2la4cmqwxezcduf",
+		})
+		Expect(err).To(Equal(services.ErrProjectionNotServed))
+		_, err = accounts.ListAccountMessages(context.Background(), &pb.ListAccountMessagesRequest{
+			Address: "tcro15grftg88l0gdnvp5lgq8qdp2la4cmqwxezcduf",
+		})
+		Expect(err).To(Equal(services.ErrProjectionNotServed))
+	})
+})
@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+)
+
+var _ pb.BlockServiceServer = &Blocks{}
+
+type Blocks struct {
+	pb.UnimplementedBlockServiceServer
+
+	logger applogger.Logger
+
+	blocksView       *block_view.Blocks
+	transactionsView *transaction_view.BlockTransactions
+
+	// Interval to check for new blocks when a subscriber has caught up with the latest block
+	subscriptionPollInterval time.Duration
+
+	projections view.Projections
+}
+
+func NewBlocks(
+	logger applogger.Logger,
+	rdbHandle *rdb.Handle,
+	subscriptionPollInterval time.Duration,
+) *Blocks {
+	return &Blocks{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "BlocksService",
+		}),
+
+		blocksView:       block_view.NewBlocks(rdbHandle),
+		transactionsView: transaction_view.NewTransactions(rdbHandle),
+
+		subscriptionPollInterval: subscriptionPollInterval,
+	}
+}
+
+// WithProjections only serves the methods reading the views of the projections. The methods of all
+// projections are served by default.
+func (service *Blocks) WithProjections(projections view.Projections) *Blocks {
+	service.projections = projections
+	return service
+}
+
+func (service *Blocks) GetBlock(_ context.Context, req *pb.GetBlockRequest) (*pb.Block, error) {
+	var identity block_view.BlockIdentity
+	switch blockIdentity := req.Identity.(type) {
+	case *pb.GetBlockRequest_Height:
+		identity.MaybeHeight = primptr.Int64(blockIdentity.Height)
+	case *pb.GetBlockRequest_Hash:
+		identity.MaybeHash = primptr.String(blockIdentity.Hash)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either height or hash is required")
+	}
+
+	block, err := service.blocksView.FindBy(&identity)
+	if err != nil {
+		return nil, findError(service.logger, err, "finding block")
+	}
+
+	return BlockToProto(block), nil
+}
+
+func (service *Blocks) ListBlocks(_ context.Context, req *pb.ListBlocksRequest) (*pb.ListBlocksResponse, error) {
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, paginationResult, err := service.blocksView.List(block_view.BlocksListOrder{
+		Height: grpcapi.ParseOrder(req.Order),
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing blocks")
+	}
+
+	protoBlocks := make([]*pb.Block, 0, len(blocks))
+	for i := range blocks {
+		protoBlocks = append(protoBlocks, BlockToProto(&blocks[i]))
+	}
+
+	return &pb.ListBlocksResponse{
+		Blocks:     protoBlocks,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
+
+func (service *Blocks) ListBlockTransactions(
+	_ context.Context,
+	req *pb.ListBlockTransactionsRequest,
+) (*pb.ListTransactionsResponse, error) {
+	if !service.projections.Serves("Transaction") {
+		return nil, ErrProjectionNotServed
+	}
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, paginationResult, err := service.transactionsView.List(transaction_view.TransactionsListFilter{
+		MaybeBlockHeight: primptr.Int64(req.Height),
+	}, transaction_view.TransactionsListOrder{
+		Height: grpcapi.ParseOrder(req.Order),
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing block transactions")
+	}
+
+	return transactionsResponse(service.logger, transactions, paginationResult)
+}
+
+func (service *Blocks) SubscribeBlocks(
+	req *pb.SubscribeBlocksRequest,
+	stream pb.BlockService_SubscribeBlocksServer,
+) error {
+	nextHeight := req.FromHeight
+	if nextHeight <= 0 {
+		latestHeight, err := service.latestHeight()
+		if err != nil {
+			return internalError(service.logger, err, "finding latest block height")
+		}
+		nextHeight = latestHeight + 1
+	}
+
+	ctx := stream.Context()
+	for {
+		block, err := service.blocksView.FindBy(&block_view.BlockIdentity{
+			MaybeHeight: primptr.Int64(nextHeight),
+		})
+		if err != nil {
+			if !errors.Is(err, rdb.ErrNoRows) {
+				return internalError(service.logger, err, "finding subscribed block")
+			}
+
+			// Caught up with the latest indexed block
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(service.subscriptionPollInterval):
+			}
+			continue
+		}
+
+		if err := stream.Send(BlockToProto(block)); err != nil {
+			return err
+		}
+		nextHeight += 1
+	}
+}
+
+func (service *Blocks) latestHeight() (int64, error) {
+	blocks, _, err := service.blocksView.List(block_view.BlocksListOrder{
+		Height: view.ORDER_DESC,
+	}, pagination_interface.NewOffsetPagination(1, 1))
+	if err != nil {
+		return 0, err
+	}
+	if len(blocks) == 0 {
+		return 0, nil
+	}
+
+	return blocks[0].Height, nil
+}
+
+func transactionsResponse(
+	logger applogger.Logger,
+	transactions []transaction_view.TransactionRow,
+	paginationResult *pagination_interface.PaginationResult,
+) (*pb.ListTransactionsResponse, error) {
+	protoTransactions := make([]*pb.Transaction, 0, len(transactions))
+	for i := range transactions {
+		protoTransaction, err := TransactionToProto(&transactions[i])
+		if err != nil {
+			return nil, internalError(logger, err, "converting transaction")
+		}
+		protoTransactions = append(protoTransactions, protoTransaction)
+	}
+
+	return &pb.ListTransactionsResponse{
+		Transactions: protoTransactions,
+		Pagination:   grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
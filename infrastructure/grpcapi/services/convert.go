@@ -0,0 +1,300 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	account_view "github.com/crypto-com/chain-indexing/projection/account/view"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	account_transaction_view "github.com/crypto-com/chain-indexing/projection/account_transaction/view"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	nft_view "github.com/crypto-com/chain-indexing/projection/nft/view"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+func BlockToProto(block *block_view.Block) *pb.Block {
+	committedCouncilNodes := make([]*pb.BlockCommittedCouncilNode, 0, len(block.CommittedCouncilNodes))
+	for _, node := range block.CommittedCouncilNodes {
+		committedCouncilNodes = append(committedCouncilNodes, &pb.BlockCommittedCouncilNode{
+			Address:    node.Address,
+			Time:       TimestampToProto(node.Time),
+			Signature:  node.Signature,
+			IsProposer: node.IsProposer,
+		})
+	}
+
+	return &pb.Block{
+		Height:                block.Height,
+		Hash:                  block.Hash,
+		Time:                  TimestampToProto(block.Time),
+		AppHash:               block.AppHash,
+		TransactionCount:      int64(block.TransactionCount),
+		CommittedCouncilNodes: committedCouncilNodes,
+	}
+}
+
+func TransactionToProto(transaction *transaction_view.TransactionRow) (*pb.Transaction, error) {
+	messages, err := TransactionMessagesToProto(transaction.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Transaction{
+		BlockHeight:   transaction.BlockHeight,
+		BlockHash:     transaction.BlockHash,
+		BlockTime:     TimestampToProto(transaction.BlockTime),
+		Hash:          transaction.Hash,
+		Index:         int64(transaction.Index),
+		Success:       transaction.Success,
+		Code:          int64(transaction.Code),
+		Log:           transaction.Log,
+		Fee:           CoinsToProto(transaction.Fee),
+		FeePayer:      transaction.FeePayer,
+		FeeGranter:    transaction.FeeGranter,
+		GasWanted:     int64(transaction.GasWanted),
+		GasUsed:       int64(transaction.GasUsed),
+		Memo:          transaction.Memo,
+		TimeoutHeight: transaction.TimeoutHeight,
+		Messages:      messages,
+	}, nil
+}
+
+func TransactionMessagesToProto(
+	messages []transaction_view.TransactionRowMessage,
+) ([]*pb.TransactionMessage, error) {
+	protoMessages := make([]*pb.TransactionMessage, 0, len(messages))
+	for _, message := range messages {
+		content, err := ValueToProto(message.Content)
+		if err != nil {
+			return nil, fmt.Errorf("error converting message content: %v", err)
+		}
+		protoMessages = append(protoMessages, &pb.TransactionMessage{
+			Type:    message.Type,
+			Content: content,
+		})
+	}
+
+	return protoMessages, nil
+}
+
+func AccountToProto(account *account_view.AccountRow) *pb.Account {
+	return &pb.Account{
+		Address:        account.Address,
+		Type:           account.Type,
+		Name:           optString(account.MaybeName),
+		Pubkey:         optString(account.MaybePubkey),
+		AccountNumber:  account.AccountNumber,
+		SequenceNumber: account.SequenceNumber,
+		Balance:        CoinsToProto(account.Balance),
+	}
+}
+
+func AccountTransactionToProto(
+	transaction *account_transaction_view.AccountTransactionReadRow,
+) (*pb.AccountTransaction, error) {
+	messages := make([]transaction_view.TransactionRowMessage, 0, len(transaction.Messages))
+	for _, message := range transaction.Messages {
+		messages = append(messages, transaction_view.TransactionRowMessage{
+			Type:    message.Type,
+			Content: message.Content,
+		})
+	}
+	protoMessages, err := TransactionMessagesToProto(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AccountTransaction{
+		Account:       transaction.Account,
+		BlockHeight:   transaction.BlockHeight,
+		BlockHash:     transaction.BlockHash,
+		BlockTime:     TimestampToProto(transaction.BlockTime),
+		Hash:          transaction.Hash,
+		MessageTypes:  transaction.MessageTypes,
+		Success:       transaction.Success,
+		Code:          int64(transaction.Code),
+		Log:           transaction.Log,
+		Fee:           CoinsToProto(transaction.Fee),
+		FeePayer:      transaction.FeePayer,
+		FeeGranter:    transaction.FeeGranter,
+		GasWanted:     int64(transaction.GasWanted),
+		GasUsed:       int64(transaction.GasUsed),
+		Memo:          transaction.Memo,
+		TimeoutHeight: transaction.TimeoutHeight,
+		Messages:      protoMessages,
+	}, nil
+}
+
+func AccountMessageToProto(message *account_message_view.AccountMessageRow) (*pb.AccountMessage, error) {
+	data, err := ValueToProto(message.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting message data: %v", err)
+	}
+
+	return &pb.AccountMessage{
+		Account:         optString(message.MaybeAccount),
+		BlockHeight:     message.BlockHeight,
+		BlockHash:       message.BlockHash,
+		BlockTime:       TimestampToProto(message.BlockTime),
+		TransactionHash: message.TransactionHash,
+		Success:         message.Success,
+		MessageIndex:    int64(message.MessageIndex),
+		MessageType:     message.MessageType,
+		Data:            data,
+	}, nil
+}
+
+func ValidatorToProto(validator *validator_view.ValidatorRow) *pb.Validator {
+	var impreciseUpTime, votedGovProposal string
+	if validator.ImpreciseUpTime != nil {
+		impreciseUpTime = validator.ImpreciseUpTime.Text('f', -1)
+	}
+	if validator.VotedGovProposal != nil {
+		votedGovProposal = validator.VotedGovProposal.String()
+	}
+
+	return &pb.Validator{
+		OperatorAddress:         validator.OperatorAddress,
+		ConsensusNodeAddress:    validator.ConsensusNodeAddress,
+		InitialDelegatorAddress: validator.InitialDelegatorAddress,
+		TendermintPubkey:        validator.TendermintPubkey,
+		TendermintAddress:       validator.TendermintAddress,
+		Status:                  validator.Status,
+		Jailed:                  validator.Jailed,
+		JoinedAtBlockHeight:     validator.JoinedAtBlockHeight,
+		Power:                   validator.Power,
+		Moniker:                 validator.Moniker,
+		Identity:                validator.Identity,
+		Website:                 validator.Website,
+		SecurityContact:         validator.SecurityContact,
+		Details:                 validator.Details,
+		CommissionRate:          validator.CommissionRate,
+		CommissionMaxRate:       validator.CommissionMaxRate,
+		CommissionMaxChangeRate: validator.CommissionMaxChangeRate,
+		MinSelfDelegation:       validator.MinSelfDelegation,
+		TotalSignedBlock:        validator.TotalSignedBlock,
+		TotalActiveBlock:        validator.TotalActiveBlock,
+		ImpreciseUpTime:         impreciseUpTime,
+		VotedGovProposal:        votedGovProposal,
+	}
+}
+
+func ProposalToProto(proposal *proposal_view.ProposalWithMonikerRow) (*pb.Proposal, error) {
+	data, err := ValueToProto(proposal.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting proposal data: %v", err)
+	}
+
+	var totalVote string
+	if proposal.TotalVote != nil {
+		totalVote = proposal.TotalVote.String()
+	}
+	var votingEndBlockHeight int64
+	if proposal.MaybeVotingEndBlockHeight != nil {
+		votingEndBlockHeight = *proposal.MaybeVotingEndBlockHeight
+	}
+
+	return &pb.Proposal{
+		Id:                      proposal.ProposalId,
+		Title:                   proposal.Title,
+		Description:             proposal.Description,
+		Type:                    proposal.Type,
+		Status:                  proposal.Status,
+		ProposerAddress:         proposal.ProposerAddress,
+		ProposerOperatorAddress: optString(proposal.MaybeProposerOperatorAddress),
+		ProposerMoniker:         optString(proposal.MaybeProposerMoniker),
+		Data:                    data,
+		InitialDeposit:          CoinsToProto(proposal.InitialDeposit),
+		TotalDeposit:            CoinsToProto(proposal.TotalDeposit),
+		TotalVote:               totalVote,
+		TransactionHash:         proposal.TransactionHash,
+		SubmitBlockHeight:       proposal.SubmitBlockHeight,
+		SubmitTime:              TimestampToProto(proposal.SubmitTime),
+		DepositEndTime:          TimestampToProto(proposal.DepositEndTime),
+		VotingStartTime:         optTimestampToProto(proposal.MaybeVotingStartTime),
+		VotingEndBlockHeight:    votingEndBlockHeight,
+		VotingEndTime:           optTimestampToProto(proposal.MaybeVotingEndTime),
+	}, nil
+}
+
+func DenomToProto(denom *nft_view.DenomRow) *pb.Denom {
+	return &pb.Denom{
+		DenomId:              denom.DenomId,
+		Name:                 denom.Name,
+		Schema:               denom.Schema,
+		Creator:              denom.Creator,
+		CreatedAt:            TimestampToProto(denom.CreatedAt),
+		CreatedAtBlockHeight: denom.CreatedAtBlockHeight,
+	}
+}
+
+func TokenToProto(token *nft_view.TokenRowWithDenomname) *pb.Token {
+	return &pb.Token{
+		DenomId:                 token.DenomId,
+		DenomName:               token.DenomName,
+		DenomSchema:             token.DenomSchema,
+		TokenId:                 token.TokenId,
+		Drop:                    optString(token.MaybeDrop),
+		Name:                    token.Name,
+		Uri:                     token.URI,
+		Data:                    token.Data,
+		Minter:                  token.Minter,
+		Owner:                   token.Owner,
+		MintedAt:                TimestampToProto(token.MintedAt),
+		MintedAtBlockHeight:     token.MintedAtBlockHeight,
+		LastEditedAt:            TimestampToProto(token.LastEditedAt),
+		LastEditedAtBlockHeight: token.LastEditedAtBlockHeight,
+	}
+}
+
+func CoinsToProto(coins coin.Coins) []*pb.Coin {
+	protoCoins := make([]*pb.Coin, 0, len(coins))
+	for _, c := range coins {
+		protoCoins = append(protoCoins, &pb.Coin{
+			Denom:  c.Denom,
+			Amount: c.Amount.String(),
+		})
+	}
+	return protoCoins
+}
+
+func TimestampToProto(t utctime.UTCTime) *timestamppb.Timestamp {
+	return timestamppb.New(time.Unix(0, t.UnixNano()).UTC())
+}
+
+// ValueToProto converts a value to its JSON representation in the REST API
+func ValueToProto(value interface{}) (*structpb.Value, error) {
+	encoded, err := jsoniter.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding value: %v", err)
+	}
+	var decoded interface{}
+	if err := jsoniter.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("error decoding value: %v", err)
+	}
+
+	return structpb.NewValue(decoded)
+}
+
+func optTimestampToProto(maybeTime *utctime.UTCTime) *timestamppb.Timestamp {
+	if maybeTime == nil {
+		return nil
+	}
+	return TimestampToProto(*maybeTime)
+}
+
+func optString(maybeValue *string) string {
+	if maybeValue == nil {
+		return ""
+	}
+	return *maybeValue
+}
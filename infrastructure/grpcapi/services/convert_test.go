@@ -0,0 +1,102 @@
+package services_test
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/services"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+var _ = Describe("Convert", func() {
+	It("should convert block", func() {
+		blockTime := utctime.FromUnixNano(int64(1618400000123456789))
+		block := services.BlockToProto(&block_view.Block{
+			Height:           100,
+			Hash:             "A4D10E0DDE6E4A7F57E5AE6B2BDE95F2E0E1D9DF2A3DF1F6A3E0F8B5A5B6C7D8",
+			Time:             blockTime,
+			AppHash:          "F1B3E0A7",
+			TransactionCount: 2,
+			CommittedCouncilNodes: []block_view.BlockCommittedCouncilNode{
+				{
+					Address:    "tcrocnclcons1qquk8ztyd8r0p6yzxgsu8xxvmlk9zd0kq5jrej",
+					Time:       blockTime,
+					Signature:  "c2lnbmF0dXJl",
+					IsProposer: true,
+				},
+			},
+		})
+
+		Expect(block.Height).To(Equal(int64(100)))
+		Expect(block.TransactionCount).To(Equal(int64(2)))
+		Expect(block.Time.AsTime().UnixNano()).To(Equal(blockTime.UnixNano()))
+		Expect(block.CommittedCouncilNodes).To(HaveLen(1))
+		Expect(block.CommittedCouncilNodes[0].IsProposer).To(BeTrue())
+	})
+
+	It("should convert transaction messages content to the same JSON values as REST API", func() {
+		transaction, err := services.TransactionToProto(&transaction_view.TransactionRow{
+			BlockHeight: 100,
+			Hash:        "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416",
+			Fee:         coin.NewCoins(coin.MustNewCoinFromString("basetcro", "5000")),
+			Messages: []transaction_view.TransactionRowMessage{
+				{
+					Type: "MsgSend",
+					Content: map[string]interface{}{
+						"amount": coin.NewCoins(coin.MustNewCoinFromString("basetcro", "1000")),
+						"height": 100,
+					},
+				},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		Expect(transaction.Fee).To(HaveLen(1))
+		Expect(transaction.Fee[0].Denom).To(Equal("basetcro"))
+		Expect(transaction.Fee[0].Amount).To(Equal("5000"))
+		Expect(transaction.Messages).To(HaveLen(1))
+		Expect(transaction.Messages[0].Type).To(Equal("MsgSend"))
+		Expect(transaction.Messages[0].Content.AsInterface()).To(Equal(map[string]interface{}{
+			"amount": []interface{}{
+				map[string]interface{}{
+					"denom":  "basetcro",
+					"amount": "1000",
+				},
+			},
+			"height": float64(100),
+		}))
+	})
+
+	It("should convert optional proposal fields to zero values", func() {
+		proposal, err := services.ProposalToProto(&proposal_view.ProposalWithMonikerRow{
+			ProposalRow: proposal_view.ProposalRow{
+				ProposalId:        "1",
+				Status:            proposal_view.PROPOSAL_STATUS_DEPOSIT_PERIOD,
+				TotalVote:         big.NewInt(0),
+				SubmitBlockHeight: 10,
+			},
+			MaybeProposerMoniker: primptr.String("Validator"),
+		})
+		Expect(err).To(BeNil())
+
+		Expect(proposal.Id).To(Equal("1"))
+		Expect(proposal.ProposerMoniker).To(Equal("Validator"))
+		Expect(proposal.ProposerOperatorAddress).To(Equal(""))
+		Expect(proposal.TotalVote).To(Equal("0"))
+		Expect(proposal.VotingStartTime).To(BeNil())
+		Expect(proposal.VotingEndBlockHeight).To(Equal(int64(0)))
+		Expect(proposal.Data.AsInterface()).To(BeNil())
+	})
+
+	It("should convert coins", func() {
+		Expect(services.CoinsToProto(coin.NewEmptyCoins())).To(Equal([]*pb.Coin{}))
+	})
+})
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+var (
+	ErrNotFound            = status.Error(codes.NotFound, "record not found")
+	ErrInternalServerError = status.Error(codes.Internal, "internal server error")
+	// The method reads the views of a projection which is not enabled
+	ErrProjectionNotServed = status.Error(codes.Unimplemented, "projection views not served")
+)
+
+// findError converts the error of finding a record to the gRPC status error. Errors other than
+// not found are logged and hidden from the client.
+func findError(logger applogger.Logger, err error, action string) error {
+	if errors.Is(err, rdb.ErrNoRows) {
+		return ErrNotFound
+	}
+	logger.Errorf("error %s: %v", action, err)
+	return ErrInternalServerError
+}
+
+func internalError(logger applogger.Logger, err error, action string) error {
+	logger.Errorf("error %s: %v", action, err)
+	return ErrInternalServerError
+}
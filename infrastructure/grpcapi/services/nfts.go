@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	nft_view "github.com/crypto-com/chain-indexing/projection/nft/view"
+)
+
+var _ pb.NFTServiceServer = &NFTs{}
+
+type NFTs struct {
+	pb.UnimplementedNFTServiceServer
+
+	logger applogger.Logger
+
+	denomsView *nft_view.Denoms
+	tokensView *nft_view.Tokens
+}
+
+func NewNFTs(logger applogger.Logger, rdbHandle *rdb.Handle) *NFTs {
+	return &NFTs{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "NFTsService",
+		}),
+
+		denomsView: nft_view.NewDenoms(rdbHandle),
+		tokensView: nft_view.NewTokens(rdbHandle),
+	}
+}
+
+func (service *NFTs) GetDenom(_ context.Context, req *pb.GetDenomRequest) (*pb.Denom, error) {
+	denom, err := service.denomsView.FindById(req.DenomId)
+	if err != nil {
+		return nil, findError(service.logger, err, "finding denom")
+	}
+
+	return DenomToProto(denom), nil
+}
+
+func (service *NFTs) ListDenoms(_ context.Context, req *pb.ListDenomsRequest) (*pb.ListDenomsResponse, error) {
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := nft_view.DenomListFilter{
+		MaybeCreator: nil,
+	}
+	if req.Creator != "" {
+		filter.MaybeCreator = &req.Creator
+	}
+
+	denoms, paginationResult, err := service.denomsView.List(filter, nft_view.DenomListOrder{
+		CreatedAt: grpcapi.ParseOrder(req.Order),
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing denoms")
+	}
+
+	protoDenoms := make([]*pb.Denom, 0, len(denoms))
+	for i := range denoms {
+		protoDenoms = append(protoDenoms, DenomToProto(&denoms[i]))
+	}
+
+	return &pb.ListDenomsResponse{
+		Denoms:     protoDenoms,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
+
+func (service *NFTs) GetToken(_ context.Context, req *pb.GetTokenRequest) (*pb.Token, error) {
+	token, err := service.tokensView.FindById(req.DenomId, req.TokenId)
+	if err != nil {
+		return nil, findError(service.logger, err, "finding token")
+	}
+
+	return TokenToProto(token), nil
+}
+
+func (service *NFTs) ListTokens(_ context.Context, req *pb.ListTokensRequest) (*pb.ListTokensResponse, error) {
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := nft_view.TokenListFilter{
+		MaybeDenomId: nil,
+		MaybeDrop:    nil,
+		MaybeMinter:  nil,
+		MaybeOwner:   nil,
+	}
+	if req.DenomId != "" {
+		filter.MaybeDenomId = &req.DenomId
+	}
+	if req.Drop != "" {
+		filter.MaybeDrop = &req.Drop
+	}
+	if req.Minter != "" {
+		filter.MaybeMinter = &req.Minter
+	}
+	if req.Owner != "" {
+		filter.MaybeOwner = &req.Owner
+	}
+
+	order := grpcapi.ParseOrder(req.Order)
+	tokens, paginationResult, err := service.tokensView.List(filter, nft_view.TokenListOrder{
+		MintedAt:     order,
+		LastEditedAt: order,
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing tokens")
+	}
+
+	protoTokens := make([]*pb.Token, 0, len(tokens))
+	for i := range tokens {
+		protoTokens = append(protoTokens, TokenToProto(&tokens[i]))
+	}
+
+	return &pb.ListTokensResponse{
+		Tokens:     protoTokens,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+)
+
+var _ pb.ProposalServiceServer = &Proposals{}
+
+type Proposals struct {
+	pb.UnimplementedProposalServiceServer
+
+	logger applogger.Logger
+
+	proposalsView *proposal_view.Proposals
+}
+
+func NewProposals(logger applogger.Logger, rdbHandle *rdb.Handle) *Proposals {
+	return &Proposals{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "ProposalsService",
+		}),
+
+		proposalsView: proposal_view.NewProposals(rdbHandle),
+	}
+}
+
+func (service *Proposals) GetProposal(_ context.Context, req *pb.GetProposalRequest) (*pb.Proposal, error) {
+	proposal, err := service.proposalsView.FindById(req.Id)
+	if err != nil {
+		return nil, findError(service.logger, err, "finding proposal")
+	}
+
+	protoProposal, err := ProposalToProto(proposal)
+	if err != nil {
+		return nil, internalError(service.logger, err, "converting proposal")
+	}
+	return protoProposal, nil
+}
+
+func (service *Proposals) ListProposals(
+	_ context.Context,
+	req *pb.ListProposalsRequest,
+) (*pb.ListProposalsResponse, error) {
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := proposal_view.ProposalListFilter{
+		MaybeStatus:          nil,
+		MaybeProposerAddress: nil,
+	}
+	if req.Status != "" {
+		filter.MaybeStatus = &req.Status
+	}
+	if req.ProposerAddress != "" {
+		filter.MaybeProposerAddress = &req.ProposerAddress
+	}
+
+	proposals, paginationResult, err := service.proposalsView.List(filter, proposal_view.ProposalListOrder{
+		Id: grpcapi.ParseOrder(req.Order),
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing proposals")
+	}
+
+	protoProposals := make([]*pb.Proposal, 0, len(proposals))
+	for i := range proposals {
+		protoProposal, err := ProposalToProto(&proposals[i])
+		if err != nil {
+			return nil, internalError(service.logger, err, "converting proposal")
+		}
+		protoProposals = append(protoProposals, protoProposal)
+	}
+
+	return &pb.ListProposalsResponse{
+		Proposals:  protoProposals,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+)
+
+var _ pb.TransactionServiceServer = &Transactions{}
+
+type Transactions struct {
+	pb.UnimplementedTransactionServiceServer
+
+	logger applogger.Logger
+
+	transactionsView *transaction_view.BlockTransactions
+}
+
+func NewTransactions(logger applogger.Logger, rdbHandle *rdb.Handle) *Transactions {
+	return &Transactions{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "TransactionsService",
+		}),
+
+		transactionsView: transaction_view.NewTransactions(rdbHandle),
+	}
+}
+
+func (service *Transactions) GetTransaction(
+	_ context.Context,
+	req *pb.GetTransactionRequest,
+) (*pb.Transaction, error) {
+	transaction, err := service.transactionsView.FindByHash(req.Hash)
+	if err != nil {
+		return nil, findError(service.logger, err, "finding transaction")
+	}
+
+	protoTransaction, err := TransactionToProto(transaction)
+	if err != nil {
+		return nil, internalError(service.logger, err, "converting transaction")
+	}
+	return protoTransaction, nil
+}
+
+func (service *Transactions) ListTransactions(
+	_ context.Context,
+	req *pb.ListTransactionsRequest,
+) (*pb.ListTransactionsResponse, error) {
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, paginationResult, err := service.transactionsView.List(
+		transaction_view.TransactionsListFilter{},
+		transaction_view.TransactionsListOrder{
+			Height: grpcapi.ParseOrder(req.Order),
+		},
+		pagination,
+	)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing transactions")
+	}
+
+	return transactionsResponse(service.logger, transactions, paginationResult)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/grpcapi/pb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+)
+
+var _ pb.ValidatorServiceServer = &Validators{}
+
+type Validators struct {
+	pb.UnimplementedValidatorServiceServer
+
+	logger applogger.Logger
+
+	validatorsView *validator_view.Validators
+}
+
+func NewValidators(logger applogger.Logger, rdbHandle *rdb.Handle) *Validators {
+	return &Validators{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "ValidatorsService",
+		}),
+
+		validatorsView: validator_view.NewValidators(rdbHandle),
+	}
+}
+
+func (service *Validators) GetValidator(_ context.Context, req *pb.GetValidatorRequest) (*pb.Validator, error) {
+	var identity validator_view.ValidatorIdentity
+	switch validatorIdentity := req.Identity.(type) {
+	case *pb.GetValidatorRequest_OperatorAddress:
+		identity.MaybeOperatorAddress = primptr.String(validatorIdentity.OperatorAddress)
+	case *pb.GetValidatorRequest_ConsensusNodeAddress:
+		identity.MaybeConsensusNodeAddress = primptr.String(validatorIdentity.ConsensusNodeAddress)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either operator address or consensus node address is required")
+	}
+
+	validator, err := service.validatorsView.FindBy(identity)
+	if err != nil {
+		return nil, findError(service.logger, err, "finding validator")
+	}
+
+	return ValidatorToProto(validator), nil
+}
+
+func (service *Validators) ListValidators(
+	_ context.Context,
+	req *pb.ListValidatorsRequest,
+) (*pb.ListValidatorsResponse, error) {
+	pagination, err := grpcapi.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := validator_view.ValidatorsListFilter{
+		MaybeStatuses: nil,
+	}
+	if len(req.Statuses) > 0 {
+		filter.MaybeStatuses = req.Statuses
+	}
+
+	validators, paginationResult, err := service.validatorsView.List(filter, validator_view.ValidatorsListOrder{
+		MaybeStatus:              primptr.String(view.ORDER_ASC),
+		MaybeJoinedAtBlockHeight: primptr.String(view.ORDER_ASC),
+	}, pagination)
+	if err != nil {
+		return nil, internalError(service.logger, err, "listing validators")
+	}
+
+	protoValidators := make([]*pb.Validator, 0, len(validators))
+	for i := range validators {
+		protoValidator := ValidatorToProto(&validators[i].ValidatorRow)
+		protoValidator.PowerPercentage = validators[i].PowerPercentage
+		protoValidator.CumulativePowerPercentage = validators[i].CumulativePowerPercentage
+		protoValidators = append(protoValidators, protoValidator)
+	}
+
+	return &pb.ListValidatorsResponse{
+		Validators: protoValidators,
+		Pagination: grpcapi.PaginationResponseFromResult(paginationResult),
+	}, nil
+}
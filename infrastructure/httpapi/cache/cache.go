@@ -0,0 +1,20 @@
+package cache
+
+import "time"
+
+// Cache stores responses by key. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (*Entry, bool)
+	Set(key string, entry *Entry)
+}
+
+// Entry is a cached response. Version identifies the projection heights the response was computed
+// at, and the entry is stale once the heights advance.
+type Entry struct {
+	Version     string
+	ContentType string
+	Body        []byte
+	ETag        string
+	// Time the response was computed at, which bounds the age of the entry when the rule has MaxAge
+	CreatedAt time.Time
+}
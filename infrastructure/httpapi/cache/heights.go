@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+// HeightSource returns the last handled event heights of the projections keyed by projection id
+type HeightSource interface {
+	LastHandledEventHeights() (map[string]int64, error)
+}
+
+// ProjectionHeights reads the last handled event heights of all projections from the projections
+// table. The heights are re-read at most once per refresh interval, so that a cache hit does not
+// query the database.
+type ProjectionHeights struct {
+	rdb             *rdb.Handle
+	refreshInterval time.Duration
+
+	mutex     sync.Mutex
+	heights   map[string]int64
+	fetchedAt time.Time
+
+	nowFn func() time.Time
+}
+
+func NewProjectionHeights(handle *rdb.Handle, refreshInterval time.Duration) *ProjectionHeights {
+	return &ProjectionHeights{
+		rdb:             handle,
+		refreshInterval: refreshInterval,
+
+		nowFn: time.Now,
+	}
+}
+
+func (projectionHeights *ProjectionHeights) LastHandledEventHeights() (map[string]int64, error) {
+	projectionHeights.mutex.Lock()
+	defer projectionHeights.mutex.Unlock()
+
+	now := projectionHeights.nowFn()
+	if projectionHeights.heights != nil && now.Sub(projectionHeights.fetchedAt) < projectionHeights.refreshInterval {
+		return projectionHeights.heights, nil
+	}
+
+	heights, err := rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE).GetAllLastHandledEventHeights(
+		projectionHeights.rdb,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	projectionHeights.heights = heights
+	projectionHeights.fetchedAt = now
+	return heights, nil
+}
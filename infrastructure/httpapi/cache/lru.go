@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRU is an in-memory Cache which evicts the least recently used entries beyond the capacity
+type LRU struct {
+	maxEntries int
+
+	mutex   sync.Mutex
+	list    *list.List
+	entries map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *Entry
+}
+
+func NewLRU(maxEntries int) *LRU {
+	return &LRU{
+		maxEntries: maxEntries,
+
+		list:    list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (lru *LRU) Get(key string) (*Entry, bool) {
+	lru.mutex.Lock()
+	defer lru.mutex.Unlock()
+
+	element, ok := lru.entries[key]
+	if !ok {
+		return nil, false
+	}
+	lru.list.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true
+}
+
+func (lru *LRU) Set(key string, entry *Entry) {
+	lru.mutex.Lock()
+	defer lru.mutex.Unlock()
+
+	if element, ok := lru.entries[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		lru.list.MoveToFront(element)
+		return
+	}
+
+	lru.entries[key] = lru.list.PushFront(&lruItem{
+		key:   key,
+		entry: entry,
+	})
+	for lru.list.Len() > lru.maxEntries {
+		oldest := lru.list.Back()
+		lru.list.Remove(oldest)
+		delete(lru.entries, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (lru *LRU) Len() int {
+	lru.mutex.Lock()
+	defer lru.mutex.Unlock()
+
+	return lru.list.Len()
+}
@@ -0,0 +1,40 @@
+package cache_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/cache"
+)
+
+var _ = Describe("LRU", func() {
+	It("should evict the least recently used entry beyond capacity", func() {
+		lru := cache.NewLRU(2)
+
+		lru.Set("a", &cache.Entry{Version: "1"})
+		lru.Set("b", &cache.Entry{Version: "1"})
+		_, found := lru.Get("a")
+		Expect(found).To(BeTrue())
+
+		lru.Set("c", &cache.Entry{Version: "1"})
+		Expect(lru.Len()).To(Equal(2))
+		_, found = lru.Get("b")
+		Expect(found).To(BeFalse())
+		_, found = lru.Get("a")
+		Expect(found).To(BeTrue())
+		_, found = lru.Get("c")
+		Expect(found).To(BeTrue())
+	})
+
+	It("should replace existing entry", func() {
+		lru := cache.NewLRU(2)
+
+		lru.Set("a", &cache.Entry{Version: "1"})
+		lru.Set("a", &cache.Entry{Version: "2"})
+
+		entry, found := lru.Get("a")
+		Expect(found).To(BeTrue())
+		Expect(entry.Version).To(Equal("2"))
+		Expect(lru.Len()).To(Equal(1))
+	})
+})
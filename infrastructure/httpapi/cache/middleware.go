@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const HEADER_CACHE = "X-Cache"
+
+const (
+	CACHE_HIT  = "HIT"
+	CACHE_MISS = "MISS"
+)
+
+// Rule enables caching of a route. The cached responses are invalidated when the last handled
+// event height of any of the projections advances.
+type Rule struct {
+	Projections []string
+	// Only cache the first pages of a paginated route. Zero means caching every page.
+	MaxPage int
+	// Optional. Age beyond which a cached response is recomputed even though the projection heights
+	// have not advanced, for a route also computed from data outside of the projections, e.g. the
+	// Cosmos app. Zero means the responses only go stale with the projection heights.
+	MaxAge time.Duration
+}
+
+// Middleware caches the successful GET responses of the routes with a Rule, keyed by route and
+// query. Responses carry ETag and Cache-Control, and conditional requests matching the ETag are
+// answered with status 304.
+type Middleware struct {
+	logger applogger.Logger
+
+	cache       Cache
+	heights     HeightSource
+	routePrefix string
+	rules       map[string]Rule
+	maxAge      time.Duration
+}
+
+// NewMiddleware creates the cache middleware. Rules are keyed by route path without routePrefix,
+// and maxAge is the duration clients and CDNs may reuse a response without revalidation.
+func NewMiddleware(
+	logger applogger.Logger,
+	cache Cache,
+	heights HeightSource,
+	routePrefix string,
+	rules map[string]Rule,
+	maxAge time.Duration,
+) *Middleware {
+	if routePrefix == "/" {
+		routePrefix = ""
+	}
+
+	return &Middleware{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "CacheMiddleware",
+		}),
+
+		cache:       cache,
+		heights:     heights,
+		routePrefix: routePrefix,
+		rules:       rules,
+		maxAge:      maxAge,
+	}
+}
+
+// RouteMiddleware is a httpapi.RouteMiddleware
+func (middleware *Middleware) RouteMiddleware(
+	method string,
+	path string,
+	handler fasthttp.RequestHandler,
+) fasthttp.RequestHandler {
+	rule, ok := middleware.rules[strings.TrimPrefix(path, middleware.routePrefix)]
+	if !ok || method != fasthttp.MethodGet {
+		return handler
+	}
+
+	maxAge := middleware.maxAge
+	if rule.MaxAge > 0 && rule.MaxAge < maxAge {
+		maxAge = rule.MaxAge
+	}
+	cacheControl := fmt.Sprintf("public, max-age=%d", int64(maxAge.Seconds()))
+	return func(ctx *fasthttp.RequestCtx) {
+		if rule.MaxPage > 0 && ctx.QueryArgs().GetUintOrZero("page") > rule.MaxPage {
+			handler(ctx)
+			return
+		}
+
+		version, err := middleware.version(rule)
+		if err != nil {
+			middleware.logger.Errorf("error getting projection heights: %v", err)
+			handler(ctx)
+			return
+		}
+
+		key := cacheKey(ctx)
+		if entry, found := middleware.cache.Get(key); found && entry.Version == version &&
+			(rule.MaxAge == 0 || time.Since(entry.CreatedAt) < rule.MaxAge) {
+			ctx.Response.Header.Set(HEADER_CACHE, CACHE_HIT)
+			middleware.respond(ctx, entry, cacheControl)
+			return
+		}
+
+		handler(ctx)
+		if ctx.Response.StatusCode() != fasthttp.StatusOK || ctx.Response.IsBodyStream() {
+			return
+		}
+
+		body := append([]byte(nil), ctx.Response.Body()...)
+		entry := &Entry{
+			Version:     version,
+			ContentType: string(ctx.Response.Header.ContentType()),
+			Body:        body,
+			ETag:        etagOf(body),
+			CreatedAt:   time.Now(),
+		}
+		middleware.cache.Set(key, entry)
+
+		ctx.Response.Header.Set(HEADER_CACHE, CACHE_MISS)
+		middleware.respond(ctx, entry, cacheControl)
+	}
+}
+
+func (middleware *Middleware) respond(ctx *fasthttp.RequestCtx, entry *Entry, cacheControl string) {
+	ctx.Response.Header.Set(fasthttp.HeaderETag, entry.ETag)
+	ctx.Response.Header.Set(fasthttp.HeaderCacheControl, cacheControl)
+	ctx.Response.Header.SetContentType(entry.ContentType)
+
+	if isNotModified(ctx, entry.ETag) {
+		ctx.Response.ResetBody()
+		ctx.SetStatusCode(fasthttp.StatusNotModified)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetBody(entry.Body)
+}
+
+// version returns the projection heights of the rule, which changes whenever any of them advances
+func (middleware *Middleware) version(rule Rule) (string, error) {
+	heights, err := middleware.heights.LastHandledEventHeights()
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	for _, projection := range rule.Projections {
+		builder.WriteString(projection)
+		builder.WriteString("=")
+		builder.WriteString(strconv.FormatInt(heights[projection], 10))
+		builder.WriteString(";")
+	}
+	return builder.String(), nil
+}
+
+// cacheKey returns the request path with the query arguments sorted, so that the same query in a
+// different order shares the entry
+func cacheKey(ctx *fasthttp.RequestCtx) string {
+	args := make([]string, 0, ctx.QueryArgs().Len())
+	ctx.QueryArgs().VisitAll(func(key, value []byte) {
+		args = append(args, string(key)+"="+string(value))
+	})
+	sort.Strings(args)
+
+	return string(ctx.Path()) + "?" + strings.Join(args, "&")
+}
+
+func etagOf(body []byte) string {
+	hash := sha1.Sum(body)
+	return "\"" + hex.EncodeToString(hash[:]) + "\""
+}
+
+func isNotModified(ctx *fasthttp.RequestCtx, etag string) bool {
+	ifNoneMatch := string(ctx.Request.Header.Peek(fasthttp.HeaderIfNoneMatch))
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
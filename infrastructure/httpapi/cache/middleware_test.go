@@ -0,0 +1,146 @@
+package cache_test
+
+import (
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/cache"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("Middleware", func() {
+	var heights *fakeHeightSource
+	var handleCount int
+	var handler fasthttp.RequestHandler
+
+	BeforeEach(func() {
+		heights = &fakeHeightSource{
+			heights: map[string]int64{
+				"Block":       100,
+				"Transaction": 90,
+			},
+		}
+		handleCount = 0
+
+		middleware := cache.NewMiddleware(
+			NewFakeLogger(),
+			cache.NewLRU(10),
+			heights,
+			"/indexing",
+			map[string]cache.Rule{
+				"/api/v1/blocks": {
+					Projections: []string{"Block"},
+					MaxPage:     2,
+				},
+			},
+			5*time.Second,
+		)
+		handler = middleware.RouteMiddleware(
+			fasthttp.MethodGet,
+			"/indexing/api/v1/blocks",
+			func(ctx *fasthttp.RequestCtx) {
+				handleCount += 1
+				ctx.SetContentType("application/json")
+				ctx.SetBodyString(`{"count":` + strconv.Itoa(handleCount) + `}`)
+			},
+		)
+	})
+
+	serve := func(uri string, maybeIfNoneMatch string) *fasthttp.RequestCtx {
+		var request fasthttp.Request
+		request.SetRequestURI(uri)
+		if maybeIfNoneMatch != "" {
+			request.Header.Set(fasthttp.HeaderIfNoneMatch, maybeIfNoneMatch)
+		}
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Init(&request, nil, nil)
+
+		handler(ctx)
+		return ctx
+	}
+
+	It("should serve cached response until the projection height advances", func() {
+		ctx := serve("/indexing/api/v1/blocks?limit=10&page=1", "")
+		Expect(string(ctx.Response.Body())).To(Equal(`{"count":1}`))
+		Expect(string(ctx.Response.Header.Peek(cache.HEADER_CACHE))).To(Equal(cache.CACHE_MISS))
+		Expect(string(ctx.Response.Header.Peek(fasthttp.HeaderCacheControl))).To(Equal("public, max-age=5"))
+		Expect(string(ctx.Response.Header.Peek(fasthttp.HeaderETag))).NotTo(BeEmpty())
+
+		ctx = serve("/indexing/api/v1/blocks?page=1&limit=10", "")
+		Expect(string(ctx.Response.Body())).To(Equal(`{"count":1}`))
+		Expect(string(ctx.Response.Header.Peek(cache.HEADER_CACHE))).To(Equal(cache.CACHE_HIT))
+		Expect(string(ctx.Response.Header.ContentType())).To(Equal("application/json"))
+
+		heights.heights["Transaction"] = 91
+		ctx = serve("/indexing/api/v1/blocks?page=1&limit=10", "")
+		Expect(string(ctx.Response.Header.Peek(cache.HEADER_CACHE))).To(Equal(cache.CACHE_HIT))
+
+		heights.heights["Block"] = 101
+		ctx = serve("/indexing/api/v1/blocks?page=1&limit=10", "")
+		Expect(string(ctx.Response.Body())).To(Equal(`{"count":2}`))
+		Expect(handleCount).To(Equal(2))
+	})
+
+	It("should respond not modified when ETag matches", func() {
+		ctx := serve("/indexing/api/v1/blocks", "")
+		etag := string(ctx.Response.Header.Peek(fasthttp.HeaderETag))
+
+		ctx = serve("/indexing/api/v1/blocks", "W/"+etag)
+		Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusNotModified))
+		Expect(ctx.Response.Body()).To(BeEmpty())
+	})
+
+	It("should not cache pages beyond max page", func() {
+		serve("/indexing/api/v1/blocks?page=3", "")
+		ctx := serve("/indexing/api/v1/blocks?page=3", "")
+
+		Expect(ctx.Response.Header.Peek(cache.HEADER_CACHE)).To(BeEmpty())
+		Expect(handleCount).To(Equal(2))
+	})
+
+	It("should recompute response older than the rule max age", func() {
+		middleware := cache.NewMiddleware(
+			NewFakeLogger(),
+			cache.NewLRU(10),
+			heights,
+			"/indexing",
+			map[string]cache.Rule{
+				"/api/v1/status": {
+					Projections: []string{"Block"},
+					MaxAge:      50 * time.Millisecond,
+				},
+			},
+			5*time.Second,
+		)
+		handler = middleware.RouteMiddleware(
+			fasthttp.MethodGet,
+			"/indexing/api/v1/status",
+			func(ctx *fasthttp.RequestCtx) {
+				handleCount += 1
+				ctx.SetBodyString(`{"count":` + strconv.Itoa(handleCount) + `}`)
+			},
+		)
+
+		ctx := serve("/indexing/api/v1/status", "")
+		Expect(string(ctx.Response.Header.Peek(fasthttp.HeaderCacheControl))).To(Equal("public, max-age=0"))
+		ctx = serve("/indexing/api/v1/status", "")
+		Expect(string(ctx.Response.Header.Peek(cache.HEADER_CACHE))).To(Equal(cache.CACHE_HIT))
+
+		time.Sleep(60 * time.Millisecond)
+		ctx = serve("/indexing/api/v1/status", "")
+		Expect(string(ctx.Response.Header.Peek(cache.HEADER_CACHE))).To(Equal(cache.CACHE_MISS))
+		Expect(string(ctx.Response.Body())).To(Equal(`{"count":2}`))
+	})
+})
+
+type fakeHeightSource struct {
+	heights map[string]int64
+}
+
+func (source *fakeHeightSource) LastHandledEventHeights() (map[string]int64, error) {
+	return source.heights, nil
+}
@@ -9,5 +9,9 @@ var (
 	ErrInvalidPage       = errors.New("invalid page number")
 	ErrInvalidLimit      = errors.New("invalid page limit")
 
-	ErrInvalidQuery = errors.New("invalid query parameter")
+	ErrInvalidQuery      = errors.New("invalid query parameter")
+	ErrInvalidParameters = errors.New("invalid request parameters")
+
+	ErrUnauthorized    = errors.New("missing or invalid API key")
+	ErrTooManyRequests = errors.New("too many requests")
 )
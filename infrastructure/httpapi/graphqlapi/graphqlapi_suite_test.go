@@ -0,0 +1,13 @@
+package graphqlapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGraphQLAPI(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GraphQL API Suite")
+}
@@ -0,0 +1,249 @@
+package graphqlapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+var ErrQueryTooDeep = errors.New("query exceeds maximum depth")
+var ErrQueryTooComplex = errors.New("query exceeds maximum complexity")
+
+// Limits bounds the cost of a query before it is executed. Zero means unlimited.
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// QueryCost is the static cost of a query. Every selected field costs 1, and the cost of the
+// selections under a paginated field is multiplied by its page size.
+type QueryCost struct {
+	Depth      int
+	Complexity int
+}
+
+// Check analyses the query and returns ErrQueryTooDeep or ErrQueryTooComplex when it exceeds the
+// limits
+func (limits Limits) Check(
+	schema graphql.Schema,
+	query string,
+	operationName string,
+	variables map[string]interface{},
+) (*QueryCost, error) {
+	cost, err := AnalyseQuery(schema, query, operationName, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if limits.MaxDepth > 0 && cost.Depth > limits.MaxDepth {
+		return cost, fmt.Errorf("%w: depth %d exceeds %d", ErrQueryTooDeep, cost.Depth, limits.MaxDepth)
+	}
+	if limits.MaxComplexity > 0 && cost.Complexity > limits.MaxComplexity {
+		return cost, fmt.Errorf(
+			"%w: complexity %d exceeds %d", ErrQueryTooComplex, cost.Complexity, limits.MaxComplexity,
+		)
+	}
+
+	return cost, nil
+}
+
+// AnalyseQuery computes the depth and complexity of the operation in the query. Introspection
+// fields are not counted.
+func AnalyseQuery(
+	schema graphql.Schema,
+	query string,
+	operationName string,
+	variables map[string]interface{},
+) (*QueryCost, error) {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: query,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing query: %v", err)
+	}
+
+	analyser := &queryAnalyser{
+		schema:    schema,
+		variables: variables,
+		fragments: make(map[string]*ast.FragmentDefinition),
+	}
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			analyser.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				if operation == nil {
+					operation = definition
+				}
+			}
+		}
+	}
+	if operation == nil {
+		return nil, errors.New("no operation found in query")
+	}
+	if operation.Operation != ast.OperationTypeQuery {
+		return nil, fmt.Errorf("unsupported operation: %s", operation.Operation)
+	}
+
+	depth, complexity := analyser.selectionSet(schema.QueryType(), operation.SelectionSet, 0)
+	return &QueryCost{
+		Depth:      depth,
+		Complexity: complexity,
+	}, nil
+}
+
+type queryAnalyser struct {
+	schema    graphql.Schema
+	variables map[string]interface{}
+	fragments map[string]*ast.FragmentDefinition
+}
+
+// selectionSet returns the depth and complexity of the selection set. fragmentLevel bounds the
+// nesting of fragment spreads so that fragment cycles terminate.
+func (analyser *queryAnalyser) selectionSet(
+	parentType *graphql.Object,
+	selectionSet *ast.SelectionSet,
+	fragmentLevel int,
+) (int, int) {
+	if selectionSet == nil || fragmentLevel > len(analyser.fragments) {
+		return 0, 0
+	}
+
+	maxDepth := 0
+	complexity := 0
+	for _, selection := range selectionSet.Selections {
+		var depth, cost int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			depth, cost = analyser.field(parentType, selection, fragmentLevel)
+		case *ast.InlineFragment:
+			fragmentType := parentType
+			if selection.TypeCondition != nil {
+				fragmentType = analyser.objectType(selection.TypeCondition.Name.Value, parentType)
+			}
+			depth, cost = analyser.selectionSet(fragmentType, selection.SelectionSet, fragmentLevel)
+		case *ast.FragmentSpread:
+			fragment, ok := analyser.fragments[selection.Name.Value]
+			if !ok {
+				continue
+			}
+			fragmentType := analyser.objectType(fragment.TypeCondition.Name.Value, parentType)
+			depth, cost = analyser.selectionSet(fragmentType, fragment.SelectionSet, fragmentLevel+1)
+		}
+
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+		complexity += cost
+	}
+
+	return maxDepth, complexity
+}
+
+func (analyser *queryAnalyser) field(
+	parentType *graphql.Object,
+	field *ast.Field,
+	fragmentLevel int,
+) (int, int) {
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return 0, 0
+	}
+
+	var maybeDefinition *graphql.FieldDefinition
+	var maybeChildType *graphql.Object
+	if parentType != nil {
+		maybeDefinition = parentType.Fields()[field.Name.Value]
+	}
+	if maybeDefinition != nil {
+		maybeChildType = unwrapObject(maybeDefinition.Type)
+	}
+
+	childDepth, childComplexity := analyser.selectionSet(maybeChildType, field.SelectionSet, fragmentLevel)
+
+	return childDepth + 1, 1 + childComplexity*analyser.multiplier(maybeDefinition, field)
+}
+
+// multiplier returns the page size of a paginated field, or 1 for other fields
+func (analyser *queryAnalyser) multiplier(maybeDefinition *graphql.FieldDefinition, field *ast.Field) int {
+	if maybeDefinition == nil {
+		return 1
+	}
+
+	limit := 0
+	hasLimitArg := false
+	for _, arg := range maybeDefinition.Args {
+		if arg.Name() == "limit" {
+			hasLimitArg = true
+			if defaultLimit, ok := arg.DefaultValue.(int); ok {
+				limit = defaultLimit
+			}
+		}
+	}
+	if !hasLimitArg {
+		return 1
+	}
+
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+		if value, ok := analyser.intValue(arg.Value); ok {
+			limit = value
+		}
+	}
+
+	if limit < 1 {
+		return 1
+	}
+	return limit
+}
+
+func (analyser *queryAnalyser) intValue(value ast.Value) (int, bool) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		intValue, err := strconv.Atoi(value.Value)
+		if err != nil {
+			return 0, false
+		}
+		return intValue, true
+	case *ast.Variable:
+		switch variable := analyser.variables[value.Name.Value].(type) {
+		case int:
+			return variable, true
+		case int64:
+			return int(variable), true
+		case float64:
+			return int(variable), true
+		}
+	}
+	return 0, false
+}
+
+func (analyser *queryAnalyser) objectType(name string, fallback *graphql.Object) *graphql.Object {
+	if object, ok := analyser.schema.Type(name).(*graphql.Object); ok {
+		return object
+	}
+	return fallback
+}
+
+func unwrapObject(outputType graphql.Output) *graphql.Object {
+	for {
+		switch wrappedType := outputType.(type) {
+		case *graphql.NonNull:
+			outputType = wrappedType.OfType
+		case *graphql.List:
+			outputType = wrappedType.OfType
+		case *graphql.Object:
+			return wrappedType
+		default:
+			return nil
+		}
+	}
+}
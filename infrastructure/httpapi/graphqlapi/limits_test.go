@@ -0,0 +1,131 @@
+package graphqlapi_test
+
+import (
+	"errors"
+
+	"github.com/graphql-go/graphql"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/graphqlapi"
+)
+
+var _ = Describe("Limits", func() {
+	var schema graphql.Schema
+
+	BeforeEach(func() {
+		var err error
+		schema, err = graphqlapi.NewSchema(&rdb.Handle{}, "tcrocncl", nil)
+		Expect(err).To(BeNil())
+	})
+
+	Describe("AnalyseQuery", func() {
+		It("should count every selected field once when there is no paginated field", func() {
+			cost, err := graphqlapi.AnalyseQuery(schema, `{ block(height: 1) { blockHeight blockHash } }`, "", nil)
+			Expect(err).To(BeNil())
+			Expect(*cost).To(Equal(graphqlapi.QueryCost{
+				Depth:      2,
+				Complexity: 3,
+			}))
+		})
+
+		It("should multiply the selections under a paginated field by its default page size", func() {
+			cost, err := graphqlapi.AnalyseQuery(schema, `{ blocks { items { blockHeight } } }`, "", nil)
+			Expect(err).To(BeNil())
+			Expect(*cost).To(Equal(graphqlapi.QueryCost{
+				Depth:      3,
+				Complexity: 1 + graphqlapi.DEFAULT_PAGINATION_LIMIT*2,
+			}))
+		})
+
+		It("should multiply the nested paginated selections by the provided limits", func() {
+			cost, err := graphqlapi.AnalyseQuery(
+				schema,
+				`query ($limit: Int) {
+					blocks(limit: 10) {
+						items {
+							transactions(limit: $limit) { items { hash } }
+						}
+					}
+				}`,
+				"",
+				map[string]interface{}{
+					"limit": float64(5),
+				},
+			)
+			Expect(err).To(BeNil())
+			Expect(cost.Depth).To(Equal(5))
+			// blocks + 10 * (items + transactions + 5 * (items + hash))
+			Expect(cost.Complexity).To(Equal(1 + 10*(1+1+5*2)))
+		})
+
+		It("should analyse the selections of fragments", func() {
+			cost, err := graphqlapi.AnalyseQuery(
+				schema,
+				`query Account {
+					account(address: "tcro1") { ...AccountFields }
+				}
+				fragment AccountFields on Account {
+					address
+					validator { ... on Validator { moniker } }
+				}`,
+				"Account",
+				nil,
+			)
+			Expect(err).To(BeNil())
+			Expect(*cost).To(Equal(graphqlapi.QueryCost{
+				Depth:      3,
+				Complexity: 4,
+			}))
+		})
+
+		It("should not count introspection fields", func() {
+			cost, err := graphqlapi.AnalyseQuery(schema, `{ __schema { types { name } } }`, "", nil)
+			Expect(err).To(BeNil())
+			Expect(*cost).To(Equal(graphqlapi.QueryCost{}))
+		})
+
+		It("should return error when the query cannot be parsed", func() {
+			_, err := graphqlapi.AnalyseQuery(schema, `{ blocks {`, "", nil)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("Check", func() {
+		It("should return ErrQueryTooDeep when the query exceeds the maximum depth", func() {
+			limits := graphqlapi.Limits{
+				MaxDepth: 4,
+			}
+			_, err := limits.Check(
+				schema,
+				`{ account(address: "tcro1") { transactions { items { block { blockHeight } } } } }`,
+				"",
+				nil,
+			)
+			Expect(errors.Is(err, graphqlapi.ErrQueryTooDeep)).To(BeTrue())
+		})
+
+		It("should return ErrQueryTooComplex when the query exceeds the maximum complexity", func() {
+			limits := graphqlapi.Limits{
+				MaxComplexity: 1000,
+			}
+			_, err := limits.Check(
+				schema,
+				`{ blocks(limit: 100) { items { transactions(limit: 100) { items { hash } } } } }`,
+				"",
+				nil,
+			)
+			Expect(errors.Is(err, graphqlapi.ErrQueryTooComplex)).To(BeTrue())
+		})
+
+		It("should accept the query within the limits", func() {
+			limits := graphqlapi.Limits{
+				MaxDepth:      10,
+				MaxComplexity: 1000,
+			}
+			_, err := limits.Check(schema, `{ blocks(limit: 10) { items { blockHeight } } }`, "", nil)
+			Expect(err).To(BeNil())
+		})
+	})
+})
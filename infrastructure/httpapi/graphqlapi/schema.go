@@ -0,0 +1,888 @@
+package graphqlapi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	account_transaction_view "github.com/crypto-com/chain-indexing/projection/account_transaction/view"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	nft_view "github.com/crypto-com/chain-indexing/projection/nft/view"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	"github.com/crypto-com/chain-indexing/projection/validator/constants"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+)
+
+// Account is the source of the Account type. Account details are spread across the views, so only
+// the address is resolved eagerly.
+type Account struct {
+	Address string `json:"address"`
+}
+
+type schemaBuilder struct {
+	blocksView              *block_view.Blocks
+	transactionsView        *transaction_view.BlockTransactions
+	accountMessagesView     *account_message_view.AccountMessages
+	accountTransactionsView *account_transaction_view.AccountTransactions
+	validatorsView          *validator_view.Validators
+	proposalsView           *proposal_view.Proposals
+	denomsView              *nft_view.Denoms
+	tokensView              *nft_view.Tokens
+
+	validatorAddressPrefix string
+
+	projections view.Projections
+
+	blockType              *graphql.Object
+	transactionType        *graphql.Object
+	messageType            *graphql.Object
+	accountType            *graphql.Object
+	accountMessageType     *graphql.Object
+	accountTransactionType *graphql.Object
+	validatorType          *graphql.Object
+	proposalType           *graphql.Object
+	nftDenomType           *graphql.Object
+	nftTokenType           *graphql.Object
+
+	blockListType              *graphql.Object
+	transactionListType        *graphql.Object
+	accountMessageListType     *graphql.Object
+	accountTransactionListType *graphql.Object
+	validatorListType          *graphql.Object
+	proposalListType           *graphql.Object
+	nftDenomListType           *graphql.Object
+	nftTokenListType           *graphql.Object
+}
+
+// NewSchema creates the GraphQL schema backed by the views of the projections. The fields reading the
+// views of the other projections are left out of the schema.
+func NewSchema(
+	rdbHandle *rdb.Handle,
+	validatorAddressPrefix string,
+	projections view.Projections,
+) (graphql.Schema, error) {
+	builder := &schemaBuilder{
+		blocksView:              block_view.NewBlocks(rdbHandle),
+		transactionsView:        transaction_view.NewTransactions(rdbHandle),
+		accountMessagesView:     account_message_view.NewAccountMessages(rdbHandle),
+		accountTransactionsView: account_transaction_view.NewAccountTransactions(rdbHandle),
+		validatorsView:          validator_view.NewValidators(rdbHandle),
+		proposalsView:           proposal_view.NewProposals(rdbHandle),
+		denomsView:              nft_view.NewDenoms(rdbHandle),
+		tokensView:              nft_view.NewTokens(rdbHandle),
+
+		validatorAddressPrefix: validatorAddressPrefix,
+
+		projections: projections,
+	}
+
+	return builder.build()
+}
+
+// fieldProjections are the projections whose views are read by the fields, keyed by the names of the
+// object type and the field. Fields of the types only reachable from the fields listed here, e.g. the
+// NFT token of a denom, are not listed.
+var fieldProjections = map[string]map[string]string{
+	"Query": {
+		"block":        "Block",
+		"blocks":       "Block",
+		"transaction":  "Transaction",
+		"transactions": "Transaction",
+		"validator":    "Validator",
+		"validators":   "Validator",
+		"proposal":     "Proposal",
+		"proposals":    "Proposal",
+		"nftDenom":     "NFT",
+		"nftDenoms":    "NFT",
+		"nftToken":     "NFT",
+		"nftTokens":    "NFT",
+	},
+	"Block": {
+		"transactions": "Transaction",
+	},
+	"Transaction": {
+		"block": "Block",
+	},
+	"Account": {
+		"messages":     "AccountMessage",
+		"transactions": "AccountTransaction",
+		"validator":    "Validator",
+		"proposals":    "Proposal",
+		"nftTokens":    "NFT",
+	},
+	"AccountMessage": {
+		"transaction": "Transaction",
+	},
+	"AccountTransaction": {
+		"block": "Block",
+	},
+}
+
+// servedFields removes the fields of the object type reading the views of the projections not served
+func (builder *schemaBuilder) servedFields(typeName string, fields graphql.Fields) graphql.Fields {
+	for fieldName, projection := range fieldProjections[typeName] {
+		if !builder.projections.Serves(projection) {
+			delete(fields, fieldName)
+		}
+	}
+	return fields
+}
+
+func (builder *schemaBuilder) build() (graphql.Schema, error) {
+	builder.messageType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Message",
+		Fields: jsonFields(graphql.Fields{
+			"type":    &graphql.Field{Type: graphql.String},
+			"content": &graphql.Field{Type: JSON},
+		}),
+	})
+
+	// Object types referencing each other are declared with thunks
+	builder.blockType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Block",
+		Fields: graphql.FieldsThunk(builder.blockFields),
+	})
+	builder.transactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Transaction",
+		Fields: graphql.FieldsThunk(builder.transactionFields),
+	})
+	builder.accountType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Account",
+		Fields: graphql.FieldsThunk(builder.accountFields),
+	})
+	builder.accountMessageType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "AccountMessage",
+		Fields: graphql.FieldsThunk(builder.accountMessageFields),
+	})
+	builder.accountTransactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "AccountTransaction",
+		Fields: graphql.FieldsThunk(builder.accountTransactionFields),
+	})
+	builder.validatorType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Validator",
+		Fields: graphql.FieldsThunk(builder.validatorFields),
+	})
+	builder.proposalType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Proposal",
+		Fields: graphql.FieldsThunk(builder.proposalFields),
+	})
+	builder.nftDenomType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "NFTDenom",
+		Fields: graphql.FieldsThunk(builder.nftDenomFields),
+	})
+	builder.nftTokenType = graphql.NewObject(graphql.ObjectConfig{
+		Name:   "NFTToken",
+		Fields: graphql.FieldsThunk(builder.nftTokenFields),
+	})
+
+	builder.blockListType = newListType("BlockList", builder.blockType)
+	builder.transactionListType = newListType("TransactionList", builder.transactionType)
+	builder.accountMessageListType = newListType("AccountMessageList", builder.accountMessageType)
+	builder.accountTransactionListType = newListType("AccountTransactionList", builder.accountTransactionType)
+	builder.validatorListType = newListType("ValidatorList", builder.validatorType)
+	builder.proposalListType = newListType("ProposalList", builder.proposalType)
+	builder.nftDenomListType = newListType("NFTDenomList", builder.nftDenomType)
+	builder.nftTokenListType = newListType("NFTTokenList", builder.nftTokenType)
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: builder.queryFields(),
+		}),
+	})
+}
+
+func (builder *schemaBuilder) queryFields() graphql.Fields {
+	return builder.servedFields("Query", graphql.Fields{
+		"block": &graphql.Field{
+			Type: builder.blockType,
+			Args: graphql.FieldConfigArgument{
+				"height": &graphql.ArgumentConfig{Type: graphql.Int},
+				"hash":   &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: builder.resolveBlock,
+		},
+		"blocks": &graphql.Field{
+			Type: builder.blockListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"order": &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: builder.resolveBlocks,
+		},
+		"transaction": &graphql.Field{
+			Type: builder.transactionType,
+			Args: graphql.FieldConfigArgument{
+				"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return builder.findTransaction(p.Args["hash"].(string))
+			},
+		},
+		"transactions": &graphql.Field{
+			Type: builder.transactionListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"blockHeight": &graphql.ArgumentConfig{Type: graphql.Int},
+				"order":       &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				var maybeBlockHeight *int64
+				if blockHeight, ok := p.Args["blockHeight"].(int); ok {
+					maybeBlockHeight = primptr.Int64(int64(blockHeight))
+				}
+				return builder.listTransactions(maybeBlockHeight, p.Args)
+			},
+		},
+		"account": &graphql.Field{
+			Type: builder.accountType,
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return &Account{
+					Address: p.Args["address"].(string),
+				}, nil
+			},
+		},
+		"validator": &graphql.Field{
+			Type: builder.validatorType,
+			Args: graphql.FieldConfigArgument{
+				"operatorAddress":      &graphql.ArgumentConfig{Type: graphql.String},
+				"consensusNodeAddress": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				identity := validator_view.ValidatorIdentity{
+					MaybeOperatorAddress:      optStringArg(p.Args, "operatorAddress"),
+					MaybeConsensusNodeAddress: optStringArg(p.Args, "consensusNodeAddress"),
+				}
+				if identity.MaybeOperatorAddress == nil && identity.MaybeConsensusNodeAddress == nil {
+					return nil, errors.New("either operatorAddress or consensusNodeAddress is required")
+				}
+				return builder.findValidator(identity)
+			},
+		},
+		"validators": &graphql.Field{
+			Type: builder.validatorListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"statuses": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.String)},
+			}),
+			Resolve: builder.resolveValidators,
+		},
+		"proposal": &graphql.Field{
+			Type: builder.proposalType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				proposal, err := builder.proposalsView.FindById(p.Args["id"].(string))
+				return optRow(proposal, err, "proposal")
+			},
+		},
+		"proposals": &graphql.Field{
+			Type: builder.proposalListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"status":   &graphql.ArgumentConfig{Type: graphql.String},
+				"proposer": &graphql.ArgumentConfig{Type: graphql.String},
+				"order":    &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return builder.listProposals(proposal_view.ProposalListFilter{
+					MaybeStatus:          optStringArg(p.Args, "status"),
+					MaybeProposerAddress: optStringArg(p.Args, "proposer"),
+				}, p.Args)
+			},
+		},
+		"nftDenom": &graphql.Field{
+			Type: builder.nftDenomType,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return builder.findDenom(p.Args["id"].(string))
+			},
+		},
+		"nftDenoms": &graphql.Field{
+			Type: builder.nftDenomListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"creator": &graphql.ArgumentConfig{Type: graphql.String},
+				"order":   &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: builder.resolveDenoms,
+		},
+		"nftToken": &graphql.Field{
+			Type: builder.nftTokenType,
+			Args: graphql.FieldConfigArgument{
+				"denomId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"tokenId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				token, err := builder.tokensView.FindById(p.Args["denomId"].(string), p.Args["tokenId"].(string))
+				return optRow(token, err, "NFT token")
+			},
+		},
+		"nftTokens": &graphql.Field{
+			Type: builder.nftTokenListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"denomId": &graphql.ArgumentConfig{Type: graphql.String},
+				"drop":    &graphql.ArgumentConfig{Type: graphql.String},
+				"minter":  &graphql.ArgumentConfig{Type: graphql.String},
+				"owner":   &graphql.ArgumentConfig{Type: graphql.String},
+				"order":   &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return builder.listTokens(nft_view.TokenListFilter{
+					MaybeDenomId: optStringArg(p.Args, "denomId"),
+					MaybeDrop:    optStringArg(p.Args, "drop"),
+					MaybeMinter:  optStringArg(p.Args, "minter"),
+					MaybeOwner:   optStringArg(p.Args, "owner"),
+				}, p.Args)
+			},
+		},
+	})
+}
+
+func (builder *schemaBuilder) blockFields() graphql.Fields {
+	return builder.servedFields("Block", jsonFields(graphql.Fields{
+		"blockHeight":           &graphql.Field{Type: graphql.Int},
+		"blockHash":             &graphql.Field{Type: graphql.String},
+		"blockTime":             &graphql.Field{Type: JSON},
+		"appHash":               &graphql.Field{Type: graphql.String},
+		"transactionCount":      &graphql.Field{Type: graphql.Int},
+		"committedCouncilNodes": &graphql.Field{Type: JSON},
+		"transactions": &graphql.Field{
+			Type: builder.transactionListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"order": &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				block := p.Source.(*block_view.Block)
+				return builder.listTransactions(primptr.Int64(block.Height), p.Args)
+			},
+		},
+	}))
+}
+
+func (builder *schemaBuilder) transactionFields() graphql.Fields {
+	return builder.servedFields("Transaction", jsonFields(graphql.Fields{
+		"blockHeight":   &graphql.Field{Type: graphql.Int},
+		"blockHash":     &graphql.Field{Type: graphql.String},
+		"blockTime":     &graphql.Field{Type: JSON},
+		"hash":          &graphql.Field{Type: graphql.String},
+		"index":         &graphql.Field{Type: graphql.Int},
+		"success":       &graphql.Field{Type: graphql.Boolean},
+		"code":          &graphql.Field{Type: graphql.Int},
+		"log":           &graphql.Field{Type: graphql.String},
+		"fee":           &graphql.Field{Type: JSON},
+		"feePayer":      &graphql.Field{Type: graphql.String},
+		"feeGranter":    &graphql.Field{Type: graphql.String},
+		"gasWanted":     &graphql.Field{Type: graphql.Int},
+		"gasUsed":       &graphql.Field{Type: graphql.Int},
+		"memo":          &graphql.Field{Type: graphql.String},
+		"timeoutHeight": &graphql.Field{Type: graphql.Int},
+		"messages":      &graphql.Field{Type: graphql.NewList(builder.messageType)},
+		"block": &graphql.Field{
+			Type: builder.blockType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				transaction := p.Source.(transaction_view.TransactionRow)
+				return builder.findBlock(block_view.BlockIdentity{
+					MaybeHeight: primptr.Int64(transaction.BlockHeight),
+				})
+			},
+		},
+	}))
+}
+
+func (builder *schemaBuilder) accountFields() graphql.Fields {
+	return builder.servedFields("Account", jsonFields(graphql.Fields{
+		"address": &graphql.Field{Type: graphql.String},
+		"messages": &graphql.Field{
+			Type: builder.accountMessageListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"msgTypes": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.String)},
+				"order":    &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: builder.resolveAccountMessages,
+		},
+		"transactions": &graphql.Field{
+			Type: builder.accountTransactionListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"order": &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: builder.resolveAccountTransactions,
+		},
+		"validator": &graphql.Field{
+			Type: builder.validatorType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				account := p.Source.(*Account)
+				operatorAddress, err := tmcosmosutils.ValidatorAddressFromAccountAddress(
+					builder.validatorAddressPrefix, account.Address,
+				)
+				if err != nil {
+					return nil, fmt.Errorf("invalid account address: %v", err)
+				}
+				return builder.findValidator(validator_view.ValidatorIdentity{
+					MaybeOperatorAddress: &operatorAddress,
+				})
+			},
+		},
+		"proposals": &graphql.Field{
+			Type: builder.proposalListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"status": &graphql.ArgumentConfig{Type: graphql.String},
+				"order":  &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				account := p.Source.(*Account)
+				return builder.listProposals(proposal_view.ProposalListFilter{
+					MaybeStatus:          optStringArg(p.Args, "status"),
+					MaybeProposerAddress: primptr.String(account.Address),
+				}, p.Args)
+			},
+		},
+		"nftTokens": &graphql.Field{
+			Type: builder.nftTokenListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"order": &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				account := p.Source.(*Account)
+				return builder.listTokens(nft_view.TokenListFilter{
+					MaybeOwner: primptr.String(account.Address),
+				}, p.Args)
+			},
+		},
+	}))
+}
+
+func (builder *schemaBuilder) accountMessageFields() graphql.Fields {
+	return builder.servedFields("AccountMessage", jsonFields(graphql.Fields{
+		"account":         &graphql.Field{Type: graphql.String},
+		"blockHeight":     &graphql.Field{Type: graphql.Int},
+		"blockHash":       &graphql.Field{Type: graphql.String},
+		"blockTime":       &graphql.Field{Type: JSON},
+		"transactionHash": &graphql.Field{Type: graphql.String},
+		"success":         &graphql.Field{Type: graphql.Boolean},
+		"messageIndex":    &graphql.Field{Type: graphql.Int},
+		"messageType":     &graphql.Field{Type: graphql.String},
+		"data":            &graphql.Field{Type: JSON},
+		"transaction": &graphql.Field{
+			Type: builder.transactionType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				message := p.Source.(account_message_view.AccountMessageRow)
+				return builder.findTransaction(message.TransactionHash)
+			},
+		},
+	}))
+}
+
+func (builder *schemaBuilder) accountTransactionFields() graphql.Fields {
+	return builder.servedFields("AccountTransaction", jsonFields(graphql.Fields{
+		"account":       &graphql.Field{Type: graphql.String},
+		"blockHeight":   &graphql.Field{Type: graphql.Int},
+		"blockHash":     &graphql.Field{Type: graphql.String},
+		"blockTime":     &graphql.Field{Type: JSON},
+		"hash":          &graphql.Field{Type: graphql.String},
+		"messageTypes":  &graphql.Field{Type: graphql.NewList(graphql.String)},
+		"success":       &graphql.Field{Type: graphql.Boolean},
+		"code":          &graphql.Field{Type: graphql.Int},
+		"log":           &graphql.Field{Type: graphql.String},
+		"fee":           &graphql.Field{Type: JSON},
+		"feePayer":      &graphql.Field{Type: graphql.String},
+		"feeGranter":    &graphql.Field{Type: graphql.String},
+		"gasWanted":     &graphql.Field{Type: graphql.Int},
+		"gasUsed":       &graphql.Field{Type: graphql.Int},
+		"memo":          &graphql.Field{Type: graphql.String},
+		"timeoutHeight": &graphql.Field{Type: graphql.Int},
+		"messages":      &graphql.Field{Type: graphql.NewList(builder.messageType)},
+		"block": &graphql.Field{
+			Type: builder.blockType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				transaction := p.Source.(account_transaction_view.AccountTransactionReadRow)
+				return builder.findBlock(block_view.BlockIdentity{
+					MaybeHeight: primptr.Int64(transaction.BlockHeight),
+				})
+			},
+		},
+	}))
+}
+
+func (builder *schemaBuilder) validatorFields() graphql.Fields {
+	return jsonFields(graphql.Fields{
+		"operatorAddress":         &graphql.Field{Type: graphql.String},
+		"consensusNodeAddress":    &graphql.Field{Type: graphql.String},
+		"initialDelegatorAddress": &graphql.Field{Type: graphql.String},
+		"tendermintPubkey":        &graphql.Field{Type: graphql.String},
+		"tendermintAddress":       &graphql.Field{Type: graphql.String},
+		"status":                  &graphql.Field{Type: graphql.String},
+		"jailed":                  &graphql.Field{Type: graphql.Boolean},
+		"joinedAtBlockHeight":     &graphql.Field{Type: graphql.Int},
+		"power":                   &graphql.Field{Type: graphql.String},
+		"moniker":                 &graphql.Field{Type: graphql.String},
+		"identity":                &graphql.Field{Type: graphql.String},
+		"website":                 &graphql.Field{Type: graphql.String},
+		"securityContact":         &graphql.Field{Type: graphql.String},
+		"details":                 &graphql.Field{Type: graphql.String},
+		"commissionRate":          &graphql.Field{Type: graphql.String},
+		"commissionMaxRate":       &graphql.Field{Type: graphql.String},
+		"commissionMaxChangeRate": &graphql.Field{Type: graphql.String},
+		"minSelfDelegation":       &graphql.Field{Type: graphql.String},
+		"totalSignedBlock":        &graphql.Field{Type: graphql.Int},
+		"totalActiveBlock":        &graphql.Field{Type: graphql.Int},
+		"impreciseUpTime":         &graphql.Field{Type: JSON},
+		"votedGovProposal":        &graphql.Field{Type: JSON},
+		"powerPercentage":         &graphql.Field{Type: graphql.String},
+		"cumulativePowerPercentage": &graphql.Field{
+			Type: graphql.String,
+		},
+		"account": &graphql.Field{
+			Type: builder.accountType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				initialDelegatorAddress, _ := lookupJSONField(reflect.ValueOf(p.Source), "initialDelegatorAddress")
+				return &Account{
+					Address: initialDelegatorAddress.(string),
+				}, nil
+			},
+		},
+	})
+}
+
+func (builder *schemaBuilder) proposalFields() graphql.Fields {
+	return jsonFields(graphql.Fields{
+		"id":                           &graphql.Field{Type: graphql.String},
+		"title":                        &graphql.Field{Type: graphql.String},
+		"description":                  &graphql.Field{Type: graphql.String},
+		"type":                         &graphql.Field{Type: graphql.String},
+		"status":                       &graphql.Field{Type: graphql.String},
+		"proposerAddress":              &graphql.Field{Type: graphql.String},
+		"maybeProposerOperatorAddress": &graphql.Field{Type: graphql.String},
+		"maybeProposerMoniker":         &graphql.Field{Type: graphql.String},
+		"data":                         &graphql.Field{Type: JSON},
+		"initialDeposit":               &graphql.Field{Type: JSON},
+		"totalDeposit":                 &graphql.Field{Type: JSON},
+		"totalVote":                    &graphql.Field{Type: graphql.String},
+		"transactionHash":              &graphql.Field{Type: graphql.String},
+		"submitBlockHeight":            &graphql.Field{Type: graphql.Int},
+		"submitTime":                   &graphql.Field{Type: JSON},
+		"depositEndTime":               &graphql.Field{Type: JSON},
+		"maybeVotingStartTime":         &graphql.Field{Type: JSON},
+		"maybeVotingEndBlockHeight":    &graphql.Field{Type: graphql.Int},
+		"maybeVotingEndTime":           &graphql.Field{Type: JSON},
+		"proposer": &graphql.Field{
+			Type: builder.accountType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				proposerAddress, _ := lookupJSONField(reflect.ValueOf(p.Source), "proposerAddress")
+				return &Account{
+					Address: proposerAddress.(string),
+				}, nil
+			},
+		},
+	})
+}
+
+func (builder *schemaBuilder) nftDenomFields() graphql.Fields {
+	return jsonFields(graphql.Fields{
+		"denomId":                   &graphql.Field{Type: graphql.String},
+		"denomName":                 &graphql.Field{Type: graphql.String},
+		"denomSchema":               &graphql.Field{Type: graphql.String},
+		"denomCreator":              &graphql.Field{Type: graphql.String},
+		"denomCreatedAt":            &graphql.Field{Type: JSON},
+		"denomCreatedAtBlockHeight": &graphql.Field{Type: graphql.Int},
+		"tokens": &graphql.Field{
+			Type: builder.nftTokenListType,
+			Args: paginationArgs(graphql.FieldConfigArgument{
+				"drop":   &graphql.ArgumentConfig{Type: graphql.String},
+				"minter": &graphql.ArgumentConfig{Type: graphql.String},
+				"owner":  &graphql.ArgumentConfig{Type: graphql.String},
+				"order":  &graphql.ArgumentConfig{Type: Order},
+			}),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				denomId, _ := lookupJSONField(reflect.ValueOf(p.Source), "denomId")
+				return builder.listTokens(nft_view.TokenListFilter{
+					MaybeDenomId: primptr.String(denomId.(string)),
+					MaybeDrop:    optStringArg(p.Args, "drop"),
+					MaybeMinter:  optStringArg(p.Args, "minter"),
+					MaybeOwner:   optStringArg(p.Args, "owner"),
+				}, p.Args)
+			},
+		},
+	})
+}
+
+func (builder *schemaBuilder) nftTokenFields() graphql.Fields {
+	return jsonFields(graphql.Fields{
+		"denomId":                      &graphql.Field{Type: graphql.String},
+		"denomName":                    &graphql.Field{Type: graphql.String},
+		"denomSchema":                  &graphql.Field{Type: graphql.String},
+		"tokenId":                      &graphql.Field{Type: graphql.String},
+		"drop":                         &graphql.Field{Type: graphql.String},
+		"tokenName":                    &graphql.Field{Type: graphql.String},
+		"tokenURI":                     &graphql.Field{Type: graphql.String},
+		"tokenData":                    &graphql.Field{Type: graphql.String},
+		"tokenMinter":                  &graphql.Field{Type: graphql.String},
+		"tokenOwner":                   &graphql.Field{Type: graphql.String},
+		"tokenMintedAt":                &graphql.Field{Type: JSON},
+		"tokenMintedAtBlockHeight":     &graphql.Field{Type: graphql.Int},
+		"tokenLastEditedAt":            &graphql.Field{Type: JSON},
+		"tokenLastEditedAtBlockHeight": &graphql.Field{Type: graphql.Int},
+		"denom": &graphql.Field{
+			Type: builder.nftDenomType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				denomId, _ := lookupJSONField(reflect.ValueOf(p.Source), "denomId")
+				return builder.findDenom(denomId.(string))
+			},
+		},
+		"owner": &graphql.Field{
+			Type: builder.accountType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				owner, _ := lookupJSONField(reflect.ValueOf(p.Source), "tokenOwner")
+				return &Account{
+					Address: owner.(string),
+				}, nil
+			},
+		},
+	})
+}
+
+func (builder *schemaBuilder) resolveBlock(p graphql.ResolveParams) (interface{}, error) {
+	identity := block_view.BlockIdentity{
+		MaybeHash: optStringArg(p.Args, "hash"),
+	}
+	if height, ok := p.Args["height"].(int); ok {
+		identity.MaybeHeight = primptr.Int64(int64(height))
+	}
+	if identity.MaybeHeight == nil && identity.MaybeHash == nil {
+		return nil, errors.New("either height or hash is required")
+	}
+
+	return builder.findBlock(identity)
+}
+
+func (builder *schemaBuilder) findBlock(identity block_view.BlockIdentity) (interface{}, error) {
+	block, err := builder.blocksView.FindBy(&identity)
+	return optRow(block, err, "block")
+}
+
+func (builder *schemaBuilder) resolveBlocks(p graphql.ResolveParams) (interface{}, error) {
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, paginationResult, err := builder.blocksView.List(block_view.BlocksListOrder{
+		Height: parseOrder(p.Args),
+	}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error listing blocks: %v", err)
+	}
+
+	items := make([]*block_view.Block, 0, len(blocks))
+	for i := range blocks {
+		items = append(items, &blocks[i])
+	}
+	return newListResult(items, paginationResult), nil
+}
+
+func (builder *schemaBuilder) findTransaction(hash string) (interface{}, error) {
+	transaction, err := builder.transactionsView.FindByHash(hash)
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding transaction: %v", err)
+	}
+	return *transaction, nil
+}
+
+func (builder *schemaBuilder) listTransactions(
+	maybeBlockHeight *int64,
+	args map[string]interface{},
+) (interface{}, error) {
+	pagination, err := parsePagination(args)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, paginationResult, err := builder.transactionsView.List(transaction_view.TransactionsListFilter{
+		MaybeBlockHeight: maybeBlockHeight,
+	}, transaction_view.TransactionsListOrder{
+		Height: parseOrder(args),
+	}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error listing transactions: %v", err)
+	}
+
+	return newListResult(transactions, paginationResult), nil
+}
+
+func (builder *schemaBuilder) resolveAccountMessages(p graphql.ResolveParams) (interface{}, error) {
+	account := p.Source.(*Account)
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := account_message_view.AccountMessagesListFilter{
+		Account:       account.Address,
+		MaybeMsgTypes: nil,
+	}
+	if msgTypes, ok := p.Args["msgTypes"].([]interface{}); ok {
+		filter.MaybeMsgTypes = make([]string, 0, len(msgTypes))
+		for _, msgType := range msgTypes {
+			if msgTypeStr, isString := msgType.(string); isString {
+				filter.MaybeMsgTypes = append(filter.MaybeMsgTypes, msgTypeStr)
+			}
+		}
+	}
+
+	messages, paginationResult, err := builder.accountMessagesView.List(
+		filter,
+		account_message_view.AccountMessagesListOrder{
+			Id: parseOrder(p.Args),
+		},
+		pagination,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing account messages: %v", err)
+	}
+
+	return newListResult(messages, paginationResult), nil
+}
+
+func (builder *schemaBuilder) resolveAccountTransactions(p graphql.ResolveParams) (interface{}, error) {
+	account := p.Source.(*Account)
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, paginationResult, err := builder.accountTransactionsView.List(
+		account_transaction_view.AccountTransactionsListFilter{
+			Account: account.Address,
+		},
+		account_transaction_view.AccountTransactionsListOrder{
+			Id: parseOrder(p.Args),
+		},
+		pagination,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing account transactions: %v", err)
+	}
+
+	return newListResult(transactions, paginationResult), nil
+}
+
+func (builder *schemaBuilder) findValidator(identity validator_view.ValidatorIdentity) (interface{}, error) {
+	validator, err := builder.validatorsView.FindBy(identity)
+	return optRow(validator, err, "validator")
+}
+
+func (builder *schemaBuilder) resolveValidators(p graphql.ResolveParams) (interface{}, error) {
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := validator_view.ValidatorsListFilter{
+		MaybeStatuses: nil,
+	}
+	if statuses, ok := p.Args["statuses"].([]interface{}); ok {
+		filter.MaybeStatuses = make([]constants.Status, 0, len(statuses))
+		for _, status := range statuses {
+			if statusStr, isString := status.(string); isString {
+				filter.MaybeStatuses = append(filter.MaybeStatuses, statusStr)
+			}
+		}
+	}
+
+	validators, paginationResult, err := builder.validatorsView.List(filter, validator_view.ValidatorsListOrder{
+		MaybeStatus:              primptr.String(view.ORDER_ASC),
+		MaybeJoinedAtBlockHeight: primptr.String(view.ORDER_ASC),
+	}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error listing validators: %v", err)
+	}
+
+	return newListResult(validators, paginationResult), nil
+}
+
+func (builder *schemaBuilder) listProposals(
+	filter proposal_view.ProposalListFilter,
+	args map[string]interface{},
+) (interface{}, error) {
+	pagination, err := parsePagination(args)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, paginationResult, err := builder.proposalsView.List(filter, proposal_view.ProposalListOrder{
+		Id: parseOrder(args),
+	}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error listing proposals: %v", err)
+	}
+
+	return newListResult(proposals, paginationResult), nil
+}
+
+func (builder *schemaBuilder) findDenom(denomId string) (interface{}, error) {
+	denom, err := builder.denomsView.FindById(denomId)
+	return optRow(denom, err, "NFT denom")
+}
+
+func (builder *schemaBuilder) resolveDenoms(p graphql.ResolveParams) (interface{}, error) {
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms, paginationResult, err := builder.denomsView.List(nft_view.DenomListFilter{
+		MaybeCreator: optStringArg(p.Args, "creator"),
+	}, nft_view.DenomListOrder{
+		CreatedAt: parseOrder(p.Args),
+	}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error listing NFT denoms: %v", err)
+	}
+
+	return newListResult(denoms, paginationResult), nil
+}
+
+func (builder *schemaBuilder) listTokens(
+	filter nft_view.TokenListFilter,
+	args map[string]interface{},
+) (interface{}, error) {
+	pagination, err := parsePagination(args)
+	if err != nil {
+		return nil, err
+	}
+
+	order := parseOrder(args)
+	tokens, paginationResult, err := builder.tokensView.List(filter, nft_view.TokenListOrder{
+		MintedAt:     order,
+		LastEditedAt: order,
+	}, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("error listing NFT tokens: %v", err)
+	}
+
+	return newListResult(tokens, paginationResult), nil
+}
+
+// optRow returns nil instead of an error when the record is not found, so that a missing record
+// resolves to null
+func optRow(row interface{}, err error, name string) (interface{}, error) {
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding %s: %v", name, err)
+	}
+	return row, nil
+}
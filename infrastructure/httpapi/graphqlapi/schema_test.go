@@ -0,0 +1,64 @@
+package graphqlapi_test
+
+import (
+	"github.com/graphql-go/graphql"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/graphqlapi"
+)
+
+var _ = Describe("Schema", func() {
+	It("should resolve fields without querying the views when the source provides them", func() {
+		schema, err := graphqlapi.NewSchema(&rdb.Handle{}, "tcrocncl", nil)
+		Expect(err).To(BeNil())
+
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `{ account(address: "tcro15grftg88l0gdnvp5lgq8qdp2la4cmqwxezcduf") { address } }`,
+		})
+		Expect(result.Errors).To(BeEmpty())
+		Expect(result.Data).To(Equal(map[string]interface{}{
+			"account": map[string]interface{}{
+				"address": "tcro15grftg88l0gdnvp5lgq8qdp2la4cmqwxezcduf",
+			},
+		}))
+	})
+
+	It("should reject the block query without identity", func() {
+		schema, err := graphqlapi.NewSchema(&rdb.Handle{}, "tcrocncl", nil)
+		Expect(err).To(BeNil())
+
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `{ block { blockHeight } }`,
+		})
+		Expect(result.Errors).To(HaveLen(1))
+		Expect(result.Errors[0].Message).To(Equal("either height or hash is required"))
+	})
+
+	It("should leave out the fields reading the views of the projections not served", func() {
+		schema, err := graphqlapi.NewSchema(&rdb.Handle{}, "tcrocncl", view.Projections{"Block", "Validator"})
+		Expect(err).To(BeNil())
+
+		queryFields := schema.QueryType().Fields()
+		Expect(queryFields).To(HaveKey("blocks"))
+		Expect(queryFields).To(HaveKey("validators"))
+		Expect(queryFields).To(HaveKey("account"))
+		Expect(queryFields).NotTo(HaveKey("transactions"))
+		Expect(queryFields).NotTo(HaveKey("nftTokens"))
+		accountFields := schema.Type("Account").(*graphql.Object).Fields()
+		Expect(accountFields).To(HaveKey("validator"))
+		Expect(accountFields).NotTo(HaveKey("proposals"))
+		Expect(schema.Type("Block").(*graphql.Object).Fields()).NotTo(HaveKey("transactions"))
+
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `{ proposal(id: "1") { id } }`,
+		})
+		Expect(result.Errors).To(HaveLen(1))
+		Expect(result.Errors[0].Message).To(ContainSubstring(`Cannot query field "proposal"`))
+	})
+})
@@ -0,0 +1,201 @@
+package graphqlapi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	jsoniter "github.com/json-iterator/go"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+)
+
+const DEFAULT_PAGINATION_LIMIT = 20
+const MAX_PAGINATION_LIMIT = 100
+
+// JSON is a scalar for values without a dedicated GraphQL type, such as coins, timestamps and
+// message contents. It is serialized the same way as the REST API.
+var JSON = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "The `JSON` scalar type represents a value serialized the same way as the REST API.",
+	Serialize: func(value interface{}) interface{} {
+		encoded, err := jsoniter.Marshal(value)
+		if err != nil {
+			return nil
+		}
+		var decoded interface{}
+		if err := jsoniter.Unmarshal(encoded, &decoded); err != nil {
+			return nil
+		}
+		return decoded
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		return nil
+	},
+})
+
+var Order = graphql.NewEnum(graphql.EnumConfig{
+	Name: "Order",
+	Values: graphql.EnumValueConfigMap{
+		"ASC": &graphql.EnumValueConfig{
+			Value: view.ORDER_ASC,
+		},
+		"DESC": &graphql.EnumValueConfig{
+			Value: view.ORDER_DESC,
+		},
+	},
+})
+
+var Pagination = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Pagination",
+	Fields: jsonFields(graphql.Fields{
+		"totalRecord": &graphql.Field{Type: graphql.Int},
+		"totalPage":   &graphql.Field{Type: graphql.Int},
+		"currentPage": &graphql.Field{Type: graphql.Int},
+		"limit":       &graphql.Field{Type: graphql.Int},
+	}),
+})
+
+// newListType creates a paginated list type of the item type
+func newListType(name string, itemType graphql.Output) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: jsonFields(graphql.Fields{
+			"items":      &graphql.Field{Type: graphql.NewList(itemType)},
+			"pagination": &graphql.Field{Type: Pagination},
+		}),
+	})
+}
+
+// paginationArgs returns the page and limit arguments merged with the extra arguments of a
+// paginated field
+func paginationArgs(extraArgs graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{
+		"page": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: 1,
+		},
+		"limit": &graphql.ArgumentConfig{
+			Type:         graphql.Int,
+			DefaultValue: DEFAULT_PAGINATION_LIMIT,
+		},
+	}
+	for name, arg := range extraArgs {
+		args[name] = arg
+	}
+	return args
+}
+
+func parsePagination(args map[string]interface{}) (*pagination_interface.Pagination, error) {
+	page, _ := args["page"].(int)
+	if page <= 0 {
+		return nil, errors.New("invalid page")
+	}
+	limit, _ := args["limit"].(int)
+	if limit <= 0 {
+		limit = DEFAULT_PAGINATION_LIMIT
+	}
+	if limit > MAX_PAGINATION_LIMIT {
+		return nil, fmt.Errorf("limit cannot exceed %d", MAX_PAGINATION_LIMIT)
+	}
+
+	return pagination_interface.NewOffsetPagination(int64(page), int64(limit)), nil
+}
+
+func parseOrder(args map[string]interface{}) view.ORDER {
+	if order, ok := args["order"].(string); ok && order == view.ORDER_DESC {
+		return view.ORDER_DESC
+	}
+	return view.ORDER_ASC
+}
+
+func optStringArg(args map[string]interface{}, name string) *string {
+	if value, ok := args[name].(string); ok {
+		return &value
+	}
+	return nil
+}
+
+type listResult struct {
+	Items      interface{}       `json:"items"`
+	Pagination *paginationResult `json:"pagination"`
+}
+
+type paginationResult struct {
+	TotalRecord int64 `json:"totalRecord"`
+	TotalPage   int64 `json:"totalPage"`
+	CurrentPage int64 `json:"currentPage"`
+	Limit       int64 `json:"limit"`
+}
+
+func newListResult(items interface{}, result *pagination_interface.PaginationResult) *listResult {
+	var maybePagination *paginationResult
+	if offsetResult := result.OffsetResult(); offsetResult != nil {
+		maybePagination = &paginationResult{
+			TotalRecord: offsetResult.TotalRecord,
+			TotalPage:   offsetResult.TotalPage(),
+			CurrentPage: offsetResult.CurrentPage,
+			Limit:       offsetResult.Limit,
+		}
+	}
+
+	return &listResult{
+		Items:      items,
+		Pagination: maybePagination,
+	}
+}
+
+// jsonFields sets the resolver of the fields without one to resolve by the JSON tag of the source
+// struct, so that the GraphQL field names follow the REST API responses
+func jsonFields(fields graphql.Fields) graphql.Fields {
+	for _, field := range fields {
+		if field.Resolve == nil {
+			field.Resolve = resolveJSONField
+		}
+	}
+	return fields
+}
+
+func resolveJSONField(p graphql.ResolveParams) (interface{}, error) {
+	value, _ := lookupJSONField(reflect.ValueOf(p.Source), p.Info.FieldName)
+	return value, nil
+}
+
+func lookupJSONField(sourceVal reflect.Value, name string) (interface{}, bool) {
+	for sourceVal.Kind() == reflect.Ptr || sourceVal.Kind() == reflect.Interface {
+		if sourceVal.IsNil() {
+			return nil, false
+		}
+		sourceVal = sourceVal.Elem()
+	}
+	if sourceVal.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	sourceType := sourceVal.Type()
+	for i := 0; i < sourceVal.NumField(); i++ {
+		typeField := sourceType.Field(i)
+		if typeField.PkgPath != "" && !typeField.Anonymous {
+			// unexported field
+			continue
+		}
+		if typeField.Anonymous {
+			if value, ok := lookupJSONField(sourceVal.Field(i), name); ok {
+				return value, true
+			}
+			continue
+		}
+		if strings.Split(typeField.Tag.Get("json"), ",")[0] == name {
+			return sourceVal.Field(i).Interface(), true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/accountexport"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const EXPORT_DATE_LAYOUT = "2006-01-02"
+
+type AccountExport struct {
+	logger applogger.Logger
+
+	accountExport *accountexport.AccountExport
+}
+
+func NewAccountExport(logger applogger.Logger, rdbHandle *rdb.Handle, accountAddressPrefix string) *AccountExport {
+	return &AccountExport{
+		logger.WithFields(applogger.LogFields{
+			"module": "AccountExportHandler",
+		}),
+
+		accountexport.NewAccountExport(rdbHandle, accountAddressPrefix),
+	}
+}
+
+// Export streams the ledger of the account as a file in the `format` query argument, which is
+// either `csv` (default) or `xlsx`. The `from` and `to` query arguments bound the block time and
+// accept either RFC3339 time or date. A `to` date includes the whole day.
+func (handler *AccountExport) Export(ctx *fasthttp.RequestCtx) {
+	accountParam, _ := ctx.UserValue("account").(string)
+
+	queryArgs := ctx.QueryArgs()
+	format := accountexport.FORMAT_CSV
+	if queryArgs.Has("format") {
+		format = string(queryArgs.Peek("format"))
+	}
+
+	filter := accountexport.Filter{
+		Account:       accountParam,
+		MaybeFromTime: nil,
+		MaybeToTime:   nil,
+	}
+	if queryArgs.Has("from") {
+		fromTime, err := parseExportTime(string(queryArgs.Peek("from")), false)
+		if err != nil {
+			httpapi.BadRequest(ctx, errors.New("invalid from time"))
+			return
+		}
+		filter.MaybeFromTime = &fromTime
+	}
+	if queryArgs.Has("to") {
+		toTime, err := parseExportTime(string(queryArgs.Peek("to")), true)
+		if err != nil {
+			httpapi.BadRequest(ctx, errors.New("invalid to time"))
+			return
+		}
+		filter.MaybeToTime = &toTime
+	}
+
+	contentType, err := accountexport.ContentType(format)
+	if err != nil {
+		httpapi.BadRequest(ctx, err)
+		return
+	}
+
+	ctx.Response.Header.Set("Content-Type", contentType)
+	ctx.Response.Header.Set(
+		"Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.%s\"", accountParam, format),
+	)
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		recordWriter, writerErr := accountexport.NewRecordWriter(format, w)
+		if writerErr != nil {
+			handler.logger.Errorf("error creating account %s export writer: %v", accountParam, writerErr)
+			return
+		}
+		// The status has been sent, errors can only be logged and truncate the file
+		if err := handler.accountExport.Export(filter, recordWriter); err != nil {
+			handler.logger.Errorf("error exporting account %s: %v", accountParam, err)
+			return
+		}
+		if err := recordWriter.Close(); err != nil {
+			handler.logger.Errorf("error completing account %s export: %v", accountParam, err)
+		}
+	})
+}
+
+// parseExportTime parses an RFC3339 time or a date. When isEnd is true, the exclusive end time
+// covering the provided time or the whole date is returned.
+func parseExportTime(value string, isEnd bool) (utctime.UTCTime, error) {
+	if parsedTime, err := time.Parse(time.RFC3339, value); err == nil {
+		if isEnd {
+			parsedTime = parsedTime.Add(time.Nanosecond)
+		}
+		return utctime.FromTime(parsedTime), nil
+	}
+
+	parsedDate, err := time.Parse(EXPORT_DATE_LAYOUT, value)
+	if err != nil {
+		return utctime.UTCTime{}, err
+	}
+	if isEnd {
+		parsedDate = parsedDate.AddDate(0, 0, 1)
+	}
+	return utctime.FromTime(parsedDate), nil
+}
@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"errors"
+	"time"
 
 	"github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
 	"github.com/crypto-com/chain-indexing/internal/primptr"
 	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
 	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
 
 	"github.com/crypto-com/chain-indexing/usecase/coin"
@@ -17,14 +19,20 @@ import (
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 	account_view "github.com/crypto-com/chain-indexing/projection/account/view"
+	account_pubkey_view "github.com/crypto-com/chain-indexing/projection/account_pubkey/view"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	vesting_account_view "github.com/crypto-com/chain-indexing/projection/vesting_account/view"
 )
 
 type Accounts struct {
 	logger applogger.Logger
 
-	accountsView   *account_view.Accounts
-	validatorsView *validator_view.Validators
-	cosmosClient   cosmosapp.Client
+	accountsView        *account_view.Accounts
+	accountPubKeysView  *account_pubkey_view.AccountPubKeys
+	validatorsView      *validator_view.Validators
+	vestingAccountsView *vesting_account_view.VestingAccounts
+	blocksView          *block_view.Blocks
+	cosmosClient        cosmosapp.Client
 
 	validatorAddressPrefix string
 }
@@ -41,7 +49,10 @@ func NewAccounts(
 		}),
 
 		account_view.NewAccounts(rdbHandle),
+		account_pubkey_view.NewAccountPubKeys(rdbHandle),
 		validator_view.NewValidators(rdbHandle),
+		vesting_account_view.NewVestingAccounts(rdbHandle),
+		block_view.NewBlocks(rdbHandle),
 		cosmosClient,
 
 		validatorAddressPrefix,
@@ -147,6 +158,43 @@ func (handler *Accounts) FindBy(ctx *fasthttp.RequestCtx) {
 	totalBalance = totalBalance.Add(info.Commissions...)
 	info.TotalBalance = totalBalance
 
+	accountPubKey, err := handler.accountPubKeysView.FindByAddress(accountParam)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			handler.logger.Errorf("error fetching account public key: %v", err)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+	} else {
+		info.MaybePubKey = accountPubKey
+	}
+
+	vestingAccount, err := handler.vestingAccountsView.FindBy(accountParam)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			handler.logger.Errorf("error fetching account vesting schedule: %v", err)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+	} else {
+		vestingTime, parseErr := parseVestingTime(ctx, handler.blocksView)
+		if parseErr != nil {
+			if errors.Is(parseErr, rdb.ErrNoRows) {
+				httpapi.NotFound(ctx)
+				return
+			}
+			httpapi.BadRequest(ctx, parseErr)
+			return
+		}
+		info.MaybeVesting = &AccountVestingInfo{
+			VestingAccountRow: vestingAccount,
+
+			Time:     utctime.FromUnixNano(vestingTime * int64(time.Second)),
+			Vested:   vestingAccount.Schedule.VestedAt(vestingTime),
+			Unvested: vestingAccount.Schedule.UnvestedAt(vestingTime),
+		}
+	}
+
 	httpapi.Success(ctx, info)
 }
 
@@ -190,4 +238,7 @@ type AccountInfo struct {
 	TotalRewards        coin.DecCoins `json:"totalRewards"`
 	Commissions         coin.DecCoins `json:"commissions"`
 	TotalBalance        coin.DecCoins `json:"totalBalance"`
+
+	MaybePubKey  *account_pubkey_view.AccountPubKeyRow `json:"pubKey"`
+	MaybeVesting *AccountVestingInfo                   `json:"vesting"`
 }
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	community_pool_view "github.com/crypto-com/chain-indexing/projection/community_pool/view"
+)
+
+type CommunityPool struct {
+	logger applogger.Logger
+
+	historyView *community_pool_view.History
+	flowsView   *community_pool_view.Flows
+}
+
+func NewCommunityPool(logger applogger.Logger, rdbHandle *rdb.Handle) *CommunityPool {
+	return &CommunityPool{
+		logger.WithFields(applogger.LogFields{
+			"module": "CommunityPoolHandler",
+		}),
+
+		community_pool_view.NewHistory(rdbHandle),
+		community_pool_view.NewFlows(rdbHandle),
+	}
+}
+
+func (handler *CommunityPool) FindLatest(ctx *fasthttp.RequestCtx) {
+	latest, err := handler.historyView.FindLatest()
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			httpapi.NotFound(ctx)
+			return
+		}
+		handler.logger.Errorf("error finding latest community pool balance: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.Success(ctx, latest)
+}
+
+func (handler *CommunityPool) ListHistory(ctx *fasthttp.RequestCtx) {
+	pagination, paginationError := httpapi.ParsePagination(ctx)
+	if paginationError != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	heightOrder := view.ORDER_ASC
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("order") {
+		if string(queryArgs.Peek("order")) == "height.desc" {
+			heightOrder = view.ORDER_DESC
+		}
+	}
+
+	history, paginationResult, err := handler.historyView.List(community_pool_view.HistoryListOrder{
+		Height: heightOrder,
+	}, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing community pool history: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, history, paginationResult)
+}
+
+func (handler *CommunityPool) ListFlows(ctx *fasthttp.RequestCtx) {
+	pagination, paginationError := httpapi.ParsePagination(ctx)
+	if paginationError != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	idOrder := view.ORDER_ASC
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("order") {
+		if string(queryArgs.Peek("order")) == "id.desc" {
+			idOrder = view.ORDER_DESC
+		}
+	}
+
+	filter := community_pool_view.FlowsListFilter{
+		MaybeDirection: nil,
+	}
+	if queryArgs.Has("filter.direction") {
+		direction := string(queryArgs.Peek("filter.direction"))
+		if direction != community_pool_view.FLOW_DIRECTION_INFLOW &&
+			direction != community_pool_view.FLOW_DIRECTION_OUTFLOW {
+			httpapi.BadRequest(ctx, errors.New("invalid direction filter"))
+			return
+		}
+		filter.MaybeDirection = &direction
+	}
+
+	flows, paginationResult, err := handler.flowsView.List(filter, community_pool_view.FlowsListOrder{
+		Id: idOrder,
+	}, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing community pool flows: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, flows, paginationResult)
+}
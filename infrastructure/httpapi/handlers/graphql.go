@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/graphqlapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+type GraphQL struct {
+	logger applogger.Logger
+
+	schema graphql.Schema
+	limits graphqlapi.Limits
+}
+
+func NewGraphQL(
+	logger applogger.Logger,
+	rdbHandle *rdb.Handle,
+	validatorAddressPrefix string,
+	projections view.Projections,
+	limits graphqlapi.Limits,
+) (*GraphQL, error) {
+	schema, err := graphqlapi.NewSchema(rdbHandle, validatorAddressPrefix, projections)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GraphQL schema: %v", err)
+	}
+
+	return &GraphQL{
+		logger.WithFields(applogger.LogFields{
+			"module": "GraphQLHandler",
+		}),
+
+		schema,
+		limits,
+	}, nil
+}
+
+// Query executes a GraphQL query. The query is accepted from the `query`, `variables` and
+// `operationName` query parameters of a GET request, or from the JSON body of a POST request.
+func (handler *GraphQL) Query(ctx *fasthttp.RequestCtx) {
+	var request GraphQLRequest
+	if ctx.IsPost() {
+		if err := jsoniter.Unmarshal(ctx.PostBody(), &request); err != nil {
+			handler.writeErrors(ctx, fasthttp.StatusBadRequest, errors.New("invalid request body"))
+			return
+		}
+	} else {
+		queryArgs := ctx.QueryArgs()
+		request.Query = string(queryArgs.Peek("query"))
+		request.OperationName = string(queryArgs.Peek("operationName"))
+		if queryArgs.Has("variables") {
+			if err := jsoniter.Unmarshal(queryArgs.Peek("variables"), &request.Variables); err != nil {
+				handler.writeErrors(ctx, fasthttp.StatusBadRequest, errors.New("invalid variables"))
+				return
+			}
+		}
+	}
+	if request.Query == "" {
+		handler.writeErrors(ctx, fasthttp.StatusBadRequest, errors.New("missing query"))
+		return
+	}
+
+	if _, err := handler.limits.Check(
+		handler.schema, request.Query, request.OperationName, request.Variables,
+	); err != nil {
+		handler.writeErrors(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         handler.schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        ctx,
+	})
+	for _, resultErr := range result.Errors {
+		handler.logger.Debugf("error executing GraphQL query: %v", resultErr)
+	}
+
+	handler.write(ctx, fasthttp.StatusOK, result)
+}
+
+func (handler *GraphQL) writeErrors(ctx *fasthttp.RequestCtx, statusCode int, err error) {
+	handler.write(ctx, statusCode, &graphql.Result{
+		Errors: []gqlerrors.FormattedError{
+			gqlerrors.NewFormattedError(err.Error()),
+		},
+	})
+}
+
+func (handler *GraphQL) write(ctx *fasthttp.RequestCtx, statusCode int, result *graphql.Result) {
+	body, err := jsoniter.Marshal(result)
+	if err != nil {
+		handler.logger.Errorf("error encoding GraphQL result: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	ctx.SetStatusCode(statusCode)
+	ctx.SetBody(body)
+}
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
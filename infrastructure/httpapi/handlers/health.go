@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+type Health struct {
+	logger applogger.Logger
+
+	rdbHandle *rdb.Handle
+}
+
+func NewHealth(logger applogger.Logger, rdbHandle *rdb.Handle) *Health {
+	return &Health{
+		logger.WithFields(applogger.LogFields{
+			"module": "HealthHandler",
+		}),
+
+		rdbHandle,
+	}
+}
+
+// Check responds the leases of the sync and the projections, i.e. which process is running each of
+// them. The leases are read through the API connection, so they may lag behind on a read replica.
+func (handler *Health) Check(ctx *fasthttp.RequestCtx) {
+	leases, err := rdb.ListLeaseStates(handler.rdbHandle, rdb.DEFAULT_LEASE_TABLE)
+	if err != nil {
+		handler.logger.Errorf("error listing lease states: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.Success(ctx, HealthStatus{
+		Status: "Ok",
+		Leases: leases,
+	})
+}
+
+type HealthStatus struct {
+	Status string           `json:"status"`
+	Leases []rdb.LeaseState `json:"leases"`
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	multisig_account_view "github.com/crypto-com/chain-indexing/projection/multisig_account/view"
+)
+
+type MultisigAccounts struct {
+	logger applogger.Logger
+
+	multisigAccountsView            *multisig_account_view.MultisigAccounts
+	multisigAccountTransactionsView *multisig_account_view.MultisigAccountTransactions
+}
+
+func NewMultisigAccounts(logger applogger.Logger, rdbHandle *rdb.Handle) *MultisigAccounts {
+	return &MultisigAccounts{
+		logger.WithFields(applogger.LogFields{
+			"module": "MultisigAccountsHandler",
+		}),
+
+		multisig_account_view.NewMultisigAccounts(rdbHandle),
+		multisig_account_view.NewMultisigAccountTransactions(rdbHandle),
+	}
+}
+
+// FindBy returns the threshold and members of a multisig account
+func (handler *MultisigAccounts) FindBy(ctx *fasthttp.RequestCtx) {
+	accountParam, _ := ctx.UserValue("account").(string)
+
+	account, err := handler.multisigAccountsView.FindBy(accountParam)
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			httpapi.NotFound(ctx)
+			return
+		}
+		handler.logger.Errorf("error finding multisig account: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.Success(ctx, account)
+}
+
+// ListTransactionsByAccount returns the transactions signed by a multisig account
+func (handler *MultisigAccounts) ListTransactionsByAccount(ctx *fasthttp.RequestCtx) {
+	pagination, paginationError := httpapi.ParsePagination(ctx)
+	if paginationError != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	accountParam, _ := ctx.UserValue("account").(string)
+
+	heightOrder := view.ORDER_ASC
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("order") {
+		if string(queryArgs.Peek("order")) == "height.desc" {
+			heightOrder = view.ORDER_DESC
+		}
+	}
+
+	transactions, paginationResult, err := handler.multisigAccountTransactionsView.ListByAddress(
+		accountParam,
+		multisig_account_view.MultisigAccountTransactionsListOrder{
+			Height: heightOrder,
+		},
+		pagination,
+	)
+	if err != nil {
+		handler.logger.Errorf("error listing multisig account transactions: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, transactions, paginationResult)
+}
+
+// ListMembershipsByAccount returns the multisig accounts the account is a member of
+func (handler *MultisigAccounts) ListMembershipsByAccount(ctx *fasthttp.RequestCtx) {
+	pagination, paginationError := httpapi.ParsePagination(ctx)
+	if paginationError != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	accountParam, _ := ctx.UserValue("account").(string)
+
+	multisigAccounts, paginationResult, err := handler.multisigAccountsView.ListByMember(accountParam, pagination)
+	if err != nil {
+		handler.logger.Errorf("error listing multisig account memberships: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, multisigAccounts, paginationResult)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	// Registers the Swagger UI assets of the Cosmos SDK
+	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
+	jsoniter "github.com/json-iterator/go"
+	statikfs "github.com/rakyll/statik/fs"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/openapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+// Swagger UI page loading the specification and the Swagger UI assets served next to it
+const OPENAPI_DOCS_HTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>Chain Indexing API</title>
+  <link rel="stylesheet" href="docs/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="docs/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({
+        url: "openapi.json",
+        dom_id: "#swagger-ui",
+      });
+    };
+  </script>
+</body>
+</html>
+`
+
+// Swagger UI assets served by the docs page, with their content types. The assets are bundled with
+// the Cosmos SDK module, so that the page loads no third-party script and works offline.
+var OPENAPI_DOCS_ASSETS = map[string]string{
+	"swagger-ui.css":       "text/css; charset=utf-8",
+	"swagger-ui-bundle.js": "application/javascript; charset=utf-8",
+}
+
+type OpenAPI struct {
+	logger applogger.Logger
+
+	document   []byte
+	docsAssets map[string][]byte
+}
+
+func NewOpenAPI(logger applogger.Logger, document *openapi.Document) (*OpenAPI, error) {
+	encodedDocument, err := jsoniter.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding OpenAPI document: %v", err)
+	}
+	docsAssets, err := readDocsAssets()
+	if err != nil {
+		return nil, fmt.Errorf("error reading OpenAPI docs assets: %v", err)
+	}
+
+	return &OpenAPI{
+		logger.WithFields(applogger.LogFields{
+			"module": "OpenAPIHandler",
+		}),
+
+		encodedDocument,
+		docsAssets,
+	}, nil
+}
+
+func readDocsAssets() (map[string][]byte, error) {
+	assetFS, err := statikfs.New()
+	if err != nil {
+		return nil, fmt.Errorf("error opening Swagger UI assets: %v", err)
+	}
+
+	docsAssets := make(map[string][]byte, len(OPENAPI_DOCS_ASSETS))
+	for name := range OPENAPI_DOCS_ASSETS {
+		file, err := assetFS.Open("/" + name)
+		if err != nil {
+			return nil, fmt.Errorf("error opening Swagger UI asset %s: %v", name, err)
+		}
+		content, err := ioutil.ReadAll(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading Swagger UI asset %s: %v", name, err)
+		}
+		docsAssets[name] = content
+	}
+	return docsAssets, nil
+}
+
+// Spec returns the OpenAPI specification of the HTTP API
+func (handler *OpenAPI) Spec(ctx *fasthttp.RequestCtx) {
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetBody(handler.document)
+}
+
+// Docs returns the documentation UI of the OpenAPI specification
+func (handler *OpenAPI) Docs(ctx *fasthttp.RequestCtx) {
+	ctx.Response.Header.Set("Content-Type", "text/html; charset=utf-8")
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetBodyString(OPENAPI_DOCS_HTML)
+}
+
+// DocsAsset returns a Swagger UI asset of the documentation UI
+func (handler *OpenAPI) DocsAsset(ctx *fasthttp.RequestCtx) {
+	name, _ := ctx.UserValue("asset").(string)
+	content, ok := handler.docsAssets[name]
+	if !ok {
+		httpapi.NotFound(ctx)
+		return
+	}
+
+	ctx.Response.Header.Set("Content-Type", OPENAPI_DOCS_ASSETS[name])
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetBody(content)
+}
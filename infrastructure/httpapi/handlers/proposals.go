@@ -160,7 +160,8 @@ func (handler *Proposals) List(ctx *fasthttp.RequestCtx) {
 	}
 
 	filter := proposal_view.ProposalListFilter{
-		MaybeStatus: nil,
+		MaybeStatus:          nil,
+		MaybeProposerAddress: nil,
 	}
 	if queryArgs.Has("filter.status") {
 		status := string(queryArgs.Peek("filter.status"))
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	account_pubkey_view "github.com/crypto-com/chain-indexing/projection/account_pubkey/view"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+)
+
+type PubKeys struct {
+	logger applogger.Logger
+
+	accountPubKeysView *account_pubkey_view.AccountPubKeys
+	validatorsView     *validator_view.Validators
+
+	accountAddressPrefix   string
+	validatorAddressPrefix string
+	conNodeAddressPrefix   string
+}
+
+func NewPubKeys(
+	logger applogger.Logger,
+	rdbHandle *rdb.Handle,
+	accountAddressPrefix string,
+	validatorAddressPrefix string,
+	conNodeAddressPrefix string,
+) *PubKeys {
+	return &PubKeys{
+		logger.WithFields(applogger.LogFields{
+			"module": "PubKeysHandler",
+		}),
+
+		account_pubkey_view.NewAccountPubKeys(rdbHandle),
+		validator_view.NewValidators(rdbHandle),
+
+		accountAddressPrefix,
+		validatorAddressPrefix,
+		conNodeAddressPrefix,
+	}
+}
+
+// FindBy returns the account of a public key and the validator it belongs to. The public key can
+// be provided in hex, base64 or bech32 encoding. The account is looked up by the public key
+// recorded on-chain first. A secp256k1 key is matched to the validator operated by its account, and
+// an ed25519 key to the validator of which it is the consensus key.
+func (handler *PubKeys) FindBy(ctx *fasthttp.RequestCtx) {
+	pubKeyParam, _ := ctx.UserValue("pubkey").(string)
+
+	pubKey, err := tmcosmosutils.ParsePubKey(pubKeyParam)
+	if err != nil {
+		httpapi.BadRequest(ctx, errors.New("invalid public key"))
+		return
+	}
+
+	info := PubKeyInfo{
+		PubKey: base64.StdEncoding.EncodeToString(pubKey.Bytes()),
+		Type:   pubKey.Type(),
+	}
+
+	accountPubKey, err := handler.accountPubKeysView.FindByPubKey(info.PubKey)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			handler.logger.Errorf("error finding account pubkey: %v", err)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+	} else {
+		info.MaybeAccountAddress = primptr.String(accountPubKey.Address)
+		info.MaybeAccount = accountPubKey
+	}
+
+	var validatorIdentity validator_view.ValidatorIdentity
+	switch pubKey.(type) {
+	case *secp256k1.PubKey:
+		if info.MaybeAccountAddress == nil {
+			accountAddress, addressErr := tmcosmosutils.AddressFromTypedPubKey(handler.accountAddressPrefix, pubKey)
+			if addressErr != nil {
+				httpapi.BadRequest(ctx, errors.New("invalid public key"))
+				return
+			}
+			info.MaybeAccountAddress = primptr.String(accountAddress)
+		}
+		validatorIdentity.MaybeOperatorAddress = primptr.String(tmcosmosutils.MustValidatorAddressFromAccountAddress(
+			handler.validatorAddressPrefix, *info.MaybeAccountAddress,
+		))
+	case *ed25519.PubKey:
+		conNodeAddress, addressErr := tmcosmosutils.AddressFromTypedPubKey(handler.conNodeAddressPrefix, pubKey)
+		if addressErr != nil {
+			httpapi.BadRequest(ctx, errors.New("invalid public key"))
+			return
+		}
+		validatorIdentity.MaybeConsensusNodeAddress = primptr.String(conNodeAddress)
+	default:
+		httpapi.BadRequest(ctx, errors.New("unsupported public key type"))
+		return
+	}
+
+	validator, err := handler.validatorsView.FindBy(validatorIdentity)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			handler.logger.Errorf("error finding pubkey's validator: %v", err)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+	} else {
+		info.MaybeValidatorAddress = primptr.String(validator.OperatorAddress)
+		info.MaybeConsensusNodeAddress = primptr.String(validator.ConsensusNodeAddress)
+	}
+
+	if info.MaybeAccount == nil && info.MaybeValidatorAddress == nil {
+		httpapi.NotFound(ctx)
+		return
+	}
+
+	httpapi.Success(ctx, info)
+}
+
+type PubKeyInfo struct {
+	PubKey                    string                                `json:"pubKey"`
+	Type                      string                                `json:"type"`
+	MaybeAccountAddress       *string                               `json:"accountAddress"`
+	MaybeAccount              *account_pubkey_view.AccountPubKeyRow `json:"account"`
+	MaybeValidatorAddress     *string                               `json:"validatorAddress"`
+	MaybeConsensusNodeAddress *string                               `json:"consensusNodeAddress"`
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/replica"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+type Replica struct {
+	logger applogger.Logger
+
+	// Nil when no read replica is configured
+	maybeRouter *replica.Router
+}
+
+func NewReplica(logger applogger.Logger, maybeRouter *replica.Router) *Replica {
+	return &Replica{
+		logger.WithFields(applogger.LogFields{
+			"module": "ReplicaHandler",
+		}),
+
+		maybeRouter,
+	}
+}
+
+// Status returns whether the API queries are served by the read replica and the replica lag
+func (handler *Replica) Status(ctx *fasthttp.RequestCtx) {
+	if handler.maybeRouter == nil {
+		httpapi.Success(ctx, ReplicaStatus{
+			IsEnabled:   false,
+			MaybeStatus: nil,
+		})
+		return
+	}
+
+	status := handler.maybeRouter.Status()
+	httpapi.Success(ctx, ReplicaStatus{
+		IsEnabled:   true,
+		MaybeStatus: &status,
+	})
+}
+
+type ReplicaStatus struct {
+	IsEnabled bool `json:"enabled"`
+	// Nil when no read replica is configured
+	MaybeStatus *replica.Status `json:"status"`
+}
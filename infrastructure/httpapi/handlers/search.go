@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
 
@@ -11,8 +12,10 @@ import (
 
 	"github.com/valyala/fasthttp"
 
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/search"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
 	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
@@ -25,6 +28,9 @@ type Search struct {
 	transactionsView             *transaction_view.BlockTransactions
 	validatorsView               *validator_view.Validators
 	accountTransactionsTotalView *account_transaction_view.AccountTransactionsTotal
+	fullTextSearch               *search.FullTextSearch
+
+	projections view.Projections
 }
 
 func NewSearch(logger applogger.Logger, rdbHandle *rdb.Handle) *Search {
@@ -37,52 +43,95 @@ func NewSearch(logger applogger.Logger, rdbHandle *rdb.Handle) *Search {
 		transaction_view.NewTransactions(rdbHandle),
 		validator_view.NewValidators(rdbHandle),
 		account_transaction_view.NewAccountTransactionsTotal(rdbHandle),
+		search.NewFullTextSearch(rdbHandle),
+
+		nil,
 	}
 }
 
-func (search *Search) Search(ctx *fasthttp.RequestCtx) {
-	keyword := string(ctx.QueryArgs().Peek("keyword"))
+// WithProjections only searches the views of the projections. The views of all projections are
+// searched by default.
+func (handler *Search) WithProjections(projections view.Projections) *Search {
+	handler.projections = projections
+	handler.fullTextSearch = handler.fullTextSearch.WithProjections(projections)
+	return handler
+}
 
-	var results SearchResults
+// Search returns the exact matches of blocks, transactions, validators and accounts, together with
+// the ranked and paginated full-text matches. The full-text matches can be filtered by a
+// comma-separated `filter.type` list. The views of the projections not served are not searched.
+func (handler *Search) Search(ctx *fasthttp.RequestCtx) {
+	pagination, paginationError := httpapi.ParsePagination(ctx)
+	if paginationError != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
 
-	blocks, err := search.blocksView.Search(keyword)
-	if err != nil {
-		if errors.Is(err, rdb.ErrNoRows) {
-			blocks = []block_view.Block{}
-		} else {
-			search.logger.Errorf("error searching block: %v", err)
-			httpapi.InternalServerError(ctx)
+	queryArgs := ctx.QueryArgs()
+	keyword := strings.TrimSpace(string(queryArgs.Peek("keyword")))
+
+	filter := search.Filter{
+		ResultTypes: nil,
+	}
+	if queryArgs.Has("filter.type") {
+		resultTypes, parseErr := search.ParseResultTypes(string(queryArgs.Peek("filter.type")))
+		if parseErr != nil {
+			httpapi.BadRequest(ctx, parseErr)
 			return
 		}
+		filter.ResultTypes = resultTypes
 	}
 
-	transactions, err := search.transactionsView.Search(keyword)
-	if err != nil {
-		if errors.Is(err, rdb.ErrNoRows) {
-			transactions = []transaction_view.TransactionRow{}
-		} else {
-			search.logger.Errorf("error searching transaction: %v", err)
-			httpapi.InternalServerError(ctx)
-			return
+	var results SearchResults
+	var err error
+
+	blocks := []block_view.Block{}
+	if handler.projections.Serves("Block") {
+		blocks, err = handler.blocksView.Search(keyword)
+		if err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				blocks = []block_view.Block{}
+			} else {
+				handler.logger.Errorf("error searching block: %v", err)
+				httpapi.InternalServerError(ctx)
+				return
+			}
 		}
 	}
 
-	validators, err := search.validatorsView.Search(keyword)
-	if err != nil {
-		if errors.Is(err, rdb.ErrNoRows) {
-			validators = []validator_view.ValidatorRow{}
-		} else {
-			search.logger.Errorf("error searching validator: %v", err)
-			httpapi.InternalServerError(ctx)
-			return
+	transactions := []transaction_view.TransactionRow{}
+	if handler.projections.Serves("Transaction") {
+		transactions, err = handler.transactionsView.Search(keyword)
+		if err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				transactions = []transaction_view.TransactionRow{}
+			} else {
+				handler.logger.Errorf("error searching transaction: %v", err)
+				httpapi.InternalServerError(ctx)
+				return
+			}
+		}
+	}
+
+	validators := []validator_view.ValidatorRow{}
+	if handler.projections.Serves("Validator") {
+		validators, err = handler.validatorsView.Search(keyword)
+		if err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				validators = []validator_view.ValidatorRow{}
+			} else {
+				handler.logger.Errorf("error searching validator: %v", err)
+				httpapi.InternalServerError(ctx)
+				return
+			}
 		}
 	}
 
 	results.Accounts = make([]string, 0)
-	if tmcosmosutils.IsValidCosmosAddress(keyword) {
-		isAccountExist, err := search.accountTransactionsTotalView.Search(keyword)
+	if handler.projections.Serves("AccountTransaction") && tmcosmosutils.IsValidCosmosAddress(keyword) {
+		isAccountExist, err := handler.accountTransactionsTotalView.Search(keyword)
 		if err != nil {
-			search.logger.Errorf("error searching account: %v", err)
+			handler.logger.Errorf("error searching account: %v", err)
 			httpapi.InternalServerError(ctx)
 			return
 		}
@@ -95,7 +144,18 @@ func (search *Search) Search(ctx *fasthttp.RequestCtx) {
 	results.Transactions = transactions
 	results.Validators = validators
 
-	httpapi.Success(ctx, results)
+	paginationResult := pagination.OffsetResult(0)
+	results.Matches = make([]search.Result, 0)
+	if len(keyword) >= search.MIN_KEYWORD_LENGTH {
+		results.Matches, paginationResult, err = handler.fullTextSearch.Search(keyword, filter, pagination)
+		if err != nil {
+			handler.logger.Errorf("error performing full-text search: %v", err)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+	}
+
+	httpapi.SuccessWithPagination(ctx, results, paginationResult)
 }
 
 type SearchResults struct {
@@ -103,4 +163,5 @@ type SearchResults struct {
 	Transactions []transaction_view.TransactionRow `json:"transactions"`
 	Validators   []validator_view.ValidatorRow     `json:"validators"`
 	Accounts     []string                          `json:"accounts"`
+	Matches      []search.Result                   `json:"matches"`
 }
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	supply_view "github.com/crypto-com/chain-indexing/projection/supply/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+type Supply struct {
+	logger applogger.Logger
+
+	cosmosClient      cosmosapp.Client
+	suppliesView      *supply_view.Supplies
+	supplyHistoryView *supply_view.SupplyHistory
+
+	// accounts whose balances are excluded from the circulating supply
+	circulatingExcludedAddresses []string
+}
+
+func NewSupply(
+	logger applogger.Logger,
+	rdbHandle *rdb.Handle,
+	cosmosClient cosmosapp.Client,
+	circulatingExcludedAddresses []string,
+) *Supply {
+	return &Supply{
+		logger.WithFields(applogger.LogFields{
+			"module": "SupplyHandler",
+		}),
+
+		cosmosClient,
+		supply_view.NewSupplies(rdbHandle),
+		supply_view.NewSupplyHistory(rdbHandle),
+
+		circulatingExcludedAddresses,
+	}
+}
+
+func (handler *Supply) List(ctx *fasthttp.RequestCtx) {
+	supplies, err := handler.suppliesView.List()
+	if err != nil {
+		handler.logger.Errorf("error listing supplies: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	excludedBalances := coin.NewEmptyCoins()
+	for _, address := range handler.circulatingExcludedAddresses {
+		balances, queryErr := handler.cosmosClient.Balances(address)
+		if queryErr != nil {
+			handler.logger.Errorf("error querying balances of circulating supply excluded account: %v", queryErr)
+			httpapi.InternalServerError(ctx)
+			return
+		}
+		excludedBalances = excludedBalances.Add(balances...)
+	}
+
+	result := make([]SupplyDetails, 0, len(supplies))
+	for _, supply := range supplies {
+		circulating := supply.Total.Sub(excludedBalances.AmountOf(supply.Denom))
+		if circulating.IsNegative() {
+			circulating = coin.ZeroInt()
+		}
+		result = append(result, SupplyDetails{
+			SupplyRow:   supply,
+			Circulating: circulating,
+		})
+	}
+
+	httpapi.Success(ctx, result)
+}
+
+func (handler *Supply) ListHistoryByDenom(ctx *fasthttp.RequestCtx) {
+	denomParam, _ := ctx.UserValue("denom").(string)
+
+	pagination, paginationError := httpapi.ParsePagination(ctx)
+	if paginationError != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	heightOrder := view.ORDER_ASC
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("order") {
+		if string(queryArgs.Peek("order")) == "height.desc" {
+			heightOrder = view.ORDER_DESC
+		}
+	}
+
+	history, paginationResult, err := handler.supplyHistoryView.ListByDenom(
+		denomParam,
+		supply_view.SupplyHistoryListOrder{
+			Height: heightOrder,
+		},
+		pagination,
+	)
+	if err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			httpapi.NotFound(ctx)
+			return
+		}
+		handler.logger.Errorf("error listing supply history: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+
+	httpapi.SuccessWithPagination(ctx, history, paginationResult)
+}
+
+type SupplyDetails struct {
+	supply_view.SupplyRow
+
+	Circulating coin.Int `json:"circulating"`
+}
@@ -168,10 +168,10 @@ func (handler *Validators) List(ctx *fasthttp.RequestCtx) {
 		}
 		handler.globalAPYLastUpdatedAt = time.Now()
 	}
-	validatorsWithAPY := make([]validatorRowWithAPY, 0, len(validators))
+	validatorsWithAPY := make([]ValidatorRowWithAPY, 0, len(validators))
 	for _, validator := range validators {
 		if validator.Status != constants.BONDED {
-			validatorsWithAPY = append(validatorsWithAPY, validatorRowWithAPY{
+			validatorsWithAPY = append(validatorsWithAPY, ValidatorRowWithAPY{
 				validator,
 				"0",
 			})
@@ -188,7 +188,7 @@ func (handler *Validators) List(ctx *fasthttp.RequestCtx) {
 			commissionRate,
 		)
 		apy := new(big.Float).Mul(handler.globalAPY, afterCommission)
-		validatorsWithAPY = append(validatorsWithAPY, validatorRowWithAPY{
+		validatorsWithAPY = append(validatorsWithAPY, ValidatorRowWithAPY{
 			validator,
 			apy.Text('f', -1),
 		})
@@ -197,7 +197,7 @@ func (handler *Validators) List(ctx *fasthttp.RequestCtx) {
 	httpapi.SuccessWithPagination(ctx, validatorsWithAPY, paginationResult)
 }
 
-type validatorRowWithAPY struct {
+type ValidatorRowWithAPY struct {
 	validator_view.ListValidatorRow
 
 	APY string `json:"apy"`
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	vesting_account_view "github.com/crypto-com/chain-indexing/projection/vesting_account/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const MAX_VESTING_SCHEDULE_POINTS = 1000
+
+type Vesting struct {
+	logger applogger.Logger
+
+	vestingAccountsView *vesting_account_view.VestingAccounts
+	blocksView          *block_view.Blocks
+}
+
+func NewVesting(logger applogger.Logger, rdbHandle *rdb.Handle) *Vesting {
+	return &Vesting{
+		logger.WithFields(applogger.LogFields{
+			"module": "VestingHandler",
+		}),
+
+		vesting_account_view.NewVestingAccounts(rdbHandle),
+		block_view.NewBlocks(rdbHandle),
+	}
+}
+
+// Schedule returns the chain-wide vested and unvested amounts over time
+func (handler *Vesting) Schedule(ctx *fasthttp.RequestCtx) {
+	queryArgs := ctx.QueryArgs()
+
+	interval := "month"
+	if queryArgs.Has("interval") {
+		interval = string(queryArgs.Peek("interval"))
+		if interval != "day" && interval != "week" && interval != "month" {
+			httpapi.BadRequest(ctx, errors.New("invalid interval"))
+			return
+		}
+	}
+
+	vestingAccounts, err := handler.vestingAccountsView.ListAll(vesting_account_view.VestingAccountsListFilter{
+		MaybeEndTimeAfter: nil,
+	})
+	if err != nil {
+		handler.logger.Errorf("error listing vesting accounts: %v", err)
+		httpapi.InternalServerError(ctx)
+		return
+	}
+	if len(vestingAccounts) == 0 {
+		httpapi.Success(ctx, []VestingSchedulePoint{})
+		return
+	}
+
+	// The schedule defaults to start from the earliest known start time. Accounts indexed before
+	// their start times were recorded have a zero start time and are skipped.
+	var from int64
+	to := vestingAccounts[0].Schedule.EndTime
+	for _, vestingAccount := range vestingAccounts {
+		startTime := vestingAccount.Schedule.StartTime
+		if startTime != 0 && (from == 0 || startTime < from) {
+			from = startTime
+		}
+		if vestingAccount.Schedule.EndTime > to {
+			to = vestingAccount.Schedule.EndTime
+		}
+	}
+	if from == 0 {
+		from = to
+	}
+	if queryArgs.Has("from") {
+		if from, err = strconv.ParseInt(string(queryArgs.Peek("from")), 10, 64); err != nil {
+			httpapi.BadRequest(ctx, errors.New("invalid from time"))
+			return
+		}
+	}
+	if queryArgs.Has("to") {
+		if to, err = strconv.ParseInt(string(queryArgs.Peek("to")), 10, 64); err != nil {
+			httpapi.BadRequest(ctx, errors.New("invalid to time"))
+			return
+		}
+	}
+	if to < from {
+		httpapi.BadRequest(ctx, errors.New("to time must not be earlier than from time"))
+		return
+	}
+
+	points := make([]VestingSchedulePoint, 0)
+	for pointTime := time.Unix(from, 0).UTC(); ; pointTime = nextVestingSchedulePointTime(pointTime, interval) {
+		pointUnixTime := pointTime.Unix()
+		if pointUnixTime > to {
+			pointUnixTime = to
+		}
+		if len(points) == MAX_VESTING_SCHEDULE_POINTS {
+			httpapi.BadRequest(ctx, fmt.Errorf(
+				"too many schedule points, at most %d are allowed", MAX_VESTING_SCHEDULE_POINTS,
+			))
+			return
+		}
+
+		vested := coin.NewEmptyCoins()
+		unvested := coin.NewEmptyCoins()
+		for i := range vestingAccounts {
+			vested = vested.Add(vestingAccounts[i].Schedule.VestedAt(pointUnixTime)...)
+			unvested = unvested.Add(vestingAccounts[i].Schedule.UnvestedAt(pointUnixTime)...)
+		}
+		points = append(points, VestingSchedulePoint{
+			Time:     utctime.FromUnixNano(pointUnixTime * int64(time.Second)),
+			Vested:   vested,
+			Unvested: unvested,
+		})
+
+		if pointUnixTime >= to {
+			break
+		}
+	}
+
+	httpapi.Success(ctx, points)
+}
+
+// parseVestingTime returns the Unix time specified by `vesting.time` (Unix seconds) or
+// `vesting.height` query argument. It defaults to current time.
+func parseVestingTime(ctx *fasthttp.RequestCtx, blocksView *block_view.Blocks) (int64, error) {
+	queryArgs := ctx.QueryArgs()
+	if queryArgs.Has("vesting.time") {
+		unixTime, err := strconv.ParseInt(string(queryArgs.Peek("vesting.time")), 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid vesting time")
+		}
+		return unixTime, nil
+	}
+	if queryArgs.Has("vesting.height") {
+		height, err := strconv.ParseInt(string(queryArgs.Peek("vesting.height")), 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid vesting height")
+		}
+		block, err := blocksView.FindBy(&block_view.BlockIdentity{
+			MaybeHeight: &height,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("error finding block of vesting height: %w", err)
+		}
+		return block.Time.UnixNano() / int64(time.Second), nil
+	}
+
+	return time.Now().Unix(), nil
+}
+
+func nextVestingSchedulePointTime(current time.Time, interval string) time.Time {
+	switch interval {
+	case "day":
+		return current.AddDate(0, 0, 1)
+	case "week":
+		return current.AddDate(0, 0, 7)
+	default:
+		return current.AddDate(0, 1, 0)
+	}
+}
+
+type VestingSchedulePoint struct {
+	Time     utctime.UTCTime `json:"time"`
+	Vested   coin.Coins      `json:"vested"`
+	Unvested coin.Coins      `json:"unvested"`
+}
+
+type AccountVestingInfo struct {
+	*vesting_account_view.VestingAccountRow
+
+	Time     utctime.UTCTime `json:"time"`
+	Vested   coin.Coins      `json:"vested"`
+	Unvested coin.Coins      `json:"unvested"`
+}
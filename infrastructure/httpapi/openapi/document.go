@@ -0,0 +1,163 @@
+package openapi
+
+import (
+	"strings"
+)
+
+const OPENAPI_VERSION = "3.0.3"
+
+const (
+	PARAMETER_IN_PATH  = "path"
+	PARAMETER_IN_QUERY = "query"
+)
+
+// Document is the subset of the OpenAPI 3 specification used to describe the HTTP API
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type PathItem struct {
+	Get  *Operation `json:"get,omitempty"`
+	Post *Operation `json:"post,omitempty"`
+}
+
+type Operation struct {
+	OperationId string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Style       string  `json:"style,omitempty"`
+	Explode     *bool   `json:"explode,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// IsExploded returns true when an array parameter is provided as repeated query arguments rather
+// than a comma-separated list
+func (parameter *Parameter) IsExploded() bool {
+	if parameter.Explode != nil {
+		return *parameter.Explode
+	}
+	return parameter.In == PARAMETER_IN_QUERY && (parameter.Style == "" || parameter.Style == "form")
+}
+
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+type Schema struct {
+	Ref string `json:"$ref,omitempty"`
+
+	Type        string        `json:"type,omitempty"`
+	Format      string        `json:"format,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Nullable    bool          `json:"nullable,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	Default     interface{}   `json:"default,omitempty"`
+	Minimum     *float64      `json:"minimum,omitempty"`
+	Maximum     *float64      `json:"maximum,omitempty"`
+	MinLength   *int64        `json:"minLength,omitempty"`
+	Pattern     string        `json:"pattern,omitempty"`
+
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+}
+
+func NewDocument(info Info, serverURL string) *Document {
+	return &Document{
+		OpenAPI: OPENAPI_VERSION,
+		Info:    info,
+		Servers: []Server{{
+			URL: serverURL,
+		}},
+		Paths: make(map[string]*PathItem),
+		Components: Components{
+			Schemas: make(map[string]*Schema),
+		},
+	}
+}
+
+// AddOperation adds the operation of the method on the path. Path parameters are written in the
+// same `{name}` form as the routes.
+func (document *Document) AddOperation(method string, path string, operation *Operation) *Document {
+	pathItem, ok := document.Paths[path]
+	if !ok {
+		pathItem = &PathItem{}
+		document.Paths[path] = pathItem
+	}
+
+	switch strings.ToUpper(method) {
+	case "GET":
+		pathItem.Get = operation
+	case "POST":
+		pathItem.Post = operation
+	default:
+		panic("unsupported OpenAPI method: " + method)
+	}
+	return document
+}
+
+// Operation returns the operation of the method on the path, nil if it is not documented
+func (document *Document) Operation(method string, path string) *Operation {
+	pathItem, ok := document.Paths[path]
+	if !ok {
+		return nil
+	}
+
+	switch strings.ToUpper(method) {
+	case "GET":
+		return pathItem.Get
+	case "POST":
+		return pathItem.Post
+	default:
+		return nil
+	}
+}
+
+// ResolveSchema follows the reference of a schema to the component it points to
+func (document *Document) ResolveSchema(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = document.Components.Schemas[strings.TrimPrefix(schema.Ref, COMPONENT_SCHEMA_REF_PREFIX)]
+	}
+	return schema
+}
@@ -0,0 +1,214 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const COMPONENT_SCHEMA_REF_PREFIX = "#/components/schemas/"
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	timeType          = reflect.TypeOf(time.Time{})
+	bigIntType        = reflect.TypeOf(big.Int{})
+)
+
+// SchemaGenerator derives response schemas from the Go types returned by the handlers, following
+// the `json` tags the same way the encoder does. Named struct types are added to the document
+// components and referenced.
+type SchemaGenerator struct {
+	document *Document
+
+	overrides map[reflect.Type]*Schema
+	names     map[reflect.Type]string
+}
+
+func NewSchemaGenerator(document *Document) *SchemaGenerator {
+	return &SchemaGenerator{
+		document: document,
+
+		overrides: map[reflect.Type]*Schema{
+			timeType: {
+				Type:   "string",
+				Format: "date-time",
+			},
+			rawMessageType: {},
+			bigIntType: {
+				Type: "integer",
+			},
+		},
+		names: make(map[reflect.Type]string),
+	}
+}
+
+// WithOverride uses the provided schema for the type of the value. It is required for types with
+// a custom JSON marshaller whose output cannot be derived from their fields.
+func (generator *SchemaGenerator) WithOverride(value interface{}, schema *Schema) *SchemaGenerator {
+	generator.overrides[reflect.TypeOf(value)] = schema
+	return generator
+}
+
+// SchemaOf returns the schema of the JSON encoding of the value
+func (generator *SchemaGenerator) SchemaOf(value interface{}) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+	return generator.schemaOfType(reflect.TypeOf(value))
+}
+
+func (generator *SchemaGenerator) schemaOfType(t reflect.Type) *Schema {
+	if override, ok := generator.overrides[t]; ok {
+		return override
+	}
+
+	if t.Kind() == reflect.Ptr {
+		schema := *generator.schemaOfType(t.Elem())
+		if schema.Ref != "" {
+			return &Schema{
+				AllOf:    []*Schema{&schema},
+				Nullable: true,
+			}
+		}
+		schema.Nullable = true
+		return &schema
+	}
+
+	// Types with a custom marshaller other than the overridden ones are either a slice of a
+	// regular type (e.g. coin.Coins) or encoded as a string
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array && isMarshaler(reflect.PtrTo(t)) {
+		return &Schema{
+			Type: "string",
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{
+			Type: "boolean",
+		}
+	case reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{
+			Type:   "integer",
+			Format: "int32",
+		}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{
+			Type:   "integer",
+			Format: "int64",
+		}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{
+			Type: "number",
+		}
+	case reflect.String:
+		return &Schema{
+			Type: "string",
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{
+				Type:   "string",
+				Format: "byte",
+			}
+		}
+		return &Schema{
+			Type:  "array",
+			Items: generator.schemaOfType(t.Elem()),
+		}
+	case reflect.Map:
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: generator.schemaOfType(t.Elem()),
+		}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return generator.structSchema(t)
+		}
+		return &Schema{
+			Ref: COMPONENT_SCHEMA_REF_PREFIX + generator.component(t),
+		}
+	default:
+		// interface{} and other types with no fixed encoding
+		return &Schema{}
+	}
+}
+
+// component adds the schema of the named struct type to the document components and returns its
+// name. The name is prefixed by the package path when it has been taken by another type.
+func (generator *SchemaGenerator) component(t reflect.Type) string {
+	if name, ok := generator.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := generator.document.Components.Schemas[name]; taken {
+		pkgPath := t.PkgPath()
+		name = fmt.Sprintf("%s_%s.%s", path.Base(path.Dir(pkgPath)), path.Base(pkgPath), t.Name())
+	}
+
+	// Registered before the properties are derived so that recursive types terminate
+	generator.names[t] = name
+	generator.document.Components.Schemas[name] = &Schema{}
+	generator.document.Components.Schemas[name] = generator.structSchema(t)
+
+	return name
+}
+
+func (generator *SchemaGenerator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	generator.addFields(schema, t)
+	return schema
+}
+
+func (generator *SchemaGenerator) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				generator.addFields(schema, fieldType)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// Unexported field
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = generator.schemaOfType(field.Type)
+		if !strings.Contains(tag, ",omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// isMarshaler returns true when the type has a custom JSON or text marshaller. The pointer type is
+// checked so that the methods with either receivers are found.
+func isMarshaler(ptrType reflect.Type) bool {
+	return ptrType.Implements(jsonMarshalerType) || ptrType.Implements(textMarshalerType)
+}
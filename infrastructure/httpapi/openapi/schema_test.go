@@ -0,0 +1,72 @@
+package openapi_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/openapi"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+type Embedded struct {
+	Height int64 `json:"height"`
+}
+
+type Node struct {
+	Embedded
+
+	Name       string          `json:"name"`
+	MaybeMemo  *string         `json:"memo,omitempty"`
+	Amount     coin.Coins      `json:"amount"`
+	Total      coin.Int        `json:"total"`
+	Time       utctime.UTCTime `json:"time"`
+	Data       json.RawMessage `json:"data"`
+	Children   []*Node         `json:"children"`
+	Ignored    string          `json:"-"`
+	unexported string
+	Attributes map[string]int `json:"attributes"`
+}
+
+var _ = Describe("SchemaGenerator", func() {
+	var document *openapi.Document
+	var generator *openapi.SchemaGenerator
+
+	BeforeEach(func() {
+		document = openapi.NewDocument(openapi.Info{Title: "Test", Version: "v1"}, "/")
+		generator = openapi.NewSchemaGenerator(document).WithOverride(utctime.UTCTime{}, &openapi.Schema{
+			Type:   "string",
+			Format: "date-time",
+		})
+	})
+
+	It("should reference named struct types as components", func() {
+		schema := generator.SchemaOf([]Node{})
+
+		Expect(schema.Type).To(Equal("array"))
+		Expect(schema.Items.Ref).To(Equal("#/components/schemas/Node"))
+		Expect(document.Components.Schemas).To(HaveKey("Node"))
+	})
+
+	It("should derive the properties from the json tags", func() {
+		generator.SchemaOf(Node{})
+		node := document.Components.Schemas["Node"]
+
+		Expect(node.Properties).To(HaveKey("height"))
+		Expect(node.Properties["name"].Type).To(Equal("string"))
+		Expect(node.Properties["memo"].Nullable).To(BeTrue())
+		Expect(node.Properties["amount"].Items.Ref).To(Equal("#/components/schemas/Coin"))
+		Expect(node.Properties["total"].Type).To(Equal("string"))
+		Expect(node.Properties["time"].Format).To(Equal("date-time"))
+		Expect(node.Properties["data"].Type).To(BeEmpty())
+		Expect(node.Properties["children"].Items.AllOf[0].Ref).To(Equal("#/components/schemas/Node"))
+		Expect(node.Properties["attributes"].AdditionalProperties.Type).To(Equal("integer"))
+		Expect(node.Properties).NotTo(HaveKey("Ignored"))
+		Expect(node.Properties).NotTo(HaveKey("unexported"))
+
+		Expect(node.Required).To(ContainElement("name"))
+		Expect(node.Required).NotTo(ContainElement("memo"))
+	})
+})
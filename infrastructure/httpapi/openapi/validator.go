@@ -0,0 +1,211 @@
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+)
+
+// Validator rejects the requests whose path and query parameters do not conform to the
+// parameters of the documented operation, before they reach the handler. Parameters not in the
+// document are ignored.
+type Validator struct {
+	document    *Document
+	routePrefix string
+
+	patterns map[string]*regexp.Regexp
+}
+
+func NewValidator(document *Document, routePrefix string) *Validator {
+	if routePrefix == "/" {
+		routePrefix = ""
+	}
+
+	return &Validator{
+		document,
+		routePrefix,
+
+		make(map[string]*regexp.Regexp),
+	}
+}
+
+// Middleware wraps the handler of a route with the validation of its documented operation. It
+// is an httpapi.RouteMiddleware.
+func (validator *Validator) Middleware(
+	method string,
+	path string,
+	handler fasthttp.RequestHandler,
+) fasthttp.RequestHandler {
+	operation := validator.document.Operation(method, strings.TrimPrefix(path, validator.routePrefix))
+	if operation == nil || len(operation.Parameters) == 0 {
+		return handler
+	}
+	validator.compilePatterns(operation)
+
+	return func(ctx *fasthttp.RequestCtx) {
+		if parameterErrors := validator.Validate(ctx, operation); len(parameterErrors) != 0 {
+			httpapi.InvalidParameters(ctx, parameterErrors)
+			return
+		}
+
+		handler(ctx)
+	}
+}
+
+// Validate returns the errors of every invalid parameter of the request against the operation
+func (validator *Validator) Validate(ctx *fasthttp.RequestCtx, operation *Operation) []httpapi.ParameterError {
+	var parameterErrors []httpapi.ParameterError
+	for i := range operation.Parameters {
+		parameter := &operation.Parameters[i]
+		if err := validator.validateParameter(ctx, parameter); err != nil {
+			parameterErrors = append(parameterErrors, httpapi.ParameterError{
+				In:      parameter.In,
+				Name:    parameter.Name,
+				Message: err.Error(),
+			})
+		}
+	}
+	return parameterErrors
+}
+
+func (validator *Validator) validateParameter(ctx *fasthttp.RequestCtx, parameter *Parameter) error {
+	schema := validator.document.ResolveSchema(parameter.Schema)
+	if schema == nil {
+		return nil
+	}
+
+	var values []string
+	switch parameter.In {
+	case PARAMETER_IN_PATH:
+		if value, ok := ctx.UserValue(parameter.Name).(string); ok && value != "" {
+			values = append(values, value)
+		}
+	case PARAMETER_IN_QUERY:
+		queryArgs := ctx.QueryArgs()
+		if schema.Type == "array" && parameter.IsExploded() {
+			for _, value := range queryArgs.PeekMulti(parameter.Name) {
+				if len(value) != 0 {
+					values = append(values, string(value))
+				}
+			}
+		} else if value := queryArgs.Peek(parameter.Name); len(value) != 0 {
+			values = append(values, string(value))
+		}
+	default:
+		return nil
+	}
+
+	if len(values) == 0 {
+		if parameter.Required {
+			return fmt.Errorf("is required")
+		}
+		return nil
+	}
+
+	if schema.Type != "array" {
+		return validator.validateValue(schema, values[0])
+	}
+
+	if !parameter.IsExploded() {
+		values = strings.Split(values[0], ",")
+	}
+	items := validator.document.ResolveSchema(schema.Items)
+	for _, value := range values {
+		if err := validator.validateValue(items, strings.TrimSpace(value)); err != nil {
+			return fmt.Errorf("item %q: %v", value, err)
+		}
+	}
+	return nil
+}
+
+// validateValue validates the raw parameter value against a scalar schema
+func (validator *Validator) validateValue(schema *Schema, value string) error {
+	if schema == nil {
+		return nil
+	}
+
+	switch schema.Type {
+	case "integer":
+		integer, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+		if err := validateRange(schema, float64(integer)); err != nil {
+			return err
+		}
+	case "number":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("must be a number")
+		}
+		if err := validateRange(schema, number); err != nil {
+			return err
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("must be a boolean")
+		}
+	}
+
+	if len(schema.Enum) != 0 {
+		allowed := make([]string, 0, len(schema.Enum))
+		for _, enumValue := range schema.Enum {
+			if fmt.Sprint(enumValue) == value {
+				return nil
+			}
+			allowed = append(allowed, fmt.Sprint(enumValue))
+		}
+		return fmt.Errorf("must be one of: %s", strings.Join(allowed, ", "))
+	}
+
+	if schema.MinLength != nil && int64(len(value)) < *schema.MinLength {
+		return fmt.Errorf("must be at least %d characters long", *schema.MinLength)
+	}
+
+	if schema.Pattern != "" {
+		if !validator.pattern(schema.Pattern).MatchString(value) {
+			return fmt.Errorf("must match pattern %s", schema.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func validateRange(schema *Schema, value float64) error {
+	if schema.Minimum != nil && value < *schema.Minimum {
+		return fmt.Errorf("must be greater than or equal to %v", *schema.Minimum)
+	}
+	if schema.Maximum != nil && value > *schema.Maximum {
+		return fmt.Errorf("must be less than or equal to %v", *schema.Maximum)
+	}
+	return nil
+}
+
+// compilePatterns compiles the parameter patterns of the operation ahead of the requests, so that
+// the patterns are only read while serving
+func (validator *Validator) compilePatterns(operation *Operation) {
+	for i := range operation.Parameters {
+		schema := validator.document.ResolveSchema(operation.Parameters[i].Schema)
+		if schema != nil && schema.Type == "array" {
+			schema = validator.document.ResolveSchema(schema.Items)
+		}
+		if schema == nil || schema.Pattern == "" {
+			continue
+		}
+		if _, ok := validator.patterns[schema.Pattern]; !ok {
+			validator.patterns[schema.Pattern] = regexp.MustCompile(schema.Pattern)
+		}
+	}
+}
+
+func (validator *Validator) pattern(expression string) *regexp.Regexp {
+	if pattern, ok := validator.patterns[expression]; ok {
+		return pattern
+	}
+	return regexp.MustCompile(expression)
+}
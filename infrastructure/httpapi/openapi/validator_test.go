@@ -0,0 +1,133 @@
+package openapi_test
+
+import (
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/openapi"
+)
+
+var _ = Describe("Validator", func() {
+	var validator *openapi.Validator
+	var handled bool
+	var handler fasthttp.RequestHandler
+
+	minimum := float64(1)
+	explode := true
+	noExplode := false
+
+	BeforeEach(func() {
+		document := openapi.NewDocument(openapi.Info{Title: "Test", Version: "v1"}, "/prefix")
+		document.AddOperation("GET", "/api/v1/blocks/{height}", &openapi.Operation{
+			OperationId: "test",
+			Parameters: []openapi.Parameter{
+				{
+					Name:     "height",
+					In:       openapi.PARAMETER_IN_PATH,
+					Required: true,
+					Schema:   &openapi.Schema{Type: "integer", Minimum: &minimum},
+				},
+				{
+					Name:   "order",
+					In:     openapi.PARAMETER_IN_QUERY,
+					Schema: &openapi.Schema{Type: "string", Enum: []interface{}{"height", "height.desc"}},
+				},
+				{
+					Name:    "filter.msgType",
+					In:      openapi.PARAMETER_IN_QUERY,
+					Explode: &noExplode,
+					Schema: &openapi.Schema{
+						Type:  "array",
+						Items: &openapi.Schema{Type: "string", Pattern: "^Msg[A-Za-z]+$"},
+					},
+				},
+				{
+					Name:    "sort",
+					In:      openapi.PARAMETER_IN_QUERY,
+					Explode: &explode,
+					Schema: &openapi.Schema{
+						Type:  "array",
+						Items: &openapi.Schema{Type: "string", Enum: []interface{}{"power", "power.desc"}},
+					},
+				},
+				{
+					Name:     "keyword",
+					In:       openapi.PARAMETER_IN_QUERY,
+					Required: true,
+					Schema:   &openapi.Schema{Type: "string"},
+				},
+			},
+		})
+		validator = openapi.NewValidator(document, "/prefix")
+
+		handled = false
+		handler = validator.Middleware("GET", "/prefix/api/v1/blocks/{height}", func(ctx *fasthttp.RequestCtx) {
+			handled = true
+		})
+	})
+
+	serve := func(height string, query string) (*fasthttp.RequestCtx, *httpapi.InvalidParametersResponse) {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.SetRequestURI("/prefix/api/v1/blocks/" + height + "?" + query)
+		ctx.SetUserValue("height", height)
+
+		handler(ctx)
+
+		if ctx.Response.StatusCode() != fasthttp.StatusBadRequest {
+			return ctx, nil
+		}
+		var response httpapi.InvalidParametersResponse
+		Expect(jsoniter.Unmarshal(ctx.Response.Body(), &response)).To(Succeed())
+		return ctx, &response
+	}
+
+	It("should pass valid requests to the handler", func() {
+		_, response := serve("10", "keyword=a&order=height.desc&filter.msgType=MsgSend,MsgVote&sort=power&sort=power.desc")
+
+		Expect(response).To(BeNil())
+		Expect(handled).To(BeTrue())
+	})
+
+	It("should ignore undocumented parameters", func() {
+		_, response := serve("10", "keyword=a&unknown=1")
+
+		Expect(response).To(BeNil())
+		Expect(handled).To(BeTrue())
+	})
+
+	It("should reject invalid parameters with every error", func() {
+		ctx, response := serve("0", "order=id&filter.msgType=MsgSend,send&sort=power&sort=commission")
+
+		Expect(handled).To(BeFalse())
+		Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusBadRequest))
+		Expect(response.Err).To(Equal(httpapi.ErrInvalidParameters.Error()))
+		Expect(response.Details).To(Equal([]httpapi.ParameterError{
+			{In: "path", Name: "height", Message: "must be greater than or equal to 1"},
+			{In: "query", Name: "order", Message: "must be one of: height, height.desc"},
+			{In: "query", Name: "filter.msgType", Message: `item "send": must match pattern ^Msg[A-Za-z]+$`},
+			{In: "query", Name: "sort", Message: `item "commission": must be one of: power, power.desc`},
+			{In: "query", Name: "keyword", Message: "is required"},
+		}))
+	})
+
+	It("should reject non-integer values of integer parameters", func() {
+		_, response := serve("abc", "keyword=a")
+
+		Expect(handled).To(BeFalse())
+		Expect(response.Details).To(Equal([]httpapi.ParameterError{
+			{In: "path", Name: "height", Message: "must be an integer"},
+		}))
+	})
+
+	It("should not wrap the handler of undocumented routes", func() {
+		undocumented := validator.Middleware("GET", "/prefix/api/v1/unknown", func(ctx *fasthttp.RequestCtx) {
+			handled = true
+		})
+
+		undocumented(&fasthttp.RequestCtx{})
+		Expect(handled).To(BeTrue())
+	})
+})
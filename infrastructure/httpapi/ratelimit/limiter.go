@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter keeps a token bucket per client identity, e.g. an API key or an IP address
+type Limiter struct {
+	mutex   sync.Mutex
+	buckets map[string]*limiterBucket
+
+	lastEvictedAt time.Time
+	evictInterval time.Duration
+}
+
+type limiterBucket struct {
+	tierName string
+	bucket   *TokenBucket
+}
+
+// Result is the outcome of a rate limited request
+type Result struct {
+	IsAllowed bool
+	Limit     int
+	Remaining int
+	// Duration to wait before retrying when the request is not allowed
+	RetryAfter time.Duration
+}
+
+func NewLimiter(evictInterval time.Duration) *Limiter {
+	return &Limiter{
+		buckets: make(map[string]*limiterBucket),
+
+		evictInterval: evictInterval,
+	}
+}
+
+// Allow takes a token from the bucket of the identity. The bucket is recreated when the tier of
+// the identity changes.
+func (limiter *Limiter) Allow(identity string, tier Tier, now time.Time) Result {
+	if tier.IsUnlimited() {
+		return Result{
+			IsAllowed: true,
+		}
+	}
+
+	limiter.mutex.Lock()
+	limiter.evictFull(now)
+	entry, ok := limiter.buckets[identity]
+	if !ok || entry.tierName != tier.Name {
+		entry = &limiterBucket{
+			tierName: tier.Name,
+			bucket:   NewTokenBucket(tier, now),
+		}
+		limiter.buckets[identity] = entry
+	}
+	limiter.mutex.Unlock()
+
+	isAllowed, retryAfter := entry.bucket.Take(now)
+	return Result{
+		IsAllowed:  isAllowed,
+		Limit:      tier.Burst,
+		Remaining:  entry.bucket.Remaining(),
+		RetryAfter: retryAfter,
+	}
+}
+
+// Size returns the number of buckets tracked
+func (limiter *Limiter) Size() int {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	return len(limiter.buckets)
+}
+
+// evictFull periodically drops the buckets which have refilled, since they are equivalent to new
+// buckets. This bounds the memory to the clients active within the interval. Caller must hold the
+// mutex.
+func (limiter *Limiter) evictFull(now time.Time) {
+	if now.Sub(limiter.lastEvictedAt) < limiter.evictInterval {
+		return
+	}
+	limiter.lastEvictedAt = now
+
+	for identity, entry := range limiter.buckets {
+		if entry.bucket.IsFull(now) {
+			delete(limiter.buckets, identity)
+		}
+	}
+}
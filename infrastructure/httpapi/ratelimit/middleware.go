@@ -0,0 +1,202 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/apikey"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_API_KEY_HEADER = "X-API-Key"
+
+const (
+	HEADER_RATE_LIMIT_LIMIT     = "X-RateLimit-Limit"
+	HEADER_RATE_LIMIT_REMAINING = "X-RateLimit-Remaining"
+)
+
+// Buckets idle for longer than this are evicted once they have refilled
+const BUCKET_EVICT_INTERVAL = time.Minute
+
+type Config struct {
+	Tiers map[string]Tier
+	// Tier applied per IP address to requests without an API key, and to API keys of unknown tiers
+	AnonymousTier string
+	// Reject requests without an API key
+	RequireAPIKey bool
+	APIKeyHeader  string
+	// Use the first address of the X-Forwarded-For header as the client IP. Enable it only behind a
+	// trusted proxy, otherwise clients can spoof their IP.
+	TrustForwardedFor bool
+}
+
+// KeyResolver resolves a key to an active API key. It returns apikey.ErrInvalidKey when the key is
+// unknown or revoked.
+type KeyResolver interface {
+	// ResolveCached returns the active API key of the key when it is resolved without querying the
+	// store, nil otherwise
+	ResolveCached(key string) *apikey.APIKey
+	Resolve(key string) (*apikey.APIKey, error)
+}
+
+type UsageRecorder interface {
+	Record(apiKeyId int64, isRejected bool)
+}
+
+// Middleware authenticates optional API keys and enforces token bucket limits per API key, or per
+// IP address for requests without an API key
+type Middleware struct {
+	logger applogger.Logger
+
+	config   Config
+	resolver KeyResolver
+	recorder UsageRecorder
+	limiter  *Limiter
+
+	nowFn func() time.Time
+}
+
+func NewMiddleware(
+	logger applogger.Logger,
+	config Config,
+	resolver KeyResolver,
+	recorder UsageRecorder,
+) (*Middleware, error) {
+	if _, ok := config.Tiers[config.AnonymousTier]; !ok {
+		return nil, fmt.Errorf("anonymous tier %s is not configured", config.AnonymousTier)
+	}
+	for name, tier := range config.Tiers {
+		if tier.Name != name {
+			return nil, fmt.Errorf("tier %s is configured with mismatched name %s", name, tier.Name)
+		}
+		if !tier.IsUnlimited() && tier.Burst < 1 {
+			return nil, fmt.Errorf("tier %s must have a burst of at least 1", name)
+		}
+	}
+	if config.APIKeyHeader == "" {
+		config.APIKeyHeader = DEFAULT_API_KEY_HEADER
+	}
+
+	return &Middleware{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "RateLimitMiddleware",
+		}),
+
+		config:   config,
+		resolver: resolver,
+		recorder: recorder,
+		limiter:  NewLimiter(BUCKET_EVICT_INTERVAL),
+
+		nowFn: time.Now,
+	}, nil
+}
+
+// WithNowFn overrides the clock of the token buckets
+func (middleware *Middleware) WithNowFn(nowFn func() time.Time) *Middleware {
+	middleware.nowFn = nowFn
+	return middleware
+}
+
+// Handler is a httpapi.Middleware
+func (middleware *Middleware) Handler(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		// CORS preflight requests carry no credentials
+		if ctx.IsOptions() {
+			next(ctx)
+			return
+		}
+
+		var identity string
+		var tier Tier
+		var maybeAPIKey *apikey.APIKey
+		if key := strings.TrimSpace(string(ctx.Request.Header.Peek(middleware.config.APIKeyHeader))); key != "" {
+			apiKey := middleware.resolver.ResolveCached(key)
+			if apiKey == nil {
+				// Keys not known to be valid are charged to the client IP before they are resolved, so
+				// that random keys neither bypass the limit of the IP nor query the store unthrottled
+				ipTier := middleware.config.Tiers[middleware.config.AnonymousTier]
+				if !middleware.allow(ctx, "ip:"+middleware.clientIP(ctx), ipTier) {
+					return
+				}
+
+				var err error
+				apiKey, err = middleware.resolver.Resolve(key)
+				if err != nil {
+					if errors.Is(err, apikey.ErrInvalidKey) {
+						httpapi.Unauthorized(ctx)
+						return
+					}
+					middleware.logger.Errorf("error resolving API key: %v", err)
+					httpapi.InternalServerError(ctx)
+					return
+				}
+			}
+
+			maybeAPIKey = apiKey
+			identity = "key:" + strconv.FormatInt(apiKey.Id, 10)
+			tier = middleware.tierOf(apiKey)
+		} else {
+			if middleware.config.RequireAPIKey {
+				httpapi.Unauthorized(ctx)
+				return
+			}
+
+			identity = "ip:" + middleware.clientIP(ctx)
+			tier = middleware.config.Tiers[middleware.config.AnonymousTier]
+		}
+
+		isAllowed := middleware.allow(ctx, identity, tier)
+		if maybeAPIKey != nil && middleware.recorder != nil {
+			middleware.recorder.Record(maybeAPIKey.Id, !isAllowed)
+		}
+		if !isAllowed {
+			return
+		}
+
+		next(ctx)
+	}
+}
+
+// allow takes a token from the bucket of the identity and sets the rate limit headers. It responds
+// status 429 and returns false when the bucket is empty.
+func (middleware *Middleware) allow(ctx *fasthttp.RequestCtx, identity string, tier Tier) bool {
+	result := middleware.limiter.Allow(identity, tier, middleware.nowFn())
+	if !tier.IsUnlimited() {
+		ctx.Response.Header.Set(HEADER_RATE_LIMIT_LIMIT, strconv.Itoa(result.Limit))
+		ctx.Response.Header.Set(HEADER_RATE_LIMIT_REMAINING, strconv.Itoa(result.Remaining))
+	}
+	if !result.IsAllowed {
+		httpapi.TooManyRequests(ctx, int64(math.Max(1, math.Ceil(result.RetryAfter.Seconds()))))
+		return false
+	}
+	return true
+}
+
+func (middleware *Middleware) tierOf(apiKey *apikey.APIKey) Tier {
+	if tier, ok := middleware.config.Tiers[apiKey.Tier]; ok {
+		return tier
+	}
+
+	middleware.logger.Errorf(
+		"API key %d has unknown tier %s, falling back to %s", apiKey.Id, apiKey.Tier, middleware.config.AnonymousTier,
+	)
+	return middleware.config.Tiers[middleware.config.AnonymousTier]
+}
+
+func (middleware *Middleware) clientIP(ctx *fasthttp.RequestCtx) string {
+	if middleware.config.TrustForwardedFor {
+		forwardedFor := string(ctx.Request.Header.Peek("X-Forwarded-For"))
+		if forwardedFor != "" {
+			return strings.TrimSpace(strings.SplitN(forwardedFor, ",", 2)[0])
+		}
+	}
+
+	return ctx.RemoteIP().String()
+}
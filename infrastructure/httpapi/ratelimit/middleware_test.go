@@ -0,0 +1,183 @@
+package ratelimit_test
+
+import (
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/apikey"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/ratelimit"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("Middleware", func() {
+	const anyKey = "ci_anykey"
+	anyTime := time.Unix(1600000000, 0)
+	anyConfig := ratelimit.Config{
+		Tiers: map[string]ratelimit.Tier{
+			"anonymous": {
+				Name:              "anonymous",
+				RequestsPerSecond: 1,
+				Burst:             1,
+			},
+			"standard": {
+				Name:              "standard",
+				RequestsPerSecond: 1,
+				Burst:             2,
+			},
+		},
+		AnonymousTier: "anonymous",
+	}
+
+	var resolver *fakeResolver
+	var recorder *fakeRecorder
+	BeforeEach(func() {
+		resolver = &fakeResolver{
+			apiKeys: map[string]*apikey.APIKey{
+				anyKey: {
+					Id:   1,
+					Tier: "standard",
+				},
+			},
+			resolvedKeys: make(map[string]bool),
+		}
+		recorder = &fakeRecorder{}
+	})
+
+	newHandler := func(config ratelimit.Config) fasthttp.RequestHandler {
+		middleware, err := ratelimit.NewMiddleware(NewFakeLogger(), config, resolver, recorder)
+		Expect(err).To(BeNil())
+
+		return middleware.WithNowFn(func() time.Time {
+			return anyTime
+		}).Handler(func(ctx *fasthttp.RequestCtx) {
+			ctx.SetStatusCode(fasthttp.StatusOK)
+		})
+	}
+	serve := func(handler fasthttp.RequestHandler, ip string, maybeKey string) *fasthttp.RequestCtx {
+		var request fasthttp.Request
+		request.SetRequestURI("/api/v1/blocks")
+		if maybeKey != "" {
+			request.Header.Set(ratelimit.DEFAULT_API_KEY_HEADER, maybeKey)
+		}
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Init(&request, &net.TCPAddr{IP: net.ParseIP(ip)}, nil)
+
+		handler(ctx)
+		return ctx
+	}
+
+	It("should reject configuration without the anonymous tier", func() {
+		config := anyConfig
+		config.AnonymousTier = "missing"
+
+		_, err := ratelimit.NewMiddleware(NewFakeLogger(), config, resolver, recorder)
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should limit requests without API key per IP", func() {
+		handler := newHandler(anyConfig)
+
+		ctx := serve(handler, "1.1.1.1", "")
+		Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+		Expect(string(ctx.Response.Header.Peek(ratelimit.HEADER_RATE_LIMIT_LIMIT))).To(Equal("1"))
+		Expect(string(ctx.Response.Header.Peek(ratelimit.HEADER_RATE_LIMIT_REMAINING))).To(Equal("0"))
+
+		ctx = serve(handler, "1.1.1.1", "")
+		Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusTooManyRequests))
+		Expect(string(ctx.Response.Header.Peek("Retry-After"))).To(Equal("1"))
+
+		ctx = serve(handler, "2.2.2.2", "")
+		Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+	})
+
+	It("should limit requests with API key by the tier of the key and record usages", func() {
+		handler := newHandler(anyConfig)
+
+		Expect(serve(handler, "1.1.1.1", anyKey).Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+		Expect(serve(handler, "2.2.2.2", anyKey).Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+		Expect(serve(handler, "3.3.3.3", anyKey).Response.StatusCode()).To(Equal(fasthttp.StatusTooManyRequests))
+
+		Expect(recorder.records).To(Equal([]fakeRecord{
+			{1, false},
+			{1, false},
+			{1, true},
+		}))
+	})
+
+	It("should reject invalid API key", func() {
+		handler := newHandler(anyConfig)
+
+		ctx := serve(handler, "1.1.1.1", "ci_invalid")
+		Expect(ctx.Response.StatusCode()).To(Equal(fasthttp.StatusUnauthorized))
+	})
+
+	It("should limit invalid API keys per IP before resolving them", func() {
+		handler := newHandler(anyConfig)
+
+		Expect(serve(handler, "1.1.1.1", "ci_invalid").Response.StatusCode()).To(Equal(fasthttp.StatusUnauthorized))
+		Expect(serve(handler, "1.1.1.1", "ci_another_invalid").Response.StatusCode()).To(
+			Equal(fasthttp.StatusTooManyRequests),
+		)
+		Expect(resolver.resolveCount).To(Equal(1))
+
+		Expect(serve(handler, "2.2.2.2", "ci_invalid").Response.StatusCode()).To(Equal(fasthttp.StatusUnauthorized))
+	})
+
+	It("should not charge the IP of cached API keys", func() {
+		handler := newHandler(anyConfig)
+
+		Expect(serve(handler, "1.1.1.1", anyKey).Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+		Expect(serve(handler, "1.1.1.1", anyKey).Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+		Expect(resolver.resolveCount).To(Equal(1))
+	})
+
+	It("should reject requests without API key when API key is required", func() {
+		config := anyConfig
+		config.RequireAPIKey = true
+		handler := newHandler(config)
+
+		Expect(serve(handler, "1.1.1.1", "").Response.StatusCode()).To(Equal(fasthttp.StatusUnauthorized))
+		Expect(serve(handler, "1.1.1.1", anyKey).Response.StatusCode()).To(Equal(fasthttp.StatusOK))
+	})
+})
+
+// fakeResolver caches the valid keys once resolved
+type fakeResolver struct {
+	apiKeys      map[string]*apikey.APIKey
+	resolvedKeys map[string]bool
+	resolveCount int
+}
+
+func (resolver *fakeResolver) ResolveCached(key string) *apikey.APIKey {
+	if !resolver.resolvedKeys[key] {
+		return nil
+	}
+	return resolver.apiKeys[key]
+}
+
+func (resolver *fakeResolver) Resolve(key string) (*apikey.APIKey, error) {
+	resolver.resolveCount += 1
+	apiKey, ok := resolver.apiKeys[key]
+	if !ok {
+		return nil, apikey.ErrInvalidKey
+	}
+	resolver.resolvedKeys[key] = true
+	return apiKey, nil
+}
+
+type fakeRecord struct {
+	apiKeyId   int64
+	isRejected bool
+}
+
+type fakeRecorder struct {
+	records []fakeRecord
+}
+
+func (recorder *fakeRecorder) Record(apiKeyId int64, isRejected bool) {
+	recorder.records = append(recorder.records, fakeRecord{apiKeyId, isRejected})
+}
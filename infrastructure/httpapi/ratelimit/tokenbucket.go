@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Tier is the token bucket configuration shared by a class of clients. A tier with zero
+// RequestsPerSecond is unlimited.
+type Tier struct {
+	Name string
+	// Rate at which tokens are refilled
+	RequestsPerSecond float64
+	// Maximum number of tokens, which is the number of requests allowed in a burst
+	Burst int
+}
+
+func (tier Tier) IsUnlimited() bool {
+	return tier.RequestsPerSecond <= 0
+}
+
+// TokenBucket is a token bucket rate limiter. It starts full.
+type TokenBucket struct {
+	requestsPerSecond float64
+	burst             float64
+
+	mutex     sync.Mutex
+	tokens    float64
+	updatedAt time.Time
+}
+
+func NewTokenBucket(tier Tier, now time.Time) *TokenBucket {
+	burst := math.Max(float64(tier.Burst), 1)
+	return &TokenBucket{
+		requestsPerSecond: tier.RequestsPerSecond,
+		burst:             burst,
+
+		tokens:    burst,
+		updatedAt: now,
+	}
+}
+
+// Take takes a token from the bucket. When the bucket is empty, it returns false and the duration
+// until a token is available.
+func (bucket *TokenBucket) Take(now time.Time) (bool, time.Duration) {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.refill(now)
+	if bucket.tokens >= 1 {
+		bucket.tokens -= 1
+		return true, 0
+	}
+
+	missingTokens := 1 - bucket.tokens
+	return false, time.Duration(missingTokens / bucket.requestsPerSecond * float64(time.Second))
+}
+
+// Remaining returns the number of whole tokens left in the bucket
+func (bucket *TokenBucket) Remaining() int {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	return int(bucket.tokens)
+}
+
+// IsFull returns true when the bucket has refilled completely, in which case it is equivalent to a
+// new bucket
+func (bucket *TokenBucket) IsFull(now time.Time) bool {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.refill(now)
+	return bucket.tokens >= bucket.burst
+}
+
+func (bucket *TokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(bucket.updatedAt)
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens = math.Min(bucket.burst, bucket.tokens+elapsed.Seconds()*bucket.requestsPerSecond)
+	bucket.updatedAt = now
+}
@@ -0,0 +1,91 @@
+package ratelimit_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/ratelimit"
+)
+
+var _ = Describe("TokenBucket", func() {
+	anyTier := ratelimit.Tier{
+		Name:              "standard",
+		RequestsPerSecond: 2,
+		Burst:             3,
+	}
+	anyTime := time.Unix(1600000000, 0)
+
+	It("should allow requests up to the burst", func() {
+		bucket := ratelimit.NewTokenBucket(anyTier, anyTime)
+
+		for i := 0; i < 3; i++ {
+			isAllowed, _ := bucket.Take(anyTime)
+			Expect(isAllowed).To(BeTrue())
+		}
+		isAllowed, retryAfter := bucket.Take(anyTime)
+		Expect(isAllowed).To(BeFalse())
+		Expect(retryAfter).To(Equal(500 * time.Millisecond))
+	})
+
+	It("should refill tokens at the tier rate up to the burst", func() {
+		bucket := ratelimit.NewTokenBucket(anyTier, anyTime)
+		for i := 0; i < 3; i++ {
+			bucket.Take(anyTime)
+		}
+
+		isAllowed, _ := bucket.Take(anyTime.Add(500 * time.Millisecond))
+		Expect(isAllowed).To(BeTrue())
+		isAllowed, _ = bucket.Take(anyTime.Add(500 * time.Millisecond))
+		Expect(isAllowed).To(BeFalse())
+
+		Expect(bucket.IsFull(anyTime.Add(time.Hour))).To(BeTrue())
+		Expect(bucket.Remaining()).To(Equal(3))
+	})
+})
+
+var _ = Describe("Limiter", func() {
+	anyTier := ratelimit.Tier{
+		Name:              "standard",
+		RequestsPerSecond: 1,
+		Burst:             1,
+	}
+	anyTime := time.Unix(1600000000, 0)
+
+	It("should limit each identity independently", func() {
+		limiter := ratelimit.NewLimiter(time.Minute)
+
+		Expect(limiter.Allow("ip:1.1.1.1", anyTier, anyTime).IsAllowed).To(BeTrue())
+		Expect(limiter.Allow("ip:1.1.1.1", anyTier, anyTime).IsAllowed).To(BeFalse())
+		Expect(limiter.Allow("ip:2.2.2.2", anyTier, anyTime).IsAllowed).To(BeTrue())
+	})
+
+	It("should recreate the bucket when the tier of the identity changes", func() {
+		limiter := ratelimit.NewLimiter(time.Minute)
+		anotherTier := anyTier
+		anotherTier.Name = "premium"
+
+		Expect(limiter.Allow("key:1", anyTier, anyTime).IsAllowed).To(BeTrue())
+		Expect(limiter.Allow("key:1", anotherTier, anyTime).IsAllowed).To(BeTrue())
+	})
+
+	It("should not track unlimited tier", func() {
+		limiter := ratelimit.NewLimiter(time.Minute)
+
+		result := limiter.Allow("key:1", ratelimit.Tier{Name: "unlimited"}, anyTime)
+		Expect(result.IsAllowed).To(BeTrue())
+		Expect(limiter.Size()).To(Equal(0))
+	})
+
+	It("should evict refilled buckets", func() {
+		limiter := ratelimit.NewLimiter(time.Minute)
+
+		limiter.Allow("ip:1.1.1.1", anyTier, anyTime)
+		limiter.Allow("ip:2.2.2.2", anyTier, anyTime)
+		Expect(limiter.Size()).To(Equal(2))
+
+		limiter.Allow("ip:3.3.3.3", anyTier, anyTime.Add(2*time.Minute))
+		Expect(limiter.Size()).To(Equal(1))
+	})
+})
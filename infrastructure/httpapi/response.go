@@ -1,6 +1,8 @@
 package httpapi
 
 import (
+	"strconv"
+
 	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
 	"github.com/valyala/fasthttp"
 
@@ -64,6 +66,54 @@ func BadRequest(ctx *fasthttp.RequestCtx, errResp error) {
 	ctx.SetBody(message)
 }
 
+// InvalidParameters responds with status 400 and the details of every invalid request parameter
+func InvalidParameters(ctx *fasthttp.RequestCtx, parameterErrors []ParameterError) {
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	message, err := jsoniter.Marshal(InvalidParametersResponse{
+		Response: Response{
+			Err: ErrInvalidParameters.Error(),
+		},
+		Details: parameterErrors,
+	})
+	if err != nil {
+		InternalServerError(ctx)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusBadRequest)
+	ctx.SetBody(message)
+}
+
+func Unauthorized(ctx *fasthttp.RequestCtx) {
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	message, err := jsoniter.Marshal(Response{
+		Err: ErrUnauthorized.Error(),
+	})
+	if err != nil {
+		InternalServerError(ctx)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+	ctx.SetBody(message)
+}
+
+// TooManyRequests responds with status 429 and the number of seconds to wait before retrying
+func TooManyRequests(ctx *fasthttp.RequestCtx, retryAfterSeconds int64) {
+	ctx.Response.Header.Set("Content-Type", "application/json")
+	ctx.Response.Header.Set("Retry-After", strconv.FormatInt(retryAfterSeconds, 10))
+	message, err := jsoniter.Marshal(Response{
+		Err: ErrTooManyRequests.Error(),
+	})
+	if err != nil {
+		InternalServerError(ctx)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusTooManyRequests)
+	ctx.SetBody(message)
+}
+
 func InternalServerError(ctx *fasthttp.RequestCtx) {
 	ctx.Response.Header.Set("Content-Type", "application/json")
 	message, _ := jsoniter.Marshal(Response{
@@ -85,6 +135,19 @@ type Response struct {
 	Err    string      `json:"error,omitempty"`
 }
 
+type InvalidParametersResponse struct {
+	Response
+
+	Details []ParameterError `json:"details"`
+}
+
+type ParameterError struct {
+	// Location of the parameter, either "path" or "query"
+	In      string `json:"in"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
 type PaginationOffsetResponse struct {
 	TotalRecord int64 `json:"total_record"`
 	TotalPage   int64 `json:"total_page"`
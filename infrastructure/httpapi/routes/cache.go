@@ -0,0 +1,43 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/cache"
+)
+
+// Number of leading pages cached for the paginated routes
+const CACHED_PAGES = 3
+
+// Age of the cached responses also computed from the Cosmos app or the Tendermint status, which are
+// not tracked by the projection heights
+const CHAIN_DATA_MAX_AGE = 5 * time.Second
+
+// CacheRules returns the routes served from the response cache and the projections their
+// responses are computed from. A route also computed from data outside of the projections is cached
+// for at most CHAIN_DATA_MAX_AGE, e.g. `/api/v1/status` responds the latest chain height polled from
+// Tendermint and the total bonded balance of the Cosmos app, and `/api/v1/validators` responds the
+// APY computed from the Cosmos app.
+func CacheRules() map[string]cache.Rule {
+	return map[string]cache.Rule{
+		"/api/v1/status": {
+			Projections: []string{"Block", "Transaction", "Validator", "ValidatorStats", "ChainStats"},
+			MaxAge:      CHAIN_DATA_MAX_AGE,
+		},
+		"/api/v1/validators": {
+			Projections: []string{"Validator"},
+			MaxAge:      CHAIN_DATA_MAX_AGE,
+		},
+		"/api/v1/validators/active": {
+			Projections: []string{"Validator"},
+		},
+		"/api/v1/blocks": {
+			Projections: []string{"Block"},
+			MaxPage:     CACHED_PAGES,
+		},
+		"/api/v1/transactions": {
+			Projections: []string{"Transaction"},
+			MaxPage:     CACHED_PAGES,
+		},
+	}
+}
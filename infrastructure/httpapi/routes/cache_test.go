@@ -0,0 +1,39 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/routes"
+)
+
+var _ = Describe("CacheRules", func() {
+	It("should only cache registered GET routes", func() {
+		server := httpapi.NewServer("")
+		registry := routes.NewRoutesRegistry(
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			&handlers.OpenAPI{},
+			nil,
+		)
+		registry.Register(server, "/")
+
+		registeredGETPaths := make(map[string]bool)
+		for _, route := range server.Routes() {
+			if route.Method == "GET" {
+				registeredGETPaths[route.Path] = true
+			}
+		}
+		for path, rule := range routes.CacheRules() {
+			Expect(registeredGETPaths).To(HaveKey(path))
+			Expect(rule.Projections).NotTo(BeEmpty())
+		}
+	})
+
+	It("should bound the age of the routes computed from the chain", func() {
+		Expect(routes.CacheRules()["/api/v1/status"].MaxAge).To(Equal(routes.CHAIN_DATA_MAX_AGE))
+		Expect(routes.CacheRules()["/api/v1/validators"].MaxAge).To(Equal(routes.CHAIN_DATA_MAX_AGE))
+		Expect(routes.CacheRules()["/api/v1/blocks"].MaxAge).To(BeZero())
+	})
+})
@@ -0,0 +1,662 @@
+package routes
+
+import (
+	"strings"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/infrastructure/accountexport"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/openapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/search"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	account_view "github.com/crypto-com/chain-indexing/projection/account/view"
+	account_message_view "github.com/crypto-com/chain-indexing/projection/account_message/view"
+	account_transaction_view "github.com/crypto-com/chain-indexing/projection/account_transaction/view"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	blockevent_view "github.com/crypto-com/chain-indexing/projection/blockevent/view"
+	community_pool_view "github.com/crypto-com/chain-indexing/projection/community_pool/view"
+	multisig_account_view "github.com/crypto-com/chain-indexing/projection/multisig_account/view"
+	nft_view "github.com/crypto-com/chain-indexing/projection/nft/view"
+	proposal_view "github.com/crypto-com/chain-indexing/projection/proposal/view"
+	supply_view "github.com/crypto-com/chain-indexing/projection/supply/view"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	validator_view "github.com/crypto-com/chain-indexing/projection/validator/view"
+)
+
+const (
+	TAG_ACCOUNTS      = "Accounts"
+	TAG_BLOCKS        = "Blocks"
+	TAG_TRANSACTIONS  = "Transactions"
+	TAG_VALIDATORS    = "Validators"
+	TAG_PROPOSALS     = "Proposals"
+	TAG_NFTS          = "NFTs"
+	TAG_CHAIN         = "Chain"
+	TAG_GRAPHQL       = "GraphQL"
+	TAG_DOCUMENTATION = "Documentation"
+)
+
+// NewOpenAPIDocument returns the OpenAPI specification of the routes registered by the
+// RouteRegistry with the projections. Every registered route must be documented here, or the routes
+// test fails.
+func NewOpenAPIDocument(routePrefix string, withGraphQL bool, projections view.Projections) *openapi.Document {
+	serverURL := routePrefix
+	if serverURL == "" {
+		serverURL = "/"
+	}
+
+	document := openapi.NewDocument(openapi.Info{
+		Title:       "Chain Indexing API",
+		Description: "Query interface of the data indexed from the chain",
+		Version:     "v1",
+	}, serverURL)
+	spec := &specBuilder{
+		document:    document,
+		projections: projections,
+		schemas: openapi.NewSchemaGenerator(document).WithOverride(utctime.UTCTime{}, &openapi.Schema{
+			Type:   "string",
+			Format: "date-time",
+		}),
+	}
+
+	spec.find("/api/v1/health", "health", "Health check with the leases of the sync and the projections",
+		TAG_CHAIN, handlers.HealthStatus{},
+	)
+	spec.list("/api/v1/search", "search", "Search blocks, transactions, validators, accounts and full-text matches",
+		TAG_CHAIN, handlers.SearchResults{},
+		queryParameter("keyword", "Keyword to search", stringSchema()),
+		listQueryParameter("filter.type", "Result types of the full-text matches", enumSchema(
+			search.RESULT_TYPE_TRANSACTION,
+			search.RESULT_TYPE_VALIDATOR,
+			search.RESULT_TYPE_PROPOSAL,
+			search.RESULT_TYPE_NFT_DENOM,
+			search.RESULT_TYPE_NFT_TOKEN,
+		)),
+	)
+
+	spec.list("/api/v1/accounts", "listAccounts", "List accounts",
+		TAG_ACCOUNTS, []account_view.AccountRow{},
+		orderParameter("address", "address.desc"),
+	)
+	spec.find("/api/v1/accounts/{account}", "findAccount", "Find account with its balances",
+		TAG_ACCOUNTS, handlers.AccountInfo{},
+		accountParameter(),
+		queryParameter("vesting.time", "Unix time in seconds of the vesting amounts", integerSchema(nil)),
+		queryParameter("vesting.height", "Block height of the vesting amounts", integerSchema(minimum(1))),
+	)
+	spec.list("/api/v1/accounts/{account}/transactions", "listAccountTransactions", "List transactions of account",
+		TAG_ACCOUNTS, []account_transaction_view.AccountTransactionReadRow{},
+		accountParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/accounts/{account}/messages", "listAccountMessages", "List messages of account",
+		TAG_ACCOUNTS, []account_message_view.AccountMessageRow{},
+		accountParameter(),
+		msgTypeParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.get("/api/v1/accounts/{account}/export", &openapi.Operation{
+		OperationId: "exportAccount",
+		Summary:     "Export balance-affecting items of account as CSV or XLSX",
+		Tags:        []string{TAG_ACCOUNTS},
+		Parameters: []openapi.Parameter{
+			accountParameter(),
+			queryParameter("format", "File format. Defaults to csv", enumSchema(accountexport.FORMATS...)),
+			queryParameter("from", "Inclusive start block time in RFC3339 or date (YYYY-MM-DD)", stringSchema()),
+			queryParameter("to", "Block time end in RFC3339 or date (YYYY-MM-DD). A date includes the whole day",
+				stringSchema(),
+			),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "Ledger file with a header row of " + strings.Join(accountexport.COLUMNS, ", "),
+				Content: map[string]openapi.MediaType{
+					"text/csv": {Schema: &openapi.Schema{Type: "string"}},
+					"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": {Schema: &openapi.Schema{
+						Type:   "string",
+						Format: "binary",
+					}},
+				},
+			},
+			"400": spec.errorResponse("Invalid request parameters", httpapi.InvalidParametersResponse{}),
+		},
+	})
+	spec.find("/api/v1/accounts/{account}/multisig", "findMultisigAccount", "Find multisig account",
+		TAG_ACCOUNTS, multisig_account_view.MultisigAccountRow{},
+		accountParameter(),
+	)
+	spec.list("/api/v1/accounts/{account}/multisig/transactions", "listMultisigAccountTransactions",
+		"List transactions signed by multisig account",
+		TAG_ACCOUNTS, []multisig_account_view.MultisigAccountTransactionRow{},
+		accountParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/accounts/{account}/multisig-memberships", "listMultisigMemberships",
+		"List multisig accounts the account is a member of",
+		TAG_ACCOUNTS, []multisig_account_view.MultisigAccountRow{},
+		accountParameter(),
+	)
+	spec.find("/api/v1/pubkeys/{pubkey}", "findPubKey", "Find account and validator addresses of public key",
+		TAG_ACCOUNTS, handlers.PubKeyInfo{},
+		pathParameter("pubkey", "Public key in hex, base64 or bech32", stringSchema()),
+	)
+
+	spec.list("/api/v1/blocks", "listBlocks", "List blocks",
+		TAG_BLOCKS, []block_view.Block{},
+		orderParameter("height", "height.desc"),
+	)
+	spec.find("/api/v1/blocks/{height-or-hash}", "findBlock", "Find block by height or hash",
+		TAG_BLOCKS, block_view.Block{},
+		pathParameter("height-or-hash", "Block height or hash", stringSchema()),
+	)
+	spec.list("/api/v1/blocks/{height}/transactions", "listBlockTransactions", "List transactions of block",
+		TAG_BLOCKS, []transaction_view.TransactionRow{},
+		heightParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/blocks/{height}/events", "listBlockEvents", "List events of block",
+		TAG_BLOCKS, []blockevent_view.BlockEventRow{},
+		heightParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/blocks/{height}/commitments", "listBlockCommitments", "List validator commitments of block",
+		TAG_BLOCKS, []validator_view.ListValidatorBlockCommitmentRow{},
+		heightParameter(),
+	)
+	spec.list("/api/v1/events", "listEvents", "List block events",
+		TAG_BLOCKS, []blockevent_view.BlockEventRow{},
+		orderParameter("height", "height.desc"),
+	)
+	spec.find("/api/v1/events/{id}", "findEvent", "Find block event by id",
+		TAG_BLOCKS, blockevent_view.BlockEventRow{},
+		pathParameter("id", "Event id", integerSchema(minimum(1))),
+	)
+
+	spec.list("/api/v1/proposals", "listProposals", "List proposals",
+		TAG_PROPOSALS, []proposal_view.ProposalWithMonikerRow{},
+		queryParameter("filter.status", "Proposal status", stringSchema()),
+		orderParameter("id", "id.desc"),
+	)
+	spec.find("/api/v1/proposals/{id}", "findProposal", "Find proposal with its tally",
+		TAG_PROPOSALS, handlers.ProposalDetails{},
+		proposalIdParameter(),
+	)
+	spec.list("/api/v1/proposals/{id}/votes", "listProposalVotes", "List votes of proposal",
+		TAG_PROPOSALS, []proposal_view.VoteWithMonikerRow{},
+		proposalIdParameter(),
+		orderParameter("voteAt", "voteAt.desc"),
+	)
+	spec.list("/api/v1/proposals/{id}/depositors", "listProposalDepositors", "List depositors of proposal",
+		TAG_PROPOSALS, []proposal_view.DepositorWithMonikerRow{},
+		proposalIdParameter(),
+		orderParameter("depositAt", "depositAt.desc"),
+	)
+
+	spec.find("/api/v1/status", "getStatus", "Get chain and indexing status",
+		TAG_CHAIN, handlers.Status{},
+	)
+	spec.find("/api/v1/status/replica", "getReplicaStatus", "Get read replica lag and routing status",
+		TAG_CHAIN, handlers.ReplicaStatus{},
+	)
+
+	spec.list("/api/v1/transactions", "listTransactions", "List transactions",
+		TAG_TRANSACTIONS, []transaction_view.TransactionRow{},
+		orderParameter("height", "height.desc"),
+	)
+	spec.find("/api/v1/transactions/{hash}", "findTransaction", "Find transaction by hash",
+		TAG_TRANSACTIONS, transaction_view.TransactionRow{},
+		pathParameter("hash", "Transaction hash", stringSchema()),
+	)
+
+	spec.list("/api/v1/validators", "listValidators", "List validators with their APY",
+		TAG_VALIDATORS, []handlers.ValidatorRowWithAPY{},
+		validatorsOrderParameter(),
+	)
+	spec.list("/api/v1/validators/active", "listActiveValidators", "List active validators",
+		TAG_VALIDATORS, []validator_view.ListValidatorRow{},
+		validatorsOrderParameter(),
+	)
+	spec.find("/api/v1/validators/{address}", "findValidator", "Find validator by operator or consensus node address",
+		TAG_VALIDATORS, handlers.ValidatorDetails{},
+		validatorAddressParameter(),
+	)
+	spec.list("/api/v1/validators/{address}/activities", "listValidatorActivities", "List activities of validator",
+		TAG_VALIDATORS, []validator_view.ValidatorActivityRow{},
+		validatorAddressParameter(),
+		orderParameter("height", "height.desc"),
+	)
+
+	spec.list("/api/v1/nfts/messages", "listNFTMessages", "List NFT messages",
+		TAG_NFTS, []nft_view.MessageRow{},
+		queryParameter("filter.denomId", "Denom id", stringSchema()),
+		queryParameter("filter.tokenId", "Token id", stringSchema()),
+		queryParameter("filter.drop", "Drop", stringSchema()),
+		msgTypeParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.find("/api/v1/nfts/denom/name/{denomName}", "findNFTDenomByName", "Find NFT denom by name",
+		TAG_NFTS, nft_view.DenomRow{},
+		pathParameter("denomName", "Denom name", stringSchema()),
+	)
+	spec.find("/api/v1/nfts/denom/id/{denomId}", "findNFTDenomByIdLegacy", "Find NFT denom by id",
+		TAG_NFTS, nft_view.DenomRow{},
+		denomIdParameter(),
+	)
+	spec.list("/api/v1/nfts/denoms", "listNFTDenoms", "List NFT denoms",
+		TAG_NFTS, []nft_view.DenomRow{},
+		queryParameter("filter.creator", "Creator address", stringSchema()),
+		orderParameter("createdAt", "createdAt.desc"),
+	)
+	spec.list("/api/v1/nfts/tokens", "listNFTTokens", "List NFT tokens",
+		TAG_NFTS, []nft_view.TokenRowWithDenomname{},
+		queryParameter("filter.denomId", "Denom id", stringSchema()),
+		queryParameter("filter.drop", "Drop", stringSchema()),
+		queryParameter("filter.minter", "Minter address", stringSchema()),
+		queryParameter("filter.owner", "Owner address", stringSchema()),
+		orderParameter("mintedAt", "mintedAt.desc"),
+	)
+	spec.find("/api/v1/nfts/denoms/{denomId}", "findNFTDenom", "Find NFT denom by id",
+		TAG_NFTS, nft_view.DenomRow{},
+		denomIdParameter(),
+	)
+	spec.list("/api/v1/nfts/denoms/{denomId}/messages", "listNFTDenomMessages", "List messages of NFT denom",
+		TAG_NFTS, []nft_view.MessageRow{},
+		denomIdParameter(),
+		msgTypeParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/nfts/denoms/{denomId}/tokens", "listNFTDenomTokens", "List tokens of NFT denom",
+		TAG_NFTS, []nft_view.TokenRowWithDenomname{},
+		denomIdParameter(),
+		tokensOrderParameter(),
+	)
+	spec.find("/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}", "findNFTToken", "Find NFT token",
+		TAG_NFTS, nft_view.TokenRowWithDenomname{},
+		denomIdParameter(),
+		tokenIdParameter(),
+	)
+	spec.list("/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/transfers", "listNFTTokenTransfers",
+		"List transfers of NFT token",
+		TAG_NFTS, []nft_view.MessageRow{},
+		denomIdParameter(),
+		tokenIdParameter(),
+		orderParameter("transferredAt", "transferredAt.desc"),
+	)
+	spec.list("/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/messages", "listNFTTokenMessages",
+		"List messages of NFT token",
+		TAG_NFTS, []nft_view.MessageRow{},
+		denomIdParameter(),
+		tokenIdParameter(),
+		msgTypeParameter(),
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/nfts/drops", "listNFTDrops", "List NFT drops",
+		TAG_NFTS, []string{},
+	)
+	spec.list("/api/v1/nfts/drops/{drop}/tokens", "listNFTDropTokens", "List tokens of NFT drop",
+		TAG_NFTS, []nft_view.TokenRowWithDenomname{},
+		pathParameter("drop", "Drop", stringSchema()),
+		tokensOrderParameter(),
+	)
+	spec.list("/api/v1/nfts/accounts/{account}/tokens", "listNFTAccountTokens", "List NFT tokens owned by account",
+		TAG_NFTS, []nft_view.TokenRowWithDenomname{},
+		accountParameter(),
+		tokensOrderParameter(),
+	)
+
+	spec.find("/api/v1/supply", "listSupply", "List total and circulating supply",
+		TAG_CHAIN, []handlers.SupplyDetails{},
+	)
+	spec.list("/api/v1/supply/{denom}/history", "listSupplyHistory", "List supply history of denom",
+		TAG_CHAIN, []supply_view.SupplyHistoryRow{},
+		pathParameter("denom", "Denom", stringSchema()),
+		orderParameter("height", "height.desc"),
+	)
+	spec.find("/api/v1/community-pool", "findCommunityPool", "Find latest community pool balance",
+		TAG_CHAIN, community_pool_view.HistoryRow{},
+	)
+	spec.list("/api/v1/community-pool/history", "listCommunityPoolHistory", "List community pool balance history",
+		TAG_CHAIN, []community_pool_view.HistoryRow{},
+		orderParameter("height", "height.desc"),
+	)
+	spec.list("/api/v1/community-pool/flows", "listCommunityPoolFlows", "List community pool inflows and outflows",
+		TAG_CHAIN, []community_pool_view.FlowRow{},
+		queryParameter("filter.direction", "Flow direction", enumSchema(
+			community_pool_view.FLOW_DIRECTION_INFLOW,
+			community_pool_view.FLOW_DIRECTION_OUTFLOW,
+		)),
+		orderParameter("id", "id.desc"),
+	)
+	spec.find("/api/v1/vesting/schedule", "getVestingSchedule", "Get chain-wide vested and unvested amounts over time",
+		TAG_CHAIN, []handlers.VestingSchedulePoint{},
+		queryParameter("interval", "Interval between points", enumSchema("day", "week", "month")),
+		queryParameter("from", "Unix time in seconds of the first point. Defaults to the earliest vesting start time", integerSchema(nil)),
+		queryParameter("to", "Unix time in seconds of the last point. Defaults to the latest vesting end time", integerSchema(nil)),
+	)
+
+	spec.get("/api/v1/openapi.json", &openapi.Operation{
+		OperationId: "getOpenAPISpec",
+		Summary:     "Get OpenAPI specification",
+		Tags:        []string{TAG_DOCUMENTATION},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "OpenAPI specification",
+				Content: map[string]openapi.MediaType{
+					"application/json": {Schema: &openapi.Schema{Type: "object"}},
+				},
+			},
+		},
+	})
+	spec.get("/api/v1/docs", &openapi.Operation{
+		OperationId: "getDocs",
+		Summary:     "API documentation UI",
+		Tags:        []string{TAG_DOCUMENTATION},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "API documentation page",
+				Content: map[string]openapi.MediaType{
+					"text/html": {Schema: &openapi.Schema{Type: "string"}},
+				},
+			},
+		},
+	})
+	spec.get("/api/v1/docs/{asset}", &openapi.Operation{
+		OperationId: "getDocsAsset",
+		Summary:     "Swagger UI asset of the API documentation UI",
+		Tags:        []string{TAG_DOCUMENTATION},
+		Parameters: []openapi.Parameter{
+			pathParameter("asset", "Asset file name", enumSchema("swagger-ui.css", "swagger-ui-bundle.js")),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "Swagger UI asset",
+				Content: map[string]openapi.MediaType{
+					"text/css":               {Schema: &openapi.Schema{Type: "string"}},
+					"application/javascript": {Schema: &openapi.Schema{Type: "string"}},
+				},
+			},
+			"404": {
+				Description: "Asset not found",
+			},
+		},
+	})
+
+	if withGraphQL {
+		spec.graphQL("/api/v1/graphql")
+	}
+
+	return document
+}
+
+type specBuilder struct {
+	document    *openapi.Document
+	schemas     *openapi.SchemaGenerator
+	projections view.Projections
+}
+
+// get adds a GET operation when the views of the projections of the route are served
+func (spec *specBuilder) get(path string, operation *openapi.Operation) {
+	if !spec.projections.Serves(RouteProjections()[path]...) {
+		return
+	}
+	spec.document.AddOperation("GET", path, operation)
+}
+
+// find adds a GET operation responding a non-paginated result
+func (spec *specBuilder) find(
+	path string,
+	operationId string,
+	summary string,
+	tag string,
+	result interface{},
+	parameters ...openapi.Parameter,
+) {
+	spec.get(path, &openapi.Operation{
+		OperationId: operationId,
+		Summary:     summary,
+		Tags:        []string{tag},
+		Parameters:  parameters,
+		Responses:   spec.responses(result, false),
+	})
+}
+
+// list adds a GET operation responding a paginated result. The pagination parameters are added.
+func (spec *specBuilder) list(
+	path string,
+	operationId string,
+	summary string,
+	tag string,
+	result interface{},
+	parameters ...openapi.Parameter,
+) {
+	spec.get(path, &openapi.Operation{
+		OperationId: operationId,
+		Summary:     summary,
+		Tags:        []string{tag},
+		Parameters:  append(parameters, paginationParameters()...),
+		Responses:   spec.responses(result, true),
+	})
+}
+
+func (spec *specBuilder) responses(result interface{}, isPaginated bool) map[string]*openapi.Response {
+	properties := map[string]*openapi.Schema{
+		"result": spec.schemas.SchemaOf(result),
+	}
+	if isPaginated {
+		properties["pagination"] = spec.schemas.SchemaOf(httpapi.PaginationOffsetResponse{})
+	}
+
+	responses := map[string]*openapi.Response{
+		"200": {
+			Description: "Success",
+			Content: map[string]openapi.MediaType{
+				"application/json": {Schema: &openapi.Schema{
+					Type:       "object",
+					Properties: properties,
+				}},
+			},
+		},
+		"400": spec.errorResponse("Invalid request parameters", httpapi.InvalidParametersResponse{}),
+		"500": spec.errorResponse("Internal server error", httpapi.Response{}),
+	}
+	if !isPaginated {
+		responses["404"] = spec.errorResponse("Record not found", httpapi.Response{})
+	}
+	return responses
+}
+
+func (spec *specBuilder) errorResponse(description string, response interface{}) *openapi.Response {
+	return &openapi.Response{
+		Description: description,
+		Content: map[string]openapi.MediaType{
+			"application/json": {Schema: spec.schemas.SchemaOf(response)},
+		},
+	}
+}
+
+func (spec *specBuilder) graphQL(path string) {
+	result := &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"data": {},
+			"errors": {
+				Type: "array",
+				Items: &openapi.Schema{
+					Type: "object",
+					Properties: map[string]*openapi.Schema{
+						"message": {Type: "string"},
+					},
+				},
+			},
+		},
+	}
+	responses := map[string]*openapi.Response{
+		"200": {
+			Description: "Query result",
+			Content: map[string]openapi.MediaType{
+				"application/json": {Schema: result},
+			},
+		},
+		"400": {
+			Description: "Invalid query or query exceeding the depth or complexity limits",
+			Content: map[string]openapi.MediaType{
+				"application/json": {Schema: result},
+			},
+		},
+	}
+
+	spec.document.AddOperation("GET", path, &openapi.Operation{
+		OperationId: "queryGraphQL",
+		Summary:     "Execute GraphQL query",
+		Tags:        []string{TAG_GRAPHQL},
+		Parameters: []openapi.Parameter{
+			requiredQueryParameter("query", "GraphQL query", stringSchema()),
+			queryParameter("variables", "JSON object of the query variables", stringSchema()),
+			queryParameter("operationName", "Operation to execute", stringSchema()),
+		},
+		Responses: responses,
+	})
+	spec.document.AddOperation("POST", path, &openapi.Operation{
+		OperationId: "queryGraphQLWithBody",
+		Summary:     "Execute GraphQL query",
+		Tags:        []string{TAG_GRAPHQL},
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content: map[string]openapi.MediaType{
+				"application/json": {Schema: spec.schemas.SchemaOf(handlers.GraphQLRequest{})},
+			},
+		},
+		Responses: responses,
+	})
+}
+
+func paginationParameters() []openapi.Parameter {
+	return []openapi.Parameter{
+		queryParameter("pagination", "Pagination type", enumSchema("offset")),
+		queryParameter("page", "Page number, starting from 1", integerSchema(minimum(1))),
+		queryParameter("limit", "Page size. Defaults to 20 when absent or not positive", integerSchema(nil)),
+	}
+}
+
+// orderParameter returns the `order` parameter accepting the ascending and descending values.
+// Ascending is the default.
+func orderParameter(ascending string, descending string) openapi.Parameter {
+	return queryParameter("order", "Sort order", enumSchema(ascending, descending))
+}
+
+func validatorsOrderParameter() openapi.Parameter {
+	explode := true
+	parameter := listQueryParameter(
+		"order", "Sort orders, can be repeated", enumSchema("power", "power.desc", "commission", "commission.desc"),
+	)
+	parameter.Style = "form"
+	parameter.Explode = &explode
+	return parameter
+}
+
+func tokensOrderParameter() openapi.Parameter {
+	return queryParameter("order", "Sort order", enumSchema(
+		"mintedAt", "mintedAt.desc", "lastEditedAt", "lastEditedAt.desc",
+	))
+}
+
+func msgTypeParameter() openapi.Parameter {
+	return listQueryParameter("filter.msgType", "Message types", stringSchema())
+}
+
+func accountParameter() openapi.Parameter {
+	return pathParameter("account", "Account address", stringSchema())
+}
+
+func validatorAddressParameter() openapi.Parameter {
+	return pathParameter("address", "Validator operator or consensus node address", stringSchema())
+}
+
+func heightParameter() openapi.Parameter {
+	return pathParameter("height", "Block height", integerSchema(minimum(1)))
+}
+
+func proposalIdParameter() openapi.Parameter {
+	return pathParameter("id", "Proposal id", &openapi.Schema{
+		Type:    "string",
+		Pattern: "^[0-9]+$",
+	})
+}
+
+func denomIdParameter() openapi.Parameter {
+	return pathParameter("denomId", "Denom id", stringSchema())
+}
+
+func tokenIdParameter() openapi.Parameter {
+	return pathParameter("tokenId", "Token id", stringSchema())
+}
+
+func pathParameter(name string, description string, schema *openapi.Schema) openapi.Parameter {
+	return openapi.Parameter{
+		Name:        name,
+		In:          openapi.PARAMETER_IN_PATH,
+		Description: description,
+		Required:    true,
+		Schema:      schema,
+	}
+}
+
+func queryParameter(name string, description string, schema *openapi.Schema) openapi.Parameter {
+	return openapi.Parameter{
+		Name:        name,
+		In:          openapi.PARAMETER_IN_QUERY,
+		Description: description,
+		Schema:      schema,
+	}
+}
+
+func requiredQueryParameter(name string, description string, schema *openapi.Schema) openapi.Parameter {
+	parameter := queryParameter(name, description, schema)
+	parameter.Required = true
+	return parameter
+}
+
+// listQueryParameter returns a parameter of comma-separated items
+func listQueryParameter(name string, description string, items *openapi.Schema) openapi.Parameter {
+	explode := false
+	return openapi.Parameter{
+		Name:        name,
+		In:          openapi.PARAMETER_IN_QUERY,
+		Description: description,
+		Style:       "form",
+		Explode:     &explode,
+		Schema: &openapi.Schema{
+			Type:  "array",
+			Items: items,
+		},
+	}
+}
+
+func stringSchema() *openapi.Schema {
+	return &openapi.Schema{
+		Type: "string",
+	}
+}
+
+func integerSchema(maybeMinimum *float64) *openapi.Schema {
+	return &openapi.Schema{
+		Type:    "integer",
+		Format:  "int64",
+		Minimum: maybeMinimum,
+	}
+}
+
+func enumSchema(values ...string) *openapi.Schema {
+	enum := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		enum = append(enum, value)
+	}
+	return &openapi.Schema{
+		Type: "string",
+		Enum: enum,
+	}
+}
+
+func minimum(value float64) *float64 {
+	return &value
+}
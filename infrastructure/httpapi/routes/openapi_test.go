@@ -0,0 +1,122 @@
+package routes_test
+
+import (
+	"regexp"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/openapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/routes"
+)
+
+var pathParameterPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+var _ = Describe("OpenAPI", func() {
+	const routePrefix = "/indexing"
+
+	var document *openapi.Document
+	var server *httpapi.Server
+
+	BeforeEach(func() {
+		document = routes.NewOpenAPIDocument(routePrefix, true, nil)
+
+		server = httpapi.NewServer("")
+		registry := routes.NewRoutesRegistry(
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			&handlers.OpenAPI{},
+			&handlers.GraphQL{},
+		)
+		registry.Register(server, routePrefix)
+	})
+
+	It("should document every registered route", func() {
+		Expect(server.Routes()).NotTo(BeEmpty())
+
+		for _, route := range server.Routes() {
+			Expect(route.Path).To(HavePrefix(routePrefix))
+			path := strings.TrimPrefix(route.Path, routePrefix)
+
+			operation := document.Operation(route.Method, path)
+			Expect(operation).NotTo(BeNil(), "missing OpenAPI operation for %s %s", route.Method, path)
+
+			for _, match := range pathParameterPattern.FindAllStringSubmatch(path, -1) {
+				Expect(hasParameter(operation, openapi.PARAMETER_IN_PATH, match[1])).To(
+					BeTrue(), "missing path parameter %s of %s %s", match[1], route.Method, path,
+				)
+			}
+		}
+	})
+
+	It("should only document registered routes", func() {
+		registered := make(map[string]bool)
+		for _, route := range server.Routes() {
+			registered[route.Method+" "+strings.TrimPrefix(route.Path, routePrefix)] = true
+		}
+
+		for path, pathItem := range document.Paths {
+			if pathItem.Get != nil {
+				Expect(registered).To(HaveKey("GET "+path), "documented route GET %s is not registered", path)
+			}
+			if pathItem.Post != nil {
+				Expect(registered).To(HaveKey("POST "+path), "documented route POST %s is not registered", path)
+			}
+		}
+	})
+
+	It("should have unique operation ids", func() {
+		operationIds := make(map[string]string)
+		for path, pathItem := range document.Paths {
+			for _, operation := range []*openapi.Operation{pathItem.Get, pathItem.Post} {
+				if operation == nil {
+					continue
+				}
+				Expect(operationIds).NotTo(HaveKey(operation.OperationId), "duplicated operation id on %s", path)
+				operationIds[operation.OperationId] = path
+			}
+		}
+	})
+
+	It("should resolve every component reference", func() {
+		encoded, err := jsoniter.Marshal(document)
+		Expect(err).To(BeNil())
+
+		for _, match := range regexp.MustCompile(`"\$ref":"#/components/schemas/([^"]+)"`).FindAllStringSubmatch(
+			string(encoded), -1,
+		) {
+			Expect(document.Components.Schemas).To(HaveKey(match[1]))
+		}
+	})
+
+	It("should use the route prefix as server URL", func() {
+		Expect(document.Servers[0].URL).To(Equal(routePrefix))
+		Expect(routes.NewOpenAPIDocument("", false, nil).Servers[0].URL).To(Equal("/"))
+	})
+
+	It("should not document GraphQL when it is disabled", func() {
+		Expect(routes.NewOpenAPIDocument(routePrefix, false, nil).Paths).NotTo(HaveKey("/api/v1/graphql"))
+	})
+
+	It("should only document the routes reading the views of the projections", func() {
+		paths := routes.NewOpenAPIDocument(routePrefix, false, view.Projections{"Block"}).Paths
+
+		Expect(paths).To(HaveKey("/api/v1/blocks"))
+		Expect(paths).To(HaveKey("/api/v1/search"))
+		Expect(paths).NotTo(HaveKey("/api/v1/blocks/{height}/transactions"))
+		Expect(paths).NotTo(HaveKey("/api/v1/nfts/denoms"))
+	})
+})
+
+func hasParameter(operation *openapi.Operation, in string, name string) bool {
+	for _, parameter := range operation.Parameters {
+		if parameter.In == in && parameter.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -3,12 +3,14 @@ package routes
 import (
 	"fmt"
 
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
 	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
 	"github.com/valyala/fasthttp"
 )
 
 type RouteRegistry struct {
+	healthHandler              *handlers.Health
 	searchHandler              *handlers.Search
 	blocksHandler              *handlers.Blocks
 	statusHandler              *handlers.StatusHandler
@@ -20,9 +22,32 @@ type RouteRegistry struct {
 	accountsHandler            *handlers.Accounts
 	proposalsHandler           *handlers.Proposals
 	nftsHandler                *handlers.NFTs
+	supplyHandler              *handlers.Supply
+	communityPoolHandler       *handlers.CommunityPool
+	vestingHandler             *handlers.Vesting
+	multisigAccountsHandler    *handlers.MultisigAccounts
+	pubKeysHandler             *handlers.PubKeys
+	accountExportHandler       *handlers.AccountExport
+	replicaHandler             *handlers.Replica
+	openAPIHandler             *handlers.OpenAPI
+	// Optional. GraphQL API is not served when nil.
+	maybeGraphQLHandler *handlers.GraphQL
+
+	projections view.Projections
+
+	extraRoutes []ExtraRoute
+}
+
+// ExtraRoute is a route served in addition to the routes of this repository, e.g. by a custom
+// binary with its own projections. Path is without the route prefix, e.g. `/api/v1/my-views`.
+type ExtraRoute struct {
+	Method  string
+	Path    string
+	Handler fasthttp.RequestHandler
 }
 
 func NewRoutesRegistry(
+	healthHandler *handlers.Health,
 	searchHandler *handlers.Search,
 	blocksHandler *handlers.Blocks,
 	statusHandler *handlers.StatusHandler,
@@ -34,8 +59,18 @@ func NewRoutesRegistry(
 	accountsHandler *handlers.Accounts,
 	proposalsHandler *handlers.Proposals,
 	nftsHandler *handlers.NFTs,
+	supplyHandler *handlers.Supply,
+	communityPoolHandler *handlers.CommunityPool,
+	vestingHandler *handlers.Vesting,
+	multisigAccountsHandler *handlers.MultisigAccounts,
+	pubKeysHandler *handlers.PubKeys,
+	accountExportHandler *handlers.AccountExport,
+	replicaHandler *handlers.Replica,
+	openAPIHandler *handlers.OpenAPI,
+	maybeGraphQLHandler *handlers.GraphQL,
 ) *RouteRegistry {
 	return &RouteRegistry{
+		healthHandler,
 		searchHandler,
 		blocksHandler,
 		statusHandler,
@@ -47,53 +82,178 @@ func NewRoutesRegistry(
 		accountsHandler,
 		proposalsHandler,
 		nftsHandler,
+		supplyHandler,
+		communityPoolHandler,
+		vestingHandler,
+		multisigAccountsHandler,
+		pubKeysHandler,
+		accountExportHandler,
+		replicaHandler,
+		openAPIHandler,
+		maybeGraphQLHandler,
+
+		nil,
+
+		make([]ExtraRoute, 0),
 	}
 }
 
+// WithProjections only registers the routes reading the views of the projections. The routes of all
+// projections are registered by default.
+func (registry *RouteRegistry) WithProjections(projections view.Projections) *RouteRegistry {
+	registry.projections = projections
+	return registry
+}
+
+// WithExtraRoutes adds the routes to be registered after the routes of this repository. Only GET
+// and POST routes are supported.
+func (registry *RouteRegistry) WithExtraRoutes(extraRoutes []ExtraRoute) *RouteRegistry {
+	registry.extraRoutes = append(registry.extraRoutes, extraRoutes...)
+	return registry
+}
+
 func (registry *RouteRegistry) Register(server *httpapi.Server, routePrefix string) {
 	if routePrefix == "/" {
 		routePrefix = ""
 	}
 
-	server.GET(fmt.Sprintf("%s/api/v1/health", routePrefix), func(ctx *fasthttp.RequestCtx) {
-		ctx.SetStatusCode(fasthttp.StatusOK)
-		ctx.SetBody([]byte("Ok"))
-	})
-	server.GET(fmt.Sprintf("%s/api/v1/search", routePrefix), registry.searchHandler.Search)
-	server.GET(fmt.Sprintf("%s/api/v1/accounts", routePrefix), registry.accountsHandler.List)
-	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}", routePrefix), registry.accountsHandler.FindBy)
-	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/transactions", routePrefix), registry.accountTransactionsHandler.ListByAccount)
-	server.GET(fmt.Sprintf("%s/api/v1/accounts/{account}/messages", routePrefix), registry.accountMessagesHandler.ListByAccount)
-	server.GET(fmt.Sprintf("%s/api/v1/blocks", routePrefix), registry.blocksHandler.List)
-	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height-or-hash}", routePrefix), registry.blocksHandler.FindBy)
-	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height}/transactions", routePrefix), registry.blocksHandler.ListTransactionsByHeight)
-	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height}/events", routePrefix), registry.blocksHandler.ListEventsByHeight)
-	server.GET(fmt.Sprintf("%s/api/v1/blocks/{height}/commitments", routePrefix), registry.blocksHandler.ListCommitmentsByHeight)
-	server.GET(fmt.Sprintf("%s/api/v1/events", routePrefix), registry.blockEventHandler.List)
-	server.GET(fmt.Sprintf("%s/api/v1/events/{id}", routePrefix), registry.blockEventHandler.FindById)
-	server.GET(fmt.Sprintf("%s/api/v1/proposals", routePrefix), registry.proposalsHandler.List)
-	server.GET(fmt.Sprintf("%s/api/v1/proposals/{id}", routePrefix), registry.proposalsHandler.FindById)
-	server.GET(fmt.Sprintf("%s/api/v1/proposals/{id}/votes", routePrefix), registry.proposalsHandler.ListVotesById)
-	server.GET(fmt.Sprintf("%s/api/v1/proposals/{id}/depositors", routePrefix), registry.proposalsHandler.ListDepositorsById)
-	server.GET(fmt.Sprintf("%s/api/v1/status", routePrefix), registry.statusHandler.GetStatus)
-	server.GET(fmt.Sprintf("%s/api/v1/transactions", routePrefix), registry.transactionHandler.List)
-	server.GET(fmt.Sprintf("%s/api/v1/transactions/{hash}", routePrefix), registry.transactionHandler.FindByHash)
-	server.GET(fmt.Sprintf("%s/api/v1/validators", routePrefix), registry.validatorsHandler.List)
-	server.GET(fmt.Sprintf("%s/api/v1/validators/active", routePrefix), registry.validatorsHandler.ListActive)
-	server.GET(fmt.Sprintf("%s/api/v1/validators/{address}", routePrefix), registry.validatorsHandler.FindBy)
-	server.GET(fmt.Sprintf("%s/api/v1/validators/{address}/activities", routePrefix), registry.validatorsHandler.ListActivities)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/messages", routePrefix), registry.nftsHandler.ListMessages)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denom/name/{denomName}", routePrefix), registry.nftsHandler.FindDenomByName)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denom/id/{denomId}", routePrefix), registry.nftsHandler.FindDenomById)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms", routePrefix), registry.nftsHandler.ListDenoms)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/tokens", routePrefix), registry.nftsHandler.ListTokens)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms/{denomId}", routePrefix), registry.nftsHandler.FindDenomById)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms/{denomId}/messages", routePrefix), registry.nftsHandler.ListMessagesByDenom)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms/{denomId}/tokens", routePrefix), registry.nftsHandler.ListTokensByDenomId)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}", routePrefix), registry.nftsHandler.FindTokenById)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/transfers", routePrefix), registry.nftsHandler.ListTransfersByToken)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/messages", routePrefix), registry.nftsHandler.ListMessagesByToken)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/drops", routePrefix), registry.nftsHandler.ListDrops)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/drops/{drop}/tokens", routePrefix), registry.nftsHandler.ListTokensByDrop)
-	server.GET(fmt.Sprintf("%s/api/v1/nfts/accounts/{account}/tokens", routePrefix), registry.nftsHandler.ListTokensByAccount)
+	registry.get(server, routePrefix, "/api/v1/health", registry.healthHandler.Check)
+	registry.get(server, routePrefix, "/api/v1/search", registry.searchHandler.Search)
+	registry.get(server, routePrefix, "/api/v1/accounts", registry.accountsHandler.List)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}", registry.accountsHandler.FindBy)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}/transactions", registry.accountTransactionsHandler.ListByAccount)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}/messages", registry.accountMessagesHandler.ListByAccount)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}/export", registry.accountExportHandler.Export)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}/multisig", registry.multisigAccountsHandler.FindBy)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}/multisig/transactions", registry.multisigAccountsHandler.ListTransactionsByAccount)
+	registry.get(server, routePrefix, "/api/v1/accounts/{account}/multisig-memberships", registry.multisigAccountsHandler.ListMembershipsByAccount)
+	registry.get(server, routePrefix, "/api/v1/pubkeys/{pubkey}", registry.pubKeysHandler.FindBy)
+	registry.get(server, routePrefix, "/api/v1/blocks", registry.blocksHandler.List)
+	registry.get(server, routePrefix, "/api/v1/blocks/{height-or-hash}", registry.blocksHandler.FindBy)
+	registry.get(server, routePrefix, "/api/v1/blocks/{height}/transactions", registry.blocksHandler.ListTransactionsByHeight)
+	registry.get(server, routePrefix, "/api/v1/blocks/{height}/events", registry.blocksHandler.ListEventsByHeight)
+	registry.get(server, routePrefix, "/api/v1/blocks/{height}/commitments", registry.blocksHandler.ListCommitmentsByHeight)
+	registry.get(server, routePrefix, "/api/v1/events", registry.blockEventHandler.List)
+	registry.get(server, routePrefix, "/api/v1/events/{id}", registry.blockEventHandler.FindById)
+	registry.get(server, routePrefix, "/api/v1/proposals", registry.proposalsHandler.List)
+	registry.get(server, routePrefix, "/api/v1/proposals/{id}", registry.proposalsHandler.FindById)
+	registry.get(server, routePrefix, "/api/v1/proposals/{id}/votes", registry.proposalsHandler.ListVotesById)
+	registry.get(server, routePrefix, "/api/v1/proposals/{id}/depositors", registry.proposalsHandler.ListDepositorsById)
+	registry.get(server, routePrefix, "/api/v1/status", registry.statusHandler.GetStatus)
+	registry.get(server, routePrefix, "/api/v1/status/replica", registry.replicaHandler.Status)
+	registry.get(server, routePrefix, "/api/v1/transactions", registry.transactionHandler.List)
+	registry.get(server, routePrefix, "/api/v1/transactions/{hash}", registry.transactionHandler.FindByHash)
+	registry.get(server, routePrefix, "/api/v1/validators", registry.validatorsHandler.List)
+	registry.get(server, routePrefix, "/api/v1/validators/active", registry.validatorsHandler.ListActive)
+	registry.get(server, routePrefix, "/api/v1/validators/{address}", registry.validatorsHandler.FindBy)
+	registry.get(server, routePrefix, "/api/v1/validators/{address}/activities", registry.validatorsHandler.ListActivities)
+	registry.get(server, routePrefix, "/api/v1/nfts/messages", registry.nftsHandler.ListMessages)
+	registry.get(server, routePrefix, "/api/v1/nfts/denom/name/{denomName}", registry.nftsHandler.FindDenomByName)
+	registry.get(server, routePrefix, "/api/v1/nfts/denom/id/{denomId}", registry.nftsHandler.FindDenomById)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms", registry.nftsHandler.ListDenoms)
+	registry.get(server, routePrefix, "/api/v1/nfts/tokens", registry.nftsHandler.ListTokens)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms/{denomId}", registry.nftsHandler.FindDenomById)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms/{denomId}/messages", registry.nftsHandler.ListMessagesByDenom)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms/{denomId}/tokens", registry.nftsHandler.ListTokensByDenomId)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}", registry.nftsHandler.FindTokenById)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/transfers", registry.nftsHandler.ListTransfersByToken)
+	registry.get(server, routePrefix, "/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/messages", registry.nftsHandler.ListMessagesByToken)
+	registry.get(server, routePrefix, "/api/v1/nfts/drops", registry.nftsHandler.ListDrops)
+	registry.get(server, routePrefix, "/api/v1/nfts/drops/{drop}/tokens", registry.nftsHandler.ListTokensByDrop)
+	registry.get(server, routePrefix, "/api/v1/nfts/accounts/{account}/tokens", registry.nftsHandler.ListTokensByAccount)
+	registry.get(server, routePrefix, "/api/v1/supply", registry.supplyHandler.List)
+	registry.get(server, routePrefix, "/api/v1/supply/{denom}/history", registry.supplyHandler.ListHistoryByDenom)
+	registry.get(server, routePrefix, "/api/v1/community-pool", registry.communityPoolHandler.FindLatest)
+	registry.get(server, routePrefix, "/api/v1/community-pool/history", registry.communityPoolHandler.ListHistory)
+	registry.get(server, routePrefix, "/api/v1/community-pool/flows", registry.communityPoolHandler.ListFlows)
+	registry.get(server, routePrefix, "/api/v1/vesting/schedule", registry.vestingHandler.Schedule)
+	registry.get(server, routePrefix, "/api/v1/openapi.json", registry.openAPIHandler.Spec)
+	registry.get(server, routePrefix, "/api/v1/docs", registry.openAPIHandler.Docs)
+	registry.get(server, routePrefix, "/api/v1/docs/{asset}", registry.openAPIHandler.DocsAsset)
+
+	if registry.maybeGraphQLHandler != nil {
+		server.GET(fmt.Sprintf("%s/api/v1/graphql", routePrefix), registry.maybeGraphQLHandler.Query)
+		server.POST(fmt.Sprintf("%s/api/v1/graphql", routePrefix), registry.maybeGraphQLHandler.Query)
+	}
+
+	for _, extraRoute := range registry.extraRoutes {
+		path := fmt.Sprintf("%s%s", routePrefix, extraRoute.Path)
+		switch extraRoute.Method {
+		case fasthttp.MethodGet:
+			server.GET(path, extraRoute.Handler)
+		case fasthttp.MethodPost:
+			server.POST(path, extraRoute.Handler)
+		default:
+			panic(fmt.Sprintf("unsupported method of route %s: %s", extraRoute.Path, extraRoute.Method))
+		}
+	}
+}
+
+// get registers the GET route when the views of its projections are served
+func (registry *RouteRegistry) get(
+	server *httpapi.Server,
+	routePrefix string,
+	path string,
+	handler fasthttp.RequestHandler,
+) {
+	if !registry.projections.Serves(RouteProjections()[path]...) {
+		return
+	}
+	server.GET(fmt.Sprintf("%s%s", routePrefix, path), handler)
+}
+
+// RouteProjections returns the projections whose views are read by the routes, keyed by the path of
+// the routes without prefix. A route is only registered when the views of all its projections are
+// served. The routes missing here do not read any view, or only the views which are served, e.g.
+// `/api/v1/search` only searches the views of the served projections.
+func RouteProjections() map[string][]string {
+	return map[string][]string{
+		"/api/v1/accounts":                                         {"Account"},
+		"/api/v1/accounts/{account}":                               {"Validator", "AccountPubKey", "VestingAccount", "Block"},
+		"/api/v1/accounts/{account}/transactions":                  {"AccountTransaction"},
+		"/api/v1/accounts/{account}/messages":                      {"AccountMessage"},
+		"/api/v1/accounts/{account}/export":                        {"AccountMessage", "AccountTransaction"},
+		"/api/v1/accounts/{account}/multisig":                      {"MultisigAccount"},
+		"/api/v1/accounts/{account}/multisig/transactions":         {"MultisigAccount"},
+		"/api/v1/accounts/{account}/multisig-memberships":          {"MultisigAccount"},
+		"/api/v1/pubkeys/{pubkey}":                                 {"AccountPubKey", "Validator"},
+		"/api/v1/blocks":                                           {"Block"},
+		"/api/v1/blocks/{height-or-hash}":                          {"Block"},
+		"/api/v1/blocks/{height}/transactions":                     {"Transaction"},
+		"/api/v1/blocks/{height}/events":                           {"BlockEvent"},
+		"/api/v1/blocks/{height}/commitments":                      {"Validator"},
+		"/api/v1/events":                                           {"BlockEvent"},
+		"/api/v1/events/{id}":                                      {"BlockEvent"},
+		"/api/v1/proposals":                                        {"Proposal"},
+		"/api/v1/proposals/{id}":                                   {"Proposal"},
+		"/api/v1/proposals/{id}/votes":                             {"Proposal"},
+		"/api/v1/proposals/{id}/depositors":                        {"Proposal"},
+		"/api/v1/status":                                           {"Block", "Transaction", "Validator", "ValidatorStats", "ChainStats"},
+		"/api/v1/transactions":                                     {"Transaction"},
+		"/api/v1/transactions/{hash}":                              {"Transaction"},
+		"/api/v1/validators":                                       {"Validator", "ChainStats"},
+		"/api/v1/validators/active":                                {"Validator"},
+		"/api/v1/validators/{address}":                             {"Validator"},
+		"/api/v1/validators/{address}/activities":                  {"Validator"},
+		"/api/v1/nfts/messages":                                    {"NFT"},
+		"/api/v1/nfts/denom/name/{denomName}":                      {"NFT"},
+		"/api/v1/nfts/denom/id/{denomId}":                          {"NFT"},
+		"/api/v1/nfts/denoms":                                      {"NFT"},
+		"/api/v1/nfts/tokens":                                      {"NFT"},
+		"/api/v1/nfts/denoms/{denomId}":                            {"NFT"},
+		"/api/v1/nfts/denoms/{denomId}/messages":                   {"NFT"},
+		"/api/v1/nfts/denoms/{denomId}/tokens":                     {"NFT"},
+		"/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}":           {"NFT"},
+		"/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/transfers": {"NFT"},
+		"/api/v1/nfts/denoms/{denomId}/tokens/{tokenId}/messages":  {"NFT"},
+		"/api/v1/nfts/drops":                                       {"NFT"},
+		"/api/v1/nfts/drops/{drop}/tokens":                         {"NFT"},
+		"/api/v1/nfts/accounts/{account}/tokens":                   {"NFT"},
+		"/api/v1/supply":                                           {"Supply"},
+		"/api/v1/supply/{denom}/history":                           {"Supply"},
+		"/api/v1/community-pool":                                   {"CommunityPool"},
+		"/api/v1/community-pool/history":                           {"CommunityPool"},
+		"/api/v1/community-pool/flows":                             {"CommunityPool"},
+		"/api/v1/vesting/schedule":                                 {"VestingAccount", "Block"},
+	}
 }
@@ -0,0 +1,80 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/valyala/fasthttp"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/handlers"
+	"github.com/crypto-com/chain-indexing/infrastructure/httpapi/routes"
+)
+
+var _ = Describe("RouteRegistry", func() {
+	It("should register the extra routes under the route prefix", func() {
+		server := httpapi.NewServer("")
+		anyHandler := func(ctx *fasthttp.RequestCtx) {}
+		registry := routes.NewRoutesRegistry(
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			&handlers.OpenAPI{},
+			nil,
+		).WithExtraRoutes([]routes.ExtraRoute{
+			{Method: fasthttp.MethodGet, Path: "/api/v1/any-views", Handler: anyHandler},
+			{Method: fasthttp.MethodPost, Path: "/api/v1/any-views/search", Handler: anyHandler},
+		})
+		registry.Register(server, "/indexing")
+
+		Expect(server.Routes()).To(ContainElement(httpapi.Route{
+			Method: fasthttp.MethodGet,
+			Path:   "/indexing/api/v1/any-views",
+		}))
+		Expect(server.Routes()).To(ContainElement(httpapi.Route{
+			Method: fasthttp.MethodPost,
+			Path:   "/indexing/api/v1/any-views/search",
+		}))
+	})
+
+	It("should only register the routes reading the views of the projections", func() {
+		server := httpapi.NewServer("")
+		registry := routes.NewRoutesRegistry(
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			&handlers.OpenAPI{},
+			nil,
+		).WithProjections(view.Projections{"Block", "Validator"})
+		registry.Register(server, "")
+
+		Expect(server.Routes()).To(ContainElement(httpapi.Route{
+			Method: fasthttp.MethodGet,
+			Path:   "/api/v1/blocks/{height}/commitments",
+		}))
+		Expect(server.Routes()).To(ContainElement(httpapi.Route{
+			Method: fasthttp.MethodGet,
+			Path:   "/api/v1/search",
+		}))
+		Expect(server.Routes()).NotTo(ContainElement(httpapi.Route{
+			Method: fasthttp.MethodGet,
+			Path:   "/api/v1/blocks/{height}/transactions",
+		}))
+		Expect(server.Routes()).NotTo(ContainElement(httpapi.Route{
+			Method: fasthttp.MethodGet,
+			Path:   "/api/v1/proposals",
+		}))
+	})
+
+	It("should only declare the projections of registered routes", func() {
+		server := httpapi.NewServer("")
+		routes.NewRoutesRegistry(
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			&handlers.OpenAPI{},
+			nil,
+		).Register(server, "")
+
+		for path := range routes.RouteProjections() {
+			Expect(server.Routes()).To(ContainElement(httpapi.Route{
+				Method: fasthttp.MethodGet,
+				Path:   path,
+			}), "route GET %s is not registered", path)
+		}
+	})
+})
@@ -18,6 +18,9 @@ type Server struct {
 	middlewares      []Middleware
 	corsMiddleware   Middleware
 	loggerMiddleware Middleware
+
+	routes           []Route
+	routeMiddlewares []RouteMiddleware
 }
 
 func NewServer(listeningAddress string) *Server {
@@ -30,11 +33,43 @@ func NewServer(listeningAddress string) *Server {
 		middlewares,
 		nil,
 		nil,
+
+		make([]Route, 0),
+		make([]RouteMiddleware, 0),
 	}
 }
 
 func (server *Server) GET(path string, handler fasthttp.RequestHandler) *Server {
-	server.router.GET(path, handler)
+	server.router.GET(path, server.routeHandler(fasthttp.MethodGet, path, handler))
+	return server
+}
+
+func (server *Server) POST(path string, handler fasthttp.RequestHandler) *Server {
+	server.router.POST(path, server.routeHandler(fasthttp.MethodPost, path, handler))
+	return server
+}
+
+func (server *Server) routeHandler(method string, path string, handler fasthttp.RequestHandler) fasthttp.RequestHandler {
+	server.routes = append(server.routes, Route{
+		Method: method,
+		Path:   path,
+	})
+	for _, routeMiddleware := range server.routeMiddlewares {
+		handler = routeMiddleware(method, path, handler)
+	}
+	return handler
+}
+
+// Routes returns the routes registered by GET and POST in registration order
+func (server *Server) Routes() []Route {
+	return server.routes
+}
+
+// UseOnRoutes adds a middleware which is aware of the route it wraps. Unlike Use, it runs after
+// routing so path parameters are available, and it only applies to the routes registered
+// afterwards.
+func (server *Server) UseOnRoutes(routeMiddleware RouteMiddleware) *Server {
+	server.routeMiddlewares = append(server.routeMiddlewares, routeMiddleware)
 	return server
 }
 
@@ -92,3 +127,10 @@ func (server *Server) ListenAndServe() error {
 }
 
 type Middleware = func(fasthttp.RequestHandler) fasthttp.RequestHandler
+
+type RouteMiddleware = func(method string, path string, handler fasthttp.RequestHandler) fasthttp.RequestHandler
+
+type Route struct {
+	Method string
+	Path   string
+}
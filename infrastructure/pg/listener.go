@@ -0,0 +1,109 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+)
+
+const DEFAULT_LISTENER_RECONNECT_INTERVAL = 5 * time.Second
+
+// Listener receives the notifications of a Postgres channel on a dedicated connection. When the
+// connection drops, it reconnects after an interval, during which the notifications are missed.
+// Listener consumers should therefore keep polling as a fallback.
+type Listener struct {
+	logger     applogger.Logger
+	connConfig *ConnConfig
+	channel    string
+
+	reconnectInterval time.Duration
+	payloads          chan string
+}
+
+func NewListener(logger applogger.Logger, connConfig *ConnConfig, channel string) *Listener {
+	return &Listener{
+		logger: logger.WithFields(applogger.LogFields{
+			"module":  "Listener",
+			"channel": channel,
+		}),
+		connConfig: connConfig,
+		channel:    channel,
+
+		reconnectInterval: DEFAULT_LISTENER_RECONNECT_INTERVAL,
+		// Only the latest notification is kept when the consumer falls behind
+		payloads: make(chan string, 1),
+	}
+}
+
+func (listener *Listener) WithReconnectInterval(interval time.Duration) *Listener {
+	listener.reconnectInterval = interval
+	return listener
+}
+
+// Payloads returns the channel of the notification payloads
+func (listener *Listener) Payloads() <-chan string {
+	return listener.payloads
+}
+
+// RunInBackground listens to the channel until the process exits
+func (listener *Listener) RunInBackground() {
+	go func() {
+		for {
+			if err := listener.listen(); err != nil {
+				listener.logger.Errorf("error listening to notifications, reconnecting in %s: %v",
+					listener.reconnectInterval, err,
+				)
+			}
+			<-time.After(listener.reconnectInterval)
+		}
+	}()
+}
+
+// listen connects and forwards the notifications until the connection drops
+func (listener *Listener) listen() error {
+	ctx := context.Background()
+
+	pgxConfig, err := pgx.ParseConfig(listener.connConfig.ToURL())
+	if err != nil {
+		return fmt.Errorf("error parsing connection config: %v", err)
+	}
+	pgxConfig.Logger = NewPgxLoggerAdapter(listener.logger)
+
+	conn, err := pgx.ConnectConfig(ctx, pgxConfig)
+	if err != nil {
+		return fmt.Errorf("error connecting: %v", err)
+	}
+	defer conn.Close(ctx)
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{listener.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("error executing LISTEN: %v", err)
+	}
+	listener.logger.Infof("listening to notifications")
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("error waiting for notification: %v", err)
+		}
+		listener.forward(notification.Payload)
+	}
+}
+
+// forward replaces the unconsumed payload, so that the listener is never blocked by the consumer
+func (listener *Listener) forward(payload string) {
+	for {
+		select {
+		case listener.payloads <- payload:
+			return
+		default:
+		}
+		select {
+		case <-listener.payloads:
+		default:
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package pg_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/infrastructure/pg"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/crypto-com/chain-indexing/test"
+)
+
+var _ = Describe("Listener", func() {
+	WithTestPgConnConfig(func(config *ConnConfig) {
+		It("should receive the payloads notified to the channel", func() {
+			listener := NewListener(NewFakeLogger(), config, "any_channel")
+			listener.RunInBackground()
+			pgConn := MustNewTestPgConn(config)
+
+			// Notifications sent before the listener is connected are missed
+			Eventually(func() (string, error) {
+				if _, err := pgConn.Exec("SELECT pg_notify('any_channel', '10')"); err != nil {
+					return "", err
+				}
+				select {
+				case payload := <-listener.Payloads():
+					return payload, nil
+				case <-time.After(100 * time.Millisecond):
+					return "", nil
+				}
+			}, 5*time.Second).Should(Equal("10"))
+		})
+	})
+})
@@ -3,17 +3,20 @@ package pg
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
 	gomigrate "github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 )
 
 type Migrate struct {
 	*gomigrate.Migrate
 
-	config       *ConnConfig
-	sourceFolder string
+	newMigrate func() (*gomigrate.Migrate, error)
 
 	reCreateClientOnReset bool
 }
@@ -28,7 +31,34 @@ func MustNewMigrate(config *ConnConfig, sourceFolder string) *Migrate {
 }
 
 func NewMigrate(config *ConnConfig, sourceFolder string) (*Migrate, error) {
-	m, err := _newMigrate(config, sourceFolder)
+	return newMigrate(func() (*gomigrate.Migrate, error) {
+		return _newMigrate(config, sourceFolder)
+	})
+}
+
+// NewMigrateWithMigrations creates a Migrate of the migrations declared in code, which keeps the
+// migration version in the migrationsTable, so that multiple sets of migrations can be applied to
+// the same database independently
+func NewMigrateWithMigrations(
+	config *ConnConfig,
+	migrations []rdb.Migration,
+	migrationsTable string,
+) (*Migrate, error) {
+	return newMigrate(func() (*gomigrate.Migrate, error) {
+		migrationSource, err := NewMigrationSource(migrations)
+		if err != nil {
+			return nil, err
+		}
+		return gomigrate.NewWithSourceInstance(
+			MIGRATION_SOURCE_NAME,
+			migrationSource,
+			withURLQuery(config.ToURL(), "x-migrations-table", migrationsTable),
+		)
+	})
+}
+
+func newMigrate(newMigrate func() (*gomigrate.Migrate, error)) (*Migrate, error) {
+	m, err := newMigrate()
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +67,7 @@ func NewMigrate(config *ConnConfig, sourceFolder string) (*Migrate, error) {
 	return &Migrate{
 		m,
 
-		config,
-		sourceFolder,
+		newMigrate,
 
 		reCreateClientOnReset,
 	}, nil
@@ -51,6 +80,14 @@ func _newMigrate(config *ConnConfig, sourceFolder string) (*gomigrate.Migrate, e
 	)
 }
 
+func withURLQuery(rawURL string, key string, value string) string {
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%s%s=%s", rawURL, separator, key, url.QueryEscape(value))
+}
+
 func (m *Migrate) SetReCreateOnReset(shouldReset bool) {
 	m.reCreateClientOnReset = shouldReset
 }
@@ -109,7 +146,7 @@ func (m *Migrate) Reset() error {
 	// There is a known bug of "golang-migrate" that after `Drop()`, "schema_migrations" won't be
 	// create by `Up()`. The solution is to re-create the "golang-migrate" client
 	if m.reCreateClientOnReset {
-		migrate, err := _newMigrate(m.config, m.sourceFolder)
+		migrate, err := m.newMigrate()
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,98 @@
+package pg
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4/source"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const MIGRATION_SOURCE_NAME = "migrations"
+
+var _ source.Driver = &MigrationSource{}
+
+// MigrationSource is a golang-migrate source driver of the migrations declared in code, so that the
+// migrations are built into the binary
+type MigrationSource struct {
+	migrations *source.Migrations
+}
+
+func NewMigrationSource(migrations []rdb.Migration) (*MigrationSource, error) {
+	sourceMigrations := source.NewMigrations()
+	for _, migration := range migrations {
+		if !sourceMigrations.Append(&source.Migration{
+			Version:    migration.Version,
+			Identifier: migration.Name,
+			Direction:  source.Up,
+			Raw:        migration.Up,
+		}) {
+			return nil, fmt.Errorf("duplicated migration version %d", migration.Version)
+		}
+		// A migration without down migration is only removed from the version table when migrating down
+		if migration.Down != "" {
+			sourceMigrations.Append(&source.Migration{
+				Version:    migration.Version,
+				Identifier: migration.Name,
+				Direction:  source.Down,
+				Raw:        migration.Down,
+			})
+		}
+	}
+
+	return &MigrationSource{
+		migrations: sourceMigrations,
+	}, nil
+}
+
+func (migrationSource *MigrationSource) Open(_ string) (source.Driver, error) {
+	return nil, fmt.Errorf("%s source can only be created with NewMigrationSource", MIGRATION_SOURCE_NAME)
+}
+
+func (migrationSource *MigrationSource) Close() error {
+	return nil
+}
+
+func (migrationSource *MigrationSource) First() (uint, error) {
+	version, ok := migrationSource.migrations.First()
+	if !ok {
+		return 0, &os.PathError{Op: "first", Path: MIGRATION_SOURCE_NAME, Err: os.ErrNotExist}
+	}
+	return version, nil
+}
+
+func (migrationSource *MigrationSource) Prev(version uint) (uint, error) {
+	prevVersion, ok := migrationSource.migrations.Prev(version)
+	if !ok {
+		return 0, &os.PathError{Op: fmt.Sprintf("prev for version %d", version), Path: MIGRATION_SOURCE_NAME, Err: os.ErrNotExist}
+	}
+	return prevVersion, nil
+}
+
+func (migrationSource *MigrationSource) Next(version uint) (uint, error) {
+	nextVersion, ok := migrationSource.migrations.Next(version)
+	if !ok {
+		return 0, &os.PathError{Op: fmt.Sprintf("next for version %d", version), Path: MIGRATION_SOURCE_NAME, Err: os.ErrNotExist}
+	}
+	return nextVersion, nil
+}
+
+func (migrationSource *MigrationSource) ReadUp(version uint) (io.ReadCloser, string, error) {
+	migration, ok := migrationSource.migrations.Up(version)
+	if !ok {
+		return nil, "", &os.PathError{Op: fmt.Sprintf("read up for version %d", version), Path: MIGRATION_SOURCE_NAME, Err: os.ErrNotExist}
+	}
+	return ioutil.NopCloser(strings.NewReader(migration.Raw)), migration.Identifier, nil
+}
+
+func (migrationSource *MigrationSource) ReadDown(version uint) (io.ReadCloser, string, error) {
+	migration, ok := migrationSource.migrations.Down(version)
+	if !ok {
+		return nil, "", &os.PathError{Op: fmt.Sprintf("read down for version %d", version), Path: MIGRATION_SOURCE_NAME, Err: os.ErrNotExist}
+	}
+	return ioutil.NopCloser(strings.NewReader(migration.Raw)), migration.Identifier, nil
+}
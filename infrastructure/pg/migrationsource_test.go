@@ -0,0 +1,46 @@
+package pg_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+)
+
+var _ = Describe("MigrationSource", func() {
+	It("should iterate the migrations in ascending order of version", func() {
+		migrationSource, err := pg.NewMigrationSource([]rdb.Migration{
+			{Version: 20, Name: "add_index", Up: "CREATE INDEX", Down: ""},
+			{Version: 10, Name: "create_table", Up: "CREATE TABLE", Down: "DROP TABLE"},
+		})
+		Expect(err).To(BeNil())
+
+		first, err := migrationSource.First()
+		Expect(err).To(BeNil())
+		Expect(first).To(Equal(uint(10)))
+		next, err := migrationSource.Next(first)
+		Expect(err).To(BeNil())
+		Expect(next).To(Equal(uint(20)))
+		_, err = migrationSource.Next(next)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		reader, identifier, err := migrationSource.ReadDown(10)
+		Expect(err).To(BeNil())
+		Expect(identifier).To(Equal("create_table"))
+		Expect(ioutil.ReadAll(reader)).To(Equal([]byte("DROP TABLE")))
+		_, _, err = migrationSource.ReadDown(20)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("should return error when migration versions are duplicated", func() {
+		_, err := pg.NewMigrationSource([]rdb.Migration{
+			{Version: 10, Name: "create_table", Up: "CREATE TABLE", Down: "DROP TABLE"},
+			{Version: 10, Name: "create_other_table", Up: "CREATE TABLE", Down: "DROP TABLE"},
+		})
+		Expect(err).To(MatchError("duplicated migration version 10"))
+	})
+})
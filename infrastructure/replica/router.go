@@ -0,0 +1,171 @@
+package replica
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+// Router is a rdb.Conn which sends reads to a read replica while the replica lag is within the
+// threshold, and to the primary otherwise. Writes and transactions always go to the primary.
+//
+// The lag is the largest difference between the last handled event heights of the projections on
+// the primary and on the replica. Until the first lag check, reads go to the primary. Statements
+// writing through Query or QueryRow, e.g. INSERT ... RETURNING, must use the primary connection.
+type Router struct {
+	logger applogger.Logger
+
+	primary rdb.Conn
+	replica rdb.Conn
+	// Maximum lag in blocks for reads to go to the replica
+	maxLag int64
+
+	heightStore *rdbprojectionbase.Store
+
+	mutex  sync.RWMutex
+	status Status
+}
+
+type Status struct {
+	IsUsingReplica bool  `json:"isUsingReplica"`
+	MaxLag         int64 `json:"maxLag"`
+	// Lag of the replica in blocks. Nil before the first successful check.
+	MaybeLag       *int64           `json:"lag"`
+	MaybeCheckedAt *utctime.UTCTime `json:"checkedAt"`
+	MaybeError     *string          `json:"error"`
+}
+
+func NewRouter(logger applogger.Logger, primary rdb.Conn, replica rdb.Conn, maxLag int64) *Router {
+	return &Router{
+		logger: logger.WithFields(applogger.LogFields{
+			"module": "ReplicaRouter",
+		}),
+
+		primary: primary,
+		replica: replica,
+		maxLag:  maxLag,
+
+		heightStore: rdbprojectionbase.NewStore(rdbprojectionbase.DEFAULT_TABLE),
+
+		status: Status{
+			IsUsingReplica: false,
+			MaxLag:         maxLag,
+		},
+	}
+}
+
+// Run checks the replica lag every interval. It never returns.
+func (router *Router) Run(interval time.Duration) {
+	for {
+		router.CheckLag()
+		<-time.After(interval)
+	}
+}
+
+// CheckLag measures the replica lag and routes the reads accordingly
+func (router *Router) CheckLag() Status {
+	lag, err := router.measureLag()
+	checkedAt := utctime.Now()
+
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+
+	wasUsingReplica := router.status.IsUsingReplica
+	router.status.MaybeCheckedAt = &checkedAt
+	if err != nil {
+		errMessage := err.Error()
+		router.status.MaybeError = &errMessage
+		router.status.MaybeLag = nil
+		router.status.IsUsingReplica = false
+	} else {
+		router.status.MaybeError = nil
+		router.status.MaybeLag = &lag
+		router.status.IsUsingReplica = lag <= router.maxLag
+	}
+
+	if wasUsingReplica && !router.status.IsUsingReplica {
+		if err != nil {
+			router.logger.Errorf("falling back to primary database: error checking replica lag: %v", err)
+		} else {
+			router.logger.Infof("falling back to primary database: replica lag %d exceeds %d", lag, router.maxLag)
+		}
+	} else if !wasUsingReplica && router.status.IsUsingReplica {
+		router.logger.Infof("routing reads to replica database: replica lag %d", lag)
+	}
+
+	return router.status
+}
+
+func (router *Router) Status() Status {
+	router.mutex.RLock()
+	defer router.mutex.RUnlock()
+
+	return router.status
+}
+
+func (router *Router) measureLag() (int64, error) {
+	primaryHeights, err := router.heightStore.GetAllLastHandledEventHeights(router.primary.ToHandle())
+	if err != nil {
+		return 0, err
+	}
+	replicaHeights, err := router.heightStore.GetAllLastHandledEventHeights(router.replica.ToHandle())
+	if err != nil {
+		return 0, err
+	}
+	if len(primaryHeights) > 0 && len(replicaHeights) == 0 {
+		return 0, errors.New("replica has no projection record")
+	}
+
+	var lag int64
+	for projectionId, primaryHeight := range primaryHeights {
+		if projectionLag := primaryHeight - replicaHeights[projectionId]; projectionLag > lag {
+			lag = projectionLag
+		}
+	}
+	return lag, nil
+}
+
+func (router *Router) reader() rdb.Conn {
+	router.mutex.RLock()
+	defer router.mutex.RUnlock()
+
+	if router.status.IsUsingReplica {
+		return router.replica
+	}
+	return router.primary
+}
+
+// Implements rdb.Conn
+func (router *Router) Begin() (rdb.Tx, error) {
+	return router.primary.Begin()
+}
+
+// Implements rdb.Conn
+func (router *Router) Exec(sql string, args ...interface{}) (rdb.ExecResult, error) {
+	return router.primary.Exec(sql, args...)
+}
+
+// Implements rdb.Conn
+func (router *Router) Query(sql string, args ...interface{}) (rdb.RowsResult, error) {
+	return router.reader().Query(sql, args...)
+}
+
+// Implements rdb.Conn
+func (router *Router) QueryRow(sql string, args ...interface{}) rdb.RowResult {
+	return router.reader().QueryRow(sql, args...)
+}
+
+// Implements rdb.Conn
+func (router *Router) ToHandle() *rdb.Handle {
+	primaryHandle := router.primary.ToHandle()
+	return &rdb.Handle{
+		Runner:      router,
+		TypeConv:    primaryHandle.TypeConv,
+		StmtBuilder: primaryHandle.StmtBuilder,
+	}
+}
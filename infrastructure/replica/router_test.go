@@ -0,0 +1,167 @@
+package replica_test
+
+import (
+	"errors"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/replica"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+)
+
+var _ = Describe("Router", func() {
+	var primary *fakeConn
+	var replicaConn *fakeConn
+	var router *replica.Router
+
+	BeforeEach(func() {
+		primary = newFakeConn("primary", map[string]int64{
+			"Block":       100,
+			"Transaction": 98,
+		})
+		replicaConn = newFakeConn("replica", map[string]int64{
+			"Block":       95,
+			"Transaction": 98,
+		})
+		router = replica.NewRouter(NewFakeLogger(), primary, replicaConn, 10)
+	})
+
+	It("should read from the primary before the first lag check", func() {
+		Expect(router.Status().IsUsingReplica).To(BeFalse())
+
+		_, _ = router.ToHandle().Query("SELECT 1")
+		Expect(primary.queries).To(Equal([]string{"SELECT 1"}))
+	})
+
+	It("should read from the replica when the lag is within the threshold", func() {
+		status := router.CheckLag()
+		Expect(status.IsUsingReplica).To(BeTrue())
+		Expect(*status.MaybeLag).To(Equal(int64(5)))
+
+		_, _ = router.ToHandle().Query("SELECT 1")
+		router.ToHandle().QueryRow("SELECT 2")
+		Expect(replicaConn.queries).To(ContainElements("SELECT 1", "SELECT 2"))
+	})
+
+	It("should always write to the primary", func() {
+		router.CheckLag()
+
+		_, _ = router.ToHandle().Exec("UPDATE x")
+		Expect(primary.queries).To(ContainElement("UPDATE x"))
+		Expect(replicaConn.queries).NotTo(ContainElement("UPDATE x"))
+	})
+
+	It("should fall back to the primary when the lag exceeds the threshold", func() {
+		router.CheckLag()
+		primary.heights["Block"] = 111
+
+		status := router.CheckLag()
+		Expect(status.IsUsingReplica).To(BeFalse())
+		Expect(*status.MaybeLag).To(Equal(int64(16)))
+
+		_, _ = router.ToHandle().Query("SELECT 1")
+		Expect(primary.queries).To(ContainElement("SELECT 1"))
+	})
+
+	It("should fall back to the primary when the replica is unavailable", func() {
+		router.CheckLag()
+		replicaConn.maybeErr = errors.New("connection refused")
+
+		status := router.CheckLag()
+		Expect(status.IsUsingReplica).To(BeFalse())
+		Expect(status.MaybeLag).To(BeNil())
+		Expect(*status.MaybeError).To(ContainSubstring("connection refused"))
+	})
+})
+
+type fakeConn struct {
+	name     string
+	heights  map[string]int64
+	maybeErr error
+
+	queries []string
+}
+
+func newFakeConn(name string, heights map[string]int64) *fakeConn {
+	return &fakeConn{
+		name:    name,
+		heights: heights,
+	}
+}
+
+func (conn *fakeConn) Begin() (rdb.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (conn *fakeConn) Exec(sql string, _ ...interface{}) (rdb.ExecResult, error) {
+	conn.queries = append(conn.queries, sql)
+	return nil, nil
+}
+
+// Query returns the projection heights for queries on the projections table
+func (conn *fakeConn) Query(sql string, _ ...interface{}) (rdb.RowsResult, error) {
+	if conn.maybeErr != nil {
+		return nil, conn.maybeErr
+	}
+	if sql != "SELECT id, last_handled_event_height FROM projections" {
+		conn.queries = append(conn.queries, sql)
+		return &fakeRows{}, nil
+	}
+
+	ids := make([]string, 0, len(conn.heights))
+	for id := range conn.heights {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	rows := &fakeRows{}
+	for _, id := range ids {
+		rows.rows = append(rows.rows, fakeRow{id, conn.heights[id]})
+	}
+	return rows, nil
+}
+
+func (conn *fakeConn) QueryRow(sql string, _ ...interface{}) rdb.RowResult {
+	conn.queries = append(conn.queries, sql)
+	return nil
+}
+
+func (conn *fakeConn) ToHandle() *rdb.Handle {
+	return &rdb.Handle{
+		Runner:      conn,
+		TypeConv:    nil,
+		StmtBuilder: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+type fakeRow struct {
+	id     string
+	height int64
+}
+
+type fakeRows struct {
+	rows    []fakeRow
+	current int
+}
+
+func (rows *fakeRows) Close()                     {}
+func (rows *fakeRows) Err() error                 { return nil }
+func (rows *fakeRows) ExecResult() rdb.ExecResult { return nil }
+
+func (rows *fakeRows) Next() bool {
+	if rows.current >= len(rows.rows) {
+		return false
+	}
+	rows.current += 1
+	return true
+}
+
+func (rows *fakeRows) Scan(dest ...interface{}) error {
+	row := rows.rows[rows.current-1]
+	*dest[0].(*string) = row.id
+	*dest[1].(*int64) = row.height
+	return nil
+}
@@ -0,0 +1,318 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+// Keywords shorter than this are not meaningful for trigram matching
+const MIN_KEYWORD_LENGTH = 3
+
+const (
+	RESULT_TYPE_TRANSACTION = "transaction"
+	RESULT_TYPE_VALIDATOR   = "validator"
+	RESULT_TYPE_PROPOSAL    = "proposal"
+	RESULT_TYPE_NFT_DENOM   = "nft_denom"
+	RESULT_TYPE_NFT_TOKEN   = "nft_token"
+)
+
+var ALL_RESULT_TYPES = []string{
+	RESULT_TYPE_TRANSACTION,
+	RESULT_TYPE_VALIDATOR,
+	RESULT_TYPE_PROPOSAL,
+	RESULT_TYPE_NFT_DENOM,
+	RESULT_TYPE_NFT_TOKEN,
+}
+
+// RESULT_TYPE_PROJECTIONS are the projections whose views are searched for the result types
+var RESULT_TYPE_PROJECTIONS = map[string]string{
+	RESULT_TYPE_TRANSACTION: "Transaction",
+	RESULT_TYPE_VALIDATOR:   "Validator",
+	RESULT_TYPE_PROPOSAL:    "Proposal",
+	RESULT_TYPE_NFT_DENOM:   "NFT",
+	RESULT_TYPE_NFT_TOKEN:   "NFT",
+}
+
+// Each sub-query selects (type, identity, maybe_parent_identity, title, block_height, rank) matching
+// the keyword, and the keyword as an escaped ILIKE pattern
+var resultTypeStmts = map[string]func(keyword string, pattern string) sq.SelectBuilder{
+	RESULT_TYPE_TRANSACTION: func(keyword string, pattern string) sq.SelectBuilder {
+		return sq.Select(
+			"'transaction' AS type",
+			"hash AS identity",
+			"NULL::VARCHAR AS maybe_parent_identity",
+			"memo AS title",
+			"COALESCE(block_height, 0) AS block_height",
+		).Column(
+			"CASE WHEN LOWER(memo) = LOWER(?) THEN 1 ELSE word_similarity(?, memo) END AS rank", keyword, keyword,
+		).From(
+			"view_transactions",
+		).Where(
+			"memo <> ''",
+		).Where(sq.Or{
+			sq.Expr("memo ILIKE ?", pattern),
+			sq.Expr("memo %> ?", keyword),
+		})
+	},
+	RESULT_TYPE_VALIDATOR: func(keyword string, pattern string) sq.SelectBuilder {
+		return sq.Select(
+			"'validator' AS type",
+			"operator_address AS identity",
+			"NULL::VARCHAR AS maybe_parent_identity",
+			"moniker AS title",
+			"joined_at_block_height AS block_height",
+		).Column(
+			"CASE WHEN LOWER(moniker) = LOWER(?) THEN 1 ELSE word_similarity(?, moniker) END AS rank", keyword, keyword,
+		).From(
+			"view_validators",
+		).Where(sq.Or{
+			sq.Expr("moniker ILIKE ?", pattern),
+			sq.Expr("moniker %> ?", keyword),
+		})
+	},
+	RESULT_TYPE_PROPOSAL: func(keyword string, pattern string) sq.SelectBuilder {
+		return sq.Select(
+			"'proposal' AS type",
+			"proposal_id AS identity",
+			"NULL::VARCHAR AS maybe_parent_identity",
+			"title",
+			"submit_block_height AS block_height",
+		).Column(
+			"GREATEST("+
+				"ts_rank(to_tsvector('english', title || ' ' || description), plainto_tsquery('english', ?)), "+
+				"word_similarity(?, title)"+
+				") AS rank", keyword, keyword,
+		).From(
+			"view_proposals",
+		).Where(sq.Or{
+			sq.Expr("to_tsvector('english', title || ' ' || description) @@ plainto_tsquery('english', ?)", keyword),
+			sq.Expr("title ILIKE ?", pattern),
+			sq.Expr("title %> ?", keyword),
+		})
+	},
+	RESULT_TYPE_NFT_DENOM: func(keyword string, pattern string) sq.SelectBuilder {
+		return sq.Select(
+			"'nft_denom' AS type",
+			"denom_id AS identity",
+			"NULL::VARCHAR AS maybe_parent_identity",
+			"COALESCE(name, denom_id) AS title",
+			"created_at_block_height AS block_height",
+		).Column(
+			"GREATEST(word_similarity(?, COALESCE(name, '')), word_similarity(?, denom_id)) AS rank", keyword, keyword,
+		).From(
+			"view_nft_denoms",
+		).Where(sq.Or{
+			sq.Expr("name ILIKE ?", pattern),
+			sq.Expr("name %> ?", keyword),
+			sq.Expr("denom_id ILIKE ?", pattern),
+		})
+	},
+	RESULT_TYPE_NFT_TOKEN: func(keyword string, pattern string) sq.SelectBuilder {
+		return sq.Select(
+			"'nft_token' AS type",
+			"token_id AS identity",
+			"denom_id AS maybe_parent_identity",
+			"COALESCE(name, token_id) AS title",
+			"minted_at_block_height AS block_height",
+		).Column(
+			"GREATEST(word_similarity(?, COALESCE(name, '')), word_similarity(?, token_id)) AS rank", keyword, keyword,
+		).From(
+			"view_nft_tokens",
+		).Where(sq.Or{
+			sq.Expr("name ILIKE ?", pattern),
+			sq.Expr("name %> ?", keyword),
+			sq.Expr("token_id ILIKE ?", pattern),
+		})
+	},
+}
+
+// FullTextSearch performs ranked trigram and full-text search across transaction memos, validator
+// monikers, proposal titles and descriptions, and NFT denom and token names. It is Postgres only, as
+// it relies on the pg_trgm extension and the Postgres full-text search functions.
+type FullTextSearch struct {
+	rdb *rdb.Handle
+
+	resultTypes []string
+}
+
+func NewFullTextSearch(handle *rdb.Handle) *FullTextSearch {
+	return &FullTextSearch{
+		handle,
+
+		ALL_RESULT_TYPES,
+	}
+}
+
+// WithProjections only searches the result types of the views of the projections. All result types
+// are searched by default.
+func (search *FullTextSearch) WithProjections(projections view.Projections) *FullTextSearch {
+	resultTypes := make([]string, 0, len(ALL_RESULT_TYPES))
+	for _, resultType := range ALL_RESULT_TYPES {
+		if projections.Serves(RESULT_TYPE_PROJECTIONS[resultType]) {
+			resultTypes = append(resultTypes, resultType)
+		}
+	}
+	search.resultTypes = resultTypes
+	return search
+}
+
+func (search *FullTextSearch) Search(
+	keyword string,
+	filter Filter,
+	pagination *pagination_interface.Pagination,
+) ([]Result, *pagination_interface.PaginationResult, error) {
+	if pagination.Type() != pagination_interface.PAGINATION_OFFSET {
+		return nil, nil, fmt.Errorf("unsupported pagination type: %s", pagination.Type())
+	}
+
+	// Result types of the projections not served have no view to search
+	resultTypes := search.resultTypes
+	if len(filter.ResultTypes) != 0 {
+		resultTypes = make([]string, 0, len(filter.ResultTypes))
+		for _, resultType := range filter.ResultTypes {
+			if containsResultType(search.resultTypes, resultType) {
+				resultTypes = append(resultTypes, resultType)
+			}
+		}
+	}
+	if len(resultTypes) == 0 {
+		return []Result{}, pagination.OffsetResult(0), nil
+	}
+	stmtBuilder, err := BuildStmt(search.rdb.StmtBuilder, resultTypes, keyword)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building search SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		search.rdb,
+	).BuildStmt(stmtBuilder)
+	paginatedSQL, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building search SQL: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := search.rdb.Query(paginatedSQL, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing search SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	results := make([]Result, 0)
+	for rowsResult.Next() {
+		var result Result
+		if err = rowsResult.Scan(
+			&result.Type,
+			&result.Identity,
+			&result.MaybeParentIdentity,
+			&result.Title,
+			&result.BlockHeight,
+			&result.Rank,
+		); err != nil {
+			return nil, nil, fmt.Errorf("error scanning search result row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		results = append(results, result)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return results, paginationResult, nil
+}
+
+// BuildStmt builds the ranked search statement over the provided result types
+func BuildStmt(
+	stmtBuilder sq.StatementBuilderType,
+	resultTypes []string,
+	keyword string,
+) (sq.SelectBuilder, error) {
+	pattern := ToILikePattern(keyword)
+
+	subSQLs := make([]string, 0, len(resultTypes))
+	subArgs := make([]interface{}, 0)
+	for _, resultType := range resultTypes {
+		subStmt, ok := resultTypeStmts[resultType]
+		if !ok {
+			return sq.SelectBuilder{}, fmt.Errorf("unsupported search result type: %s", resultType)
+		}
+		// Placeholders are replaced in the format of the statement builder once the statement is built
+		subSQL, args, err := subStmt(keyword, pattern).ToSql()
+		if err != nil {
+			return sq.SelectBuilder{}, fmt.Errorf("error building %s search SQL: %v", resultType, err)
+		}
+		subSQLs = append(subSQLs, fmt.Sprintf("(%s)", subSQL))
+		subArgs = append(subArgs, args...)
+	}
+
+	return stmtBuilder.Select(
+		"type",
+		"identity",
+		"maybe_parent_identity",
+		"title",
+		"block_height",
+		"rank::DOUBLE PRECISION AS rank",
+	).Prefix(
+		fmt.Sprintf("WITH search_results AS (%s)", strings.Join(subSQLs, " UNION ALL ")), subArgs...,
+	).From(
+		"search_results",
+	).OrderBy(
+		"rank DESC",
+		"block_height DESC",
+		"identity",
+	), nil
+}
+
+// ParseResultTypes parses a comma-separated list of result types
+func ParseResultTypes(value string) ([]string, error) {
+	resultTypes := make([]string, 0)
+	for _, resultType := range strings.Split(value, ",") {
+		resultType = strings.TrimSpace(resultType)
+		if resultType == "" {
+			continue
+		}
+		if _, ok := resultTypeStmts[resultType]; !ok {
+			return nil, fmt.Errorf("unsupported search result type: %s", resultType)
+		}
+		resultTypes = append(resultTypes, resultType)
+	}
+
+	return resultTypes, nil
+}
+
+func containsResultType(resultTypes []string, target string) bool {
+	for _, resultType := range resultTypes {
+		if resultType == target {
+			return true
+		}
+	}
+	return false
+}
+
+// ToILikePattern escapes the ILIKE wildcards in the keyword and wraps it for a substring match
+func ToILikePattern(keyword string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(keyword)
+	return fmt.Sprintf("%%%s%%", escaped)
+}
+
+type Filter struct {
+	// Empty means all result types searched
+	ResultTypes []string
+}
+
+type Result struct {
+	Type                string  `json:"type"`
+	Identity            string  `json:"identity"`
+	MaybeParentIdentity *string `json:"parentIdentity"`
+	Title               string  `json:"title"`
+	BlockHeight         int64   `json:"blockHeight"`
+	Rank                float64 `json:"rank"`
+}
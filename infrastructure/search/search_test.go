@@ -0,0 +1,83 @@
+package search_test
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/infrastructure/search"
+)
+
+var _ = Describe("Search", func() {
+	Describe("ParseResultTypes", func() {
+		It("should parse comma-separated result types", func() {
+			resultTypes, err := search.ParseResultTypes("transaction, nft_token,")
+			Expect(err).To(BeNil())
+			Expect(resultTypes).To(Equal([]string{
+				search.RESULT_TYPE_TRANSACTION,
+				search.RESULT_TYPE_NFT_TOKEN,
+			}))
+		})
+
+		It("should return error when result type is unsupported", func() {
+			_, err := search.ParseResultTypes("transaction,block")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("BuildStmt", func() {
+		stmtBuilder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+		It("should union the sub-queries of the provided result types only", func() {
+			stmt, err := search.BuildStmt(stmtBuilder, []string{
+				search.RESULT_TYPE_VALIDATOR,
+				search.RESULT_TYPE_PROPOSAL,
+			}, "any")
+			Expect(err).To(BeNil())
+			sql, args, err := stmt.ToSql()
+			Expect(err).To(BeNil())
+			Expect(sql).To(ContainSubstring("FROM view_validators"))
+			Expect(sql).To(ContainSubstring("FROM view_proposals"))
+			Expect(sql).NotTo(ContainSubstring("FROM view_transactions"))
+			Expect(sql).To(ContainSubstring(" UNION ALL "))
+			Expect(sql).To(HaveSuffix("ORDER BY rank DESC, block_height DESC, identity"))
+			Expect(sql).NotTo(ContainSubstring("?"))
+			Expect(sql).To(ContainSubstring(fmt.Sprintf("$%d", len(args))))
+			Expect(args).To(ContainElement("%any%"))
+		})
+
+		It("should return error when result type is unsupported", func() {
+			_, err := search.BuildStmt(stmtBuilder, []string{"block"}, "any")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("FullTextSearch", func() {
+		It("should not search the result types of the projections not served", func() {
+			fullTextSearch := search.NewFullTextSearch(nil).WithProjections(view.Projections{"Block"})
+
+			results, paginationResult, err := fullTextSearch.Search(
+				"any", search.Filter{}, pagination.NewOffsetPagination(1, 20),
+			)
+			Expect(err).To(BeNil())
+			Expect(results).To(BeEmpty())
+			Expect(paginationResult.OffsetResult().TotalRecord).To(Equal(int64(0)))
+
+			results, _, err = fullTextSearch.Search("any", search.Filter{
+				ResultTypes: []string{search.RESULT_TYPE_NFT_TOKEN},
+			}, pagination.NewOffsetPagination(1, 20))
+			Expect(err).To(BeNil())
+			Expect(results).To(BeEmpty())
+		})
+	})
+
+	Describe("ToILikePattern", func() {
+		It("should escape ILIKE wildcards", func() {
+			Expect(search.ToILikePattern(`100%_off\`)).To(Equal(`%100\%\_off\\%`))
+		})
+	})
+})
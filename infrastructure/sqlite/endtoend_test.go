@@ -0,0 +1,275 @@
+package sqlite_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/eventhandler"
+	"github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/blobstore"
+	"github.com/crypto-com/chain-indexing/infrastructure/sqlite"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/block"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	"github.com/crypto-com/chain-indexing/projection/transaction"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("End-to-end", func() {
+	var tempDir string
+	var config *sqlite.ConnConfig
+	var conn *sqlite.SQLiteConn
+	var sqliteMigrate *sqlite.Migrate
+	var registry *entity_event.Registry
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "chain-indexing-sqlite")
+		Expect(err).To(BeNil())
+
+		config = &sqlite.ConnConfig{
+			Path: filepath.Join(tempDir, "test.db"),
+		}
+		sqliteMigrate = sqlite.MustNewMigrate(config, migrationsFolder())
+		sqliteMigrate.MustUp()
+		conn = sqlite.MustNewSQLiteConn(config)
+
+		registry = entity_event.NewRegistry()
+		event_usecase.RegisterEvents(registry)
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		_, _ = sqliteMigrate.Close()
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	It("should migrate all the way up and down", func() {
+		version, isDirty, err := sqliteMigrate.Version()
+		Expect(err).To(BeNil())
+		Expect(isDirty).To(BeFalse())
+		Expect(version).To(BeNumerically(">", 0))
+
+		Expect(sqliteMigrate.Down()).To(Succeed())
+
+		var count int64
+		Expect(conn.QueryRow(
+			"SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'events'",
+		).Scan(&count)).To(Succeed())
+		Expect(count).To(Equal(int64(0)))
+	})
+
+	It("should persist events and project them into Blocks and Transactions views", func() {
+		logger := NewFakeLogger()
+
+		eventStoreHandler := eventhandler.NewRDbEventStoreHandler(logger, conn, registry)
+		Expect(eventStoreHandler.GetLastHandledEventHeight()).To(BeNil())
+
+		for height := int64(1); height <= 2; height++ {
+			Expect(eventStoreHandler.HandleEvents(height, anyBlockEvents(height))).To(Succeed())
+		}
+		Expect(eventStoreHandler.GetLastHandledEventHeight()).To(Equal(primptr.Int64(2)))
+
+		eventStore := event.NewRDbStore(conn.ToHandle(), registry)
+		Expect(eventStore.GetLatestHeight()).To(Equal(primptr.Int64(2)))
+
+		blockProjection := block.NewBlock(logger, conn)
+		transactionProjection := transaction.NewTransaction(logger, conn)
+		for height := int64(1); height <= 2; height++ {
+			events, err := eventStore.GetAllByHeight(height)
+			Expect(err).To(BeNil())
+			Expect(events).To(HaveLen(3))
+
+			blockEvents := make([]entity_event.Event, 0)
+			for _, event := range events {
+				if event.Name() == event_usecase.BLOCK_CREATED {
+					blockEvents = append(blockEvents, event)
+				}
+			}
+			Expect(blockProjection.HandleEvents(height, blockEvents)).To(Succeed())
+			Expect(transactionProjection.HandleEvents(height, events)).To(Succeed())
+		}
+		Expect(blockProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(2)))
+		Expect(transactionProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(2)))
+
+		blocksView := block_view.NewBlocks(conn.ToHandle())
+		blocks, paginationResult, err := blocksView.List(block_view.BlocksListOrder{
+			Height: view.ORDER_DESC,
+		}, pagination.NewOffsetPagination(1, 10))
+		Expect(err).To(BeNil())
+		Expect(paginationResult.OffsetResult().TotalRecord).To(Equal(int64(2)))
+		Expect(blocks).To(HaveLen(2))
+		Expect(blocks[0].Height).To(Equal(int64(2)))
+		Expect(blocks[0].Time).To(Equal(utctime.FromUnixNano(int64(2000000))))
+		Expect(blocks[0].TransactionCount).To(Equal(1))
+		Expect(blocks[0].CommittedCouncilNodes).To(HaveLen(1))
+		Expect(blocks[0].CommittedCouncilNodes[0].IsProposer).To(BeTrue())
+
+		transactionsView := transaction_view.NewTransactions(conn.ToHandle())
+		transactions, paginationResult, err := transactionsView.List(
+			transaction_view.TransactionsListFilter{},
+			transaction_view.TransactionsListOrder{
+				Height: view.ORDER_ASC,
+			},
+			pagination.NewOffsetPagination(1, 10),
+		)
+		Expect(err).To(BeNil())
+		Expect(paginationResult.OffsetResult().TotalRecord).To(Equal(int64(2)))
+		Expect(transactions).To(HaveLen(2))
+		Expect(transactions[0].BlockHeight).To(Equal(int64(1)))
+		Expect(transactions[0].BlockHash).To(Equal(anyBlockHash(1)))
+		Expect(transactions[0].Success).To(BeTrue())
+		Expect(transactions[0].Fee).To(Equal(coin.NewCoins(coin.NewInt64Coin("basecro", 1000))))
+		Expect(transactions[0].Messages).To(HaveLen(1))
+		Expect(transactions[0].Messages[0].Type).To(Equal(event_usecase.MSG_SEND))
+
+		transaction, err := transactionsView.FindByHash(anyTxHash(2))
+		Expect(err).To(BeNil())
+		Expect(transaction.BlockHeight).To(Equal(int64(2)))
+		Expect(transaction.BlockTime).To(Equal(utctime.FromUnixNano(int64(2000000))))
+
+		Expect(transaction_view.NewTransactionsTotal(conn.ToHandle()).FindBy("2")).To(Equal(int64(1)))
+	})
+
+	It("should project the heights of a batch in a single transaction", func() {
+		logger := NewFakeLogger()
+		blockProjection := block.NewBlock(logger, conn)
+
+		batch := make([]entity_projection.HeightEvents, 0)
+		for height := int64(1); height <= 2; height++ {
+			batch = append(batch, entity_projection.HeightEvents{
+				Height: height,
+				Events: anyBlockEvents(height)[:1],
+			})
+		}
+		Expect(blockProjection.HandleEventsBatch(batch)).To(Succeed())
+		Expect(blockProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(2)))
+
+		// A failing height fails the whole batch
+		failingBatch := []entity_projection.HeightEvents{
+			{Height: 3, Events: []entity_event.Event{}},
+			{Height: 4, Events: anyBlockEvents(1)[1:2]},
+		}
+		Expect(blockProjection.HandleEventsBatch(failingBatch)).NotTo(Succeed())
+		Expect(blockProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(2)))
+
+		_, paginationResult, err := block_view.NewBlocks(conn.ToHandle()).List(block_view.BlocksListOrder{
+			Height: view.ORDER_ASC,
+		}, pagination.NewOffsetPagination(1, 10))
+		Expect(err).To(BeNil())
+		Expect(paginationResult.OffsetResult().TotalRecord).To(Equal(int64(2)))
+	})
+
+	It("should move large event payloads to the blob store and resolve them for the listening projections", func() {
+		logger := NewFakeLogger()
+		blobStore, err := blobstore.NewFSBlobStore(filepath.Join(tempDir, "blobs"))
+		Expect(err).To(BeNil())
+		registry.WithBlobStore(blobStore)
+
+		// Only the BlockCreated events are large enough to be moved
+		eventStore := event.NewRDbStore(conn.ToHandle(), registry).WithBlobStore(blobStore, 600)
+		for height := int64(1); height <= 2; height++ {
+			Expect(eventStore.InsertAll(anyBlockEvents(height))).To(Succeed())
+		}
+
+		var payload string
+		Expect(conn.QueryRow(
+			"SELECT payload FROM events WHERE name = ? AND height = 1", event_usecase.BLOCK_CREATED,
+		).Scan(&payload)).To(Succeed())
+		Expect(payload).To(ContainSubstring(`"blobRef":"sha256:`))
+
+		events, err := eventStore.GetAllByHeight(1)
+		Expect(err).To(BeNil())
+		Expect(events).To(HaveLen(3))
+		Expect(events[0]).To(BeAssignableToTypeOf(&entity_event.LazyEvent{}))
+		Expect(events[1]).To(BeAssignableToTypeOf(&event_usecase.TransactionCreated{}))
+		resolved, err := entity_event.Resolve(events[0])
+		Expect(err).To(BeNil())
+		Expect(resolved.(*event_usecase.BlockCreated).Block.Hash).To(Equal(anyBlockHash(1)))
+
+		blockProjection := block.NewBlock(logger, conn)
+		manager := entity_projection.NewStoreBasedManager(logger, eventStore)
+		Expect(manager.RegisterProjection(blockProjection)).To(Succeed())
+		Expect(manager.SyncProjection(blockProjection)).To(Succeed())
+
+		blocks, _, err := block_view.NewBlocks(conn.ToHandle()).List(block_view.BlocksListOrder{
+			Height: view.ORDER_ASC,
+		}, pagination.NewOffsetPagination(1, 10))
+		Expect(err).To(BeNil())
+		Expect(blocks).To(HaveLen(2))
+		Expect(blocks[1].Hash).To(Equal(anyBlockHash(2)))
+	})
+})
+
+func anyBlockHash(height int64) string {
+	return []string{
+		"B69554A020537DA8E7C7610A318180C09BFEB91229BB85D4A78DDA2FACF68A48",
+		"C8D9C71D3E8B2ED7B2E36A5E1F5E1C8B0D1C2D8A8C0B7E5D3D2A1F4E6C8B9A0D",
+	}[height-1]
+}
+
+func anyTxHash(height int64) string {
+	return []string{
+		"4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416",
+		"2A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F70819",
+	}[height-1]
+}
+
+// anyBlockEvents returns the events of a block with a single successful MsgSend transaction
+func anyBlockEvents(height int64) []entity_event.Event {
+	proposerAddress := "F9E6FFB9B536956201AA138224FD888D03775AB4"
+	blockTime := utctime.FromUnixNano(height * int64(1000000))
+
+	return []entity_event.Event{
+		event_usecase.NewBlockCreated(&usecase_model.Block{
+			Height:          height,
+			Hash:            anyBlockHash(height),
+			Time:            blockTime,
+			AppHash:         "24474D86CBFA7E6328D473C17A9E46CD5A80FFE82A348A74844BF3E2BA2B3AF1",
+			ProposerAddress: proposerAddress,
+			Txs:             []string{"AAAMZqICtpjLrA3uEe3Rkg6cqDgQl0iBwG1Wm8ORZRzKL9EBAE1R0oP73H8A"},
+			Signatures: []usecase_model.BlockSignature{
+				{
+					BlockIdFlag:      2,
+					ValidatorAddress: proposerAddress,
+					Timestamp:        blockTime,
+					Signature:        "ZW2pUcKFN/oPQCmdCouchXmgpPyd/Ddo45dhHEMwsBeHTBuSJh15zUMmfl5FZsPHeKC8citFvOm/52bgl5XHCw==",
+				},
+			},
+		}),
+		event_usecase.NewTransactionCreated(height, usecase_model.CreateTransactionParams{
+			TxHash:    anyTxHash(height),
+			Index:     0,
+			Code:      0,
+			Log:       "[]",
+			MsgCount:  1,
+			Signers:   []usecase_model.TransactionSigner{},
+			Fee:       coin.NewCoins(coin.NewInt64Coin("basecro", 1000)),
+			GasWanted: 200000,
+			GasUsed:   60000,
+		}),
+		event_usecase.NewMsgSend(event_usecase.MsgCommonParams{
+			BlockHeight: height,
+			TxHash:      anyTxHash(height),
+			TxSuccess:   true,
+			MsgIndex:    0,
+		}, event_usecase.MsgSendCreatedParams{
+			FromAddress: "tcro165tzcrh2yl83g8qeqxueg2gq2ecgmcl2hcjyuu",
+			ToAddress:   "tcro184lta2lsyu47vwyp2e8zmtca3k5yq85p6c4vp3",
+			Amount:      coin.NewCoins(coin.NewInt64Coin("basecro", 100)),
+		}),
+	}
+}
@@ -0,0 +1,116 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+
+	gomigrate "github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+// Path of the SQLite migrations relative to the repository root. The Postgres migrations under
+// "migrations" do not run on SQLite.
+const DEFAULT_MIGRATIONS_FOLDER = "migrations/sqlite"
+
+var _ rdb.Migrate = &Migrate{}
+
+type Migrate struct {
+	*gomigrate.Migrate
+
+	config       *ConnConfig
+	sourceFolder string
+}
+
+func MustNewMigrate(config *ConnConfig, sourceFolder string) *Migrate {
+	sqliteMigrate, err := NewMigrate(config, sourceFolder)
+	if err != nil {
+		panic(err)
+	}
+
+	return sqliteMigrate
+}
+
+func NewMigrate(config *ConnConfig, sourceFolder string) (*Migrate, error) {
+	m, err := _newMigrate(config, sourceFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrate{
+		m,
+
+		config,
+		sourceFolder,
+	}, nil
+}
+
+func _newMigrate(config *ConnConfig, sourceFolder string) (*gomigrate.Migrate, error) {
+	return gomigrate.New(
+		fmt.Sprintf("file://%s", sourceFolder),
+		fmt.Sprintf("sqlite3://%s", config.Path),
+	)
+}
+
+func (m *Migrate) MustUp() {
+	if err := m.Up(); err != nil {
+		panic(err)
+	}
+}
+
+func (m *Migrate) Up() error {
+	if err := m.Migrate.Up(); err != nil {
+		if errors.Is(err, gomigrate.ErrNoChange) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *Migrate) MustDown() {
+	if err := m.Down(); err != nil {
+		panic(err)
+	}
+}
+
+func (m *Migrate) Down() error {
+	if err := m.Migrate.Down(); err != nil {
+		if errors.Is(err, gomigrate.ErrNoChange) {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (m *Migrate) MustReset() {
+	if err := m.Reset(); err != nil {
+		panic(err)
+	}
+}
+
+func (m *Migrate) Reset() error {
+	if err := m.Force(0); err != nil {
+		return err
+	}
+	if err := m.Drop(); err != nil {
+		return err
+	}
+
+	// "golang-migrate" does not re-create its version table after `Drop()`, the client has to be
+	// re-created. See the Postgres implementation for details.
+	migrate, err := _newMigrate(m.config, m.sourceFolder)
+	if err != nil {
+		return err
+	}
+	m.Migrate = migrate
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	// Register the "sqlite3" database/sql driver
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+var SQLiteStmtBuilder = sq.StatementBuilder.PlaceholderFormat(sq.Question)
+
+const DEFAULT_BUSY_TIMEOUT = 5 * time.Second
+
+type ConnConfig struct {
+	// Path to the database file. Every connection of ":memory:" opens a separate database, so it
+	// must be a file.
+	Path string
+	// How long a write waits for the lock held by another connection before failing
+	BusyTimeout time.Duration
+}
+
+// ToDSN returns the go-sqlite3 data source name. Write-ahead logging is enabled so that reads are
+// not blocked by an ongoing write, and transactions take the write lock when they begin so that
+// concurrent transactions wait for each other instead of failing on upgrade.
+func (config *ConnConfig) ToDSN() string {
+	busyTimeout := config.BusyTimeout
+	if busyTimeout == 0 {
+		busyTimeout = DEFAULT_BUSY_TIMEOUT
+	}
+
+	return fmt.Sprintf(
+		"file:%s?_busy_timeout=%d&_journal_mode=WAL&_txlock=immediate",
+		config.Path, busyTimeout.Milliseconds(),
+	)
+}
+
+var _ rdb.Conn = &SQLiteConn{}
+
+// SQLiteConn is a SQLite implementation of rdb.Conn intended for local development and tests
+type SQLiteConn struct {
+	db *sql.DB
+}
+
+func MustNewSQLiteConn(config *ConnConfig) *SQLiteConn {
+	if conn, err := NewSQLiteConn(config); err != nil {
+		panic(err)
+	} else {
+		return conn
+	}
+}
+
+func NewSQLiteConn(config *ConnConfig) (*SQLiteConn, error) {
+	db, err := sql.Open("sqlite3", config.ToDSN())
+	if err != nil {
+		return nil, err
+	}
+	if err = db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return &SQLiteConn{
+		db,
+	}, nil
+}
+
+func (conn *SQLiteConn) Close() error {
+	return conn.db.Close()
+}
+
+func (conn *SQLiteConn) Begin() (rdb.Tx, error) {
+	tx, err := conn.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &SQLiteRDbTx{
+		tx,
+	}, nil
+}
+func (conn *SQLiteConn) Exec(sql string, args ...interface{}) (rdb.ExecResult, error) {
+	result, err := conn.db.Exec(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLiteRDbExecResult(sql, result)
+}
+func (conn *SQLiteConn) Query(sql string, args ...interface{}) (rdb.RowsResult, error) {
+	rows, err := conn.db.Query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &SQLiteRDbRowsResult{
+		rows: rows,
+	}, nil
+}
+func (conn *SQLiteConn) QueryRow(sql string, args ...interface{}) rdb.RowResult {
+	return &SQLiteRDbRowResult{
+		row: conn.db.QueryRow(sql, args...),
+	}
+}
+func (conn *SQLiteConn) ToHandle() *rdb.Handle {
+	return &rdb.Handle{
+		Runner:   conn,
+		TypeConv: &SQLiteTypeConv{},
+
+		StmtBuilder: SQLiteStmtBuilder,
+	}
+}
+
+var _ rdb.Tx = &SQLiteRDbTx{}
+
+type SQLiteRDbTx struct {
+	tx *sql.Tx
+}
+
+func (tx *SQLiteRDbTx) Exec(sql string, args ...interface{}) (rdb.ExecResult, error) {
+	result, err := tx.tx.Exec(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLiteRDbExecResult(sql, result)
+}
+func (tx *SQLiteRDbTx) Query(sql string, args ...interface{}) (rdb.RowsResult, error) {
+	rows, err := tx.tx.Query(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &SQLiteRDbRowsResult{
+		rows: rows,
+	}, nil
+}
+func (tx *SQLiteRDbTx) QueryRow(sql string, args ...interface{}) rdb.RowResult {
+	return &SQLiteRDbRowResult{
+		row: tx.tx.QueryRow(sql, args...),
+	}
+}
+func (tx *SQLiteRDbTx) Commit() error {
+	return tx.tx.Commit()
+}
+func (tx *SQLiteRDbTx) Rollback() error {
+	return tx.tx.Rollback()
+}
+func (tx *SQLiteRDbTx) ToHandle() *rdb.Handle {
+	return &rdb.Handle{
+		Runner:   tx,
+		TypeConv: &SQLiteTypeConv{},
+
+		StmtBuilder: SQLiteStmtBuilder,
+	}
+}
+
+// SQLiteRDbExecResult mimics the Postgres command tag. SQLite does not report the kind of the
+// executed statement, so it is taken from the first keyword of the SQL.
+type SQLiteRDbExecResult struct {
+	command      string
+	rowsAffected int64
+}
+
+func NewSQLiteRDbExecResult(sql string, result sql.Result) (*SQLiteRDbExecResult, error) {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLiteRDbExecResult{
+		command:      statementCommand(sql),
+		rowsAffected: rowsAffected,
+	}, nil
+}
+
+func statementCommand(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToUpper(fields[0])
+}
+
+func (result *SQLiteRDbExecResult) RowsAffected() int64 {
+	return result.rowsAffected
+}
+func (result *SQLiteRDbExecResult) IsInsert() bool {
+	return result.command == "INSERT"
+}
+func (result *SQLiteRDbExecResult) IsUpdate() bool {
+	return result.command == "UPDATE"
+}
+func (result *SQLiteRDbExecResult) IsDelete() bool {
+	return result.command == "DELETE"
+}
+func (result *SQLiteRDbExecResult) IsSelect() bool {
+	return result.command == "SELECT"
+}
+func (result *SQLiteRDbExecResult) String() string {
+	return fmt.Sprintf("%s %d", result.command, result.rowsAffected)
+}
+
+type SQLiteRDbRowsResult struct {
+	rows *sql.Rows
+
+	rowCount int64
+}
+
+func (result *SQLiteRDbRowsResult) Close() {
+	_ = result.rows.Close()
+}
+func (result *SQLiteRDbRowsResult) Err() error {
+	return result.rows.Err()
+}
+func (result *SQLiteRDbRowsResult) ExecResult() rdb.ExecResult {
+	return &SQLiteRDbExecResult{
+		command:      "SELECT",
+		rowsAffected: result.rowCount,
+	}
+}
+func (result *SQLiteRDbRowsResult) Next() bool {
+	hasNext := result.rows.Next()
+	if hasNext {
+		result.rowCount += 1
+	}
+	return hasNext
+}
+func (result *SQLiteRDbRowsResult) Scan(dest ...interface{}) error {
+	err := result.rows.Scan(dest...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return rdb.ErrNoRows
+		}
+		return err
+	}
+	return nil
+}
+
+type SQLiteRDbRowResult struct {
+	row *sql.Row
+}
+
+func (result *SQLiteRDbRowResult) Scan(dest ...interface{}) error {
+	err := result.row.Scan(dest...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return rdb.ErrNoRows
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package sqlite_test
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/infrastructure/sqlite"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+var _ = Describe("SQLiteConn", func() {
+	var tempDir string
+	var conn *sqlite.SQLiteConn
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "chain-indexing-sqlite")
+		Expect(err).To(BeNil())
+
+		conn = sqlite.MustNewSQLiteConn(&sqlite.ConnConfig{
+			Path: filepath.Join(tempDir, "test.db"),
+		})
+		_, err = conn.Exec("CREATE TABLE test (id INTEGER PRIMARY KEY, amount TEXT NULL, time BIGINT NULL)")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	It("should build statements with question mark placeholders", func() {
+		sql, args, err := conn.ToHandle().StmtBuilder.Select("id").From("test").Where("id = ?", 1).ToSql()
+		Expect(err).To(BeNil())
+		Expect(sql).To(Equal("SELECT id FROM test WHERE id = ?"))
+		Expect(args).To(Equal([]interface{}{1}))
+	})
+
+	It("should report affected rows and statement kind of executed statements", func() {
+		result, err := conn.Exec("INSERT INTO test (id) VALUES (?), (?)", 1, 2)
+		Expect(err).To(BeNil())
+		Expect(result.RowsAffected()).To(Equal(int64(2)))
+		Expect(result.IsInsert()).To(BeTrue())
+		Expect(result.String()).To(Equal("INSERT 2"))
+
+		result, err = conn.Exec("UPDATE test SET amount = ? WHERE id = ?", "1", 1)
+		Expect(err).To(BeNil())
+		Expect(result.RowsAffected()).To(Equal(int64(1)))
+		Expect(result.IsUpdate()).To(BeTrue())
+
+		rows, err := conn.Query("SELECT id FROM test ORDER BY id")
+		Expect(err).To(BeNil())
+		ids := make([]int64, 0)
+		for rows.Next() {
+			var id int64
+			Expect(rows.Scan(&id)).To(Succeed())
+			ids = append(ids, id)
+		}
+		Expect(rows.Err()).To(BeNil())
+		Expect(rows.ExecResult().IsSelect()).To(BeTrue())
+		Expect(rows.ExecResult().RowsAffected()).To(Equal(int64(2)))
+		rows.Close()
+		Expect(ids).To(Equal([]int64{1, 2}))
+	})
+
+	It("should return rdb.ErrNoRows when no row is found", func() {
+		var id int64
+		err := conn.QueryRow("SELECT id FROM test WHERE id = ?", 1).Scan(&id)
+		Expect(err).To(Equal(rdb.ErrNoRows))
+	})
+
+	It("should discard changes of rolled back transaction", func() {
+		tx, err := conn.Begin()
+		Expect(err).To(BeNil())
+		_, err = tx.Exec("INSERT INTO test (id) VALUES (?)", 1)
+		Expect(err).To(BeNil())
+		Expect(tx.Rollback()).To(Succeed())
+
+		tx, err = conn.Begin()
+		Expect(err).To(BeNil())
+		_, err = tx.Exec("INSERT INTO test (id) VALUES (?)", 2)
+		Expect(err).To(BeNil())
+		Expect(tx.Commit()).To(Succeed())
+
+		var count int64
+		Expect(conn.QueryRow("SELECT COUNT(*) FROM test").Scan(&count)).To(Succeed())
+		Expect(count).To(Equal(int64(1)))
+	})
+
+	It("should convert big numbers and time without losing precision", func() {
+		handle := conn.ToHandle()
+		amount, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+		anyTime := utctime.FromUnixNano(int64(1612345678123456789))
+
+		_, err := handle.Exec(
+			"INSERT INTO test (id, amount, time) VALUES (?, ?, ?), (?, ?, ?)",
+			1, handle.Bton(amount), handle.Tton(&anyTime),
+			2, handle.Bton(nil), handle.Tton(nil),
+		)
+		Expect(err).To(BeNil())
+
+		amountReader := handle.NtobReader()
+		timeReader := handle.NtotReader()
+		Expect(handle.QueryRow(
+			"SELECT amount, time FROM test WHERE id = ?", 1,
+		).Scan(amountReader.ScannableArg(), timeReader.ScannableArg())).To(Succeed())
+		Expect(amountReader.Parse()).To(Equal(amount))
+		Expect(timeReader.Parse()).To(Equal(&anyTime))
+
+		amountReader = handle.NtobReader()
+		timeReader = handle.NtotReader()
+		Expect(handle.QueryRow(
+			"SELECT amount, time FROM test WHERE id = ?", 2,
+		).Scan(amountReader.ScannableArg(), timeReader.ScannableArg())).To(Succeed())
+		Expect(amountReader.Parse()).To(BeNil())
+		Expect(timeReader.Parse()).To(BeNil())
+	})
+})
+
+func migrationsFolder() string {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		panic("error retrieving file directory")
+	}
+	return filepath.Join(filename, "../../..", sqlite.DEFAULT_MIGRATIONS_FOLDER)
+}
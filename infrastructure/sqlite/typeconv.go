@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+var _ rdb.TypeConv = &SQLiteTypeConv{}
+
+// SQLiteTypeConv stores big numbers as decimal strings because SQLite has no arbitrary precision
+// numeric type. Columns holding them must be declared as TEXT, otherwise SQLite converts the
+// strings to lossy floating point numbers.
+type SQLiteTypeConv struct{}
+
+func (conv *SQLiteTypeConv) Bton(b *big.Int) interface{} {
+	if b == nil {
+		return nil
+	}
+	return b.Text(10)
+}
+func (conv *SQLiteTypeConv) BFton(b *big.Float) interface{} {
+	if b == nil {
+		return nil
+	}
+	return b.Text('f', 10)
+}
+func (conv *SQLiteTypeConv) Iton(i int) interface{} {
+	return int64(i)
+}
+func (conv *SQLiteTypeConv) NtobReader() rdb.NtobReader {
+	return new(SQLiteNtobReader)
+}
+
+type SQLiteNtobReader struct {
+	num *string
+}
+
+func (reader *SQLiteNtobReader) ScannableArg() interface{} {
+	return &reader.num
+}
+func (reader *SQLiteNtobReader) Parse() (*big.Int, error) {
+	if reader.num == nil {
+		return nil, nil
+	}
+
+	i, ok := new(big.Int).SetString(*reader.num, 10)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %v to bigInt", *reader.num)
+	}
+	return i, nil
+}
+
+func (conv *SQLiteTypeConv) NtobfReader() rdb.NtobfReader {
+	return new(SQLiteNtobfReader)
+}
+
+type SQLiteNtobfReader struct {
+	num *string
+}
+
+func (reader *SQLiteNtobfReader) ScannableArg() interface{} {
+	return &reader.num
+}
+func (reader *SQLiteNtobfReader) Parse() (*big.Float, error) {
+	if reader.num == nil {
+		return nil, nil
+	}
+
+	result, _, err := new(big.Float).Parse(*reader.num, 10)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (conv *SQLiteTypeConv) Tton(t *utctime.UTCTime) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UnixNano()
+}
+
+func (conv *SQLiteTypeConv) NtotReader() rdb.NtotReader {
+	return new(SQLiteNtotReader)
+}
+
+type SQLiteNtotReader struct {
+	unixNano *int64
+}
+
+func (reader *SQLiteNtotReader) ScannableArg() interface{} {
+	return &reader.unixNano
+}
+func (reader *SQLiteNtotReader) Parse() (*utctime.UTCTime, error) {
+	if reader.unixNano == nil {
+		return nil, nil
+	}
+	t := utctime.FromUnixNano(*reader.unixNano)
+	return &t, nil
+}
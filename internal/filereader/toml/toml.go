@@ -1,6 +1,7 @@
 package toml
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -43,3 +44,14 @@ func (reader *Reader) Read(value interface{}) error {
 
 	return nil
 }
+
+// DecodeMap decodes the values of a TOML table already decoded into a map, rejecting the keys not in
+// the value like Read
+func DecodeMap(values map[string]interface{}, value interface{}) error {
+	var buffer bytes.Buffer
+	if err := gotoml.NewEncoder(&buffer).Encode(values); err != nil {
+		return fmt.Errorf("error encoding TOML: %v", err)
+	}
+
+	return FromIOReader(&buffer).Read(value)
+}
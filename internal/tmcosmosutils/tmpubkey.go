@@ -2,6 +2,8 @@ package tmcosmosutils
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"sort"
 
@@ -190,6 +192,71 @@ func PubKeyFromCosmosPubKey(accountPubKey string) ([]byte, error) {
 	return pubKey.Bytes(), nil
 }
 
+// ParsePubKey parses a public key in hex, base64 or bech32 encoding. The type of the key is taken
+// from the bech32 encoding, or from the key length otherwise: 33 bytes for a compressed secp256k1
+// account key and 32 bytes for an ed25519 consensus key.
+func ParsePubKey(value string) (cryptotypes.PubKey, error) {
+	if _, conv, err := bech32.Decode(value); err == nil {
+		pkToUnmarshal, err := bech32.ConvertBits(conv, 5, 8, false)
+		if err != nil {
+			return nil, fmt.Errorf("error converting bech32 bits to public key: %v", err)
+		}
+		var pubKey cryptotypes.PubKey
+		if err := legacy.Cdc.UnmarshalBinaryBare(pkToUnmarshal, &pubKey); err != nil {
+			return nil, fmt.Errorf("error unmarshalling bech32 public key: %v", err)
+		}
+		return pubKey, nil
+	}
+
+	rawPubKey, err := decodeRawPubKey(value)
+	if err != nil {
+		return nil, err
+	}
+	switch len(rawPubKey) {
+	case secp256k1.PubKeySize:
+		return &secp256k1.PubKey{Key: rawPubKey}, nil
+	case ed25519.PubKeySize:
+		return &ed25519.PubKey{Key: rawPubKey}, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key length: %d", len(rawPubKey))
+	}
+}
+
+func decodeRawPubKey(value string) ([]byte, error) {
+	// Hex encoded keys are longer than the base64 encoded ones of the same length
+	if len(value) == secp256k1.PubKeySize*2 || len(value) == ed25519.PubKeySize*2 {
+		if rawPubKey, err := hex.DecodeString(value); err == nil {
+			return rawPubKey, nil
+		}
+	}
+	if rawPubKey, err := base64.StdEncoding.DecodeString(value); err == nil {
+		return rawPubKey, nil
+	}
+	if rawPubKey, err := base64.URLEncoding.DecodeString(value); err == nil {
+		return rawPubKey, nil
+	}
+	rawPubKey, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding public key: %v", err)
+	}
+	return rawPubKey, nil
+}
+
+// AddressFromTypedPubKey returns the address of the public key derived by its type, e.g. an account
+// address of a secp256k1 key or a consensus node address of an ed25519 key
+func AddressFromTypedPubKey(bech32Prefix string, pubKey cryptotypes.PubKey) (string, error) {
+	conv, err := bech32.ConvertBits(pubKey.Address().Bytes(), 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("error converting public key address to bech32 bits: %v", err)
+	}
+	address, err := bech32.Encode(bech32Prefix, conv)
+	if err != nil {
+		return "", fmt.Errorf("error encoding public key address bits: %v", err)
+	}
+
+	return address, nil
+}
+
 func MustMultiSigAddressFromPubKeys(
 	bech32Prefix string,
 	pubKeys [][]byte,
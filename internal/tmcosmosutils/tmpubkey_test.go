@@ -80,6 +80,60 @@ var _ = Describe("tmcosmosutils", func() {
 		})
 	})
 
+	Describe("ParsePubKey", func() {
+		It("should parse a hex encoded secp256k1 public key", func() {
+			pubKey, err := tmcosmosutils.ParsePubKey(
+				"03111b1b3f58acca19b7c3a3a82e1a759c84828496dc53cd67f1f8fd743a8d4220",
+			)
+			Expect(err).To(BeNil())
+			Expect(pubKey.Type()).To(Equal("secp256k1"))
+			Expect(base64.StdEncoding.EncodeToString(pubKey.Bytes())).To(Equal(
+				"AxEbGz9YrMoZt8OjqC4adZyEgoSW3FPNZ/H4/XQ6jUIg",
+			))
+		})
+
+		It("should parse a base64 encoded secp256k1 public key", func() {
+			pubKey, err := tmcosmosutils.ParsePubKey("A3ill3YNyWvcMstrbssC9SpzhMm+tCMWPB7bgOqWQZYk")
+			Expect(err).To(BeNil())
+			Expect(pubKey.Type()).To(Equal("secp256k1"))
+			Expect(tmcosmosutils.AddressFromTypedPubKey(
+				"tcro", pubKey,
+			)).To(Equal("tcro1p4fzn6ta24c6ek4v2qls6y5uug44ku9tnypcaf"))
+		})
+
+		It("should parse a base64 encoded ed25519 public key", func() {
+			pubKey, err := tmcosmosutils.ParsePubKey(tendermintPubKey)
+			Expect(err).To(BeNil())
+			Expect(pubKey.Type()).To(Equal("ed25519"))
+			Expect(tmcosmosutils.AddressFromTypedPubKey(
+				"tcrocnclcons", pubKey,
+			)).To(Equal(consensusNodeAddress))
+		})
+
+		It("should parse a bech32 encoded public key", func() {
+			pubKey, err := tmcosmosutils.ParsePubKey(consensusNodePubKey)
+			Expect(err).To(BeNil())
+			Expect(pubKey.Type()).To(Equal("ed25519"))
+			Expect(base64.StdEncoding.EncodeToString(pubKey.Bytes())).To(Equal(tendermintPubKey))
+
+			pubKey, err = tmcosmosutils.ParsePubKey(
+				"tcropub1addwnpepqvg3kxeltzkv5xdhcw36sts6wkwgfq5yjmw98nt878u06ap634pzqdgcjrz",
+			)
+			Expect(err).To(BeNil())
+			Expect(pubKey.Type()).To(Equal("secp256k1"))
+		})
+
+		It("should return error when the public key length is unsupported", func() {
+			_, err := tmcosmosutils.ParsePubKey(base64.StdEncoding.EncodeToString([]byte("invalid")))
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return error when the public key is not encoded", func() {
+			_, err := tmcosmosutils.ParsePubKey("not a public key")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
 	Describe("MultiSigAddressFromPubKeys", func() {
 		It("should work", func() {
 			pubKey1, _ := tmcosmosutils.PubKeyFromCosmosPubKey(
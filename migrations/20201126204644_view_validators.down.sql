@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_validators;
\ No newline at end of file
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_validator_activities;
\ No newline at end of file
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_block_events_total;
\ No newline at end of file
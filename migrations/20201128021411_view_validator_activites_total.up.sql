@@ -1,5 +0,0 @@
-CREATE TABLE view_validator_activities_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_blocks_height_brin_index;
@@ -1 +0,0 @@
-CREATE INDEX view_blocks_height_brin_index ON view_blocks USING brin (height);
\ No newline at end of file
@@ -1 +0,0 @@
-CREATE INDEX view_blocks_hash_btree_index ON view_blocks USING btree (hash);
@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_transactions_block_height_brin_index;
@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_transactions_block_hash_btree_index;
\ No newline at end of file
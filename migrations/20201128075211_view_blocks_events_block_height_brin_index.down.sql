@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_block_events_block_height_brin_index;
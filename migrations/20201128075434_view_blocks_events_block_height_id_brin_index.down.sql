@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_block_events_block_height_id_brin_index;
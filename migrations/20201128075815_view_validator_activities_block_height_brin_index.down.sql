@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_validator_activities_block_height_brin_index;
\ No newline at end of file
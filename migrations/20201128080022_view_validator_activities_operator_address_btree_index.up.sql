@@ -1 +0,0 @@
-CREATE INDEX view_validator_activities_operator_address_btree_index ON view_validator_activities USING btree (operator_address);
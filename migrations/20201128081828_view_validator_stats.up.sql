@@ -1,5 +0,0 @@
-CREATE TABLE view_validator_stats (
-    metrics VARCHAR,
-    value VARCHAR,
-    PRIMARY KEY (metrics)
-)
\ No newline at end of file
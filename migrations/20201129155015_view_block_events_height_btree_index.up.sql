@@ -1 +0,0 @@
-CREATE INDEX view_block_events_block_height_btree_index ON view_block_events USING btree (block_height);
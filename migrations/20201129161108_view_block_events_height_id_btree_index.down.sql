@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_block_events_block_height_id_btree_index;
\ No newline at end of file
@@ -1 +0,0 @@
-CREATE INDEX view_validator_activities_block_height_btree_index ON view_validator_activities USING btree (block_height);
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_transactions_total;
\ No newline at end of file
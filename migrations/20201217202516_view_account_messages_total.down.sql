@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_account_messages_total;
\ No newline at end of file
@@ -1,5 +0,0 @@
-CREATE TABLE view_account_transactions_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
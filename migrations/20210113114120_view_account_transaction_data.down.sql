@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_account_transaction_data;
\ No newline at end of file
@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_transactions_block_height_desc_id_btree_index;
\ No newline at end of file
@@ -1 +0,0 @@
-CREATE INDEX view_account_transactions_account_btree_index ON view_account_transactions USING btree (account);
@@ -1,3 +0,0 @@
-ALTER TABLE view_validators
-    DROP COLUMN tendermint_pubkey,
-    DROP COLUMN tendermint_address;
\ No newline at end of file
@@ -1,3 +0,0 @@
-ALTER TABLE view_validators
-    ADD unbonding_height BIGINT NULL,
-    ADD unbonding_completion_time BIGINT NULL;
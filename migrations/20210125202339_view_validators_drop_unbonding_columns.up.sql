@@ -1,3 +0,0 @@
-ALTER TABLE view_validators
-    DROP COLUMN unbonding_height,
-    DROP COLUMN unbonding_completion_time;
@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_validator_block_commitments_block_height_btree_index;
\ No newline at end of file
@@ -1 +0,0 @@
-CREATE INDEX view_validator_block_commitments_block_height_btree_index ON view_validator_block_commitments USING btree(block_height);
\ No newline at end of file
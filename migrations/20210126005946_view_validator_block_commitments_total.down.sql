@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_validator_block_commitments_total;
\ No newline at end of file
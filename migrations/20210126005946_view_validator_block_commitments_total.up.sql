@@ -1,5 +0,0 @@
-CREATE TABLE view_validator_block_commitments_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_account_transaction_data_block_height_hash_btree_index;
\ No newline at end of file
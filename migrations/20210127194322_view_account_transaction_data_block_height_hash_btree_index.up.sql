@@ -1 +0,0 @@
-CREATE INDEX view_account_transaction_data_block_height_hash_btree_index ON view_account_transaction_data USING btree (block_height, hash);
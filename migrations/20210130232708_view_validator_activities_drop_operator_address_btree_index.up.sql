@@ -1 +0,0 @@
-DROP INDEX view_validator_activities_operator_address_btree_index;
\ No newline at end of file
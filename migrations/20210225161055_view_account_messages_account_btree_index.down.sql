@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_account_messages_account_btree_index;
\ No newline at end of file
@@ -1 +0,0 @@
-CREATE INDEX view_account_messages_account_btree_index ON view_account_messages USING btree (account);
\ No newline at end of file
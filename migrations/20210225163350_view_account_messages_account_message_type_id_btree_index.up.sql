@@ -1 +0,0 @@
-CREATE INDEX view_account_messages_account_message_type_id_btree_index ON view_account_messages USING btree (account, message_type, id);
\ No newline at end of file
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_chain_stats;
\ No newline at end of file
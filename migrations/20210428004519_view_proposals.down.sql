@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_proposals;
\ No newline at end of file
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_proposal_params;
\ No newline at end of file
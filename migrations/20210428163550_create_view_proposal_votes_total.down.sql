@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_proposal_votes_total;
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_proposal_depositors_total;
\ No newline at end of file
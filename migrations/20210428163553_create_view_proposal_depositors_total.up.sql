@@ -1,5 +0,0 @@
-CREATE TABLE view_proposal_depositors_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
@@ -1,4 +0,0 @@
-ALTER TABLE view_validators
-    DROP COLUMN total_signed_block,
-    DROP COLUMN total_active_block,
-    DROP COLUMN imprecise_up_time;
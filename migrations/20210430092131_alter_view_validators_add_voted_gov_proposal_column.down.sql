@@ -1,2 +0,0 @@
-ALTER TABLE view_validators
-    DROP COLUMN voted_gov_proposal;
\ No newline at end of file
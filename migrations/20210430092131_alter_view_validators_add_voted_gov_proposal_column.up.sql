@@ -1,2 +0,0 @@
-ALTER TABLE view_validators
-    ADD voted_gov_proposal NUMERIC
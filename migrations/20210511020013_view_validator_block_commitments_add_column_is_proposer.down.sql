@@ -1 +0,0 @@
-ALTER TABLE view_validator_block_commitments DROP COLUMN is_proposer;
@@ -1 +0,0 @@
-ALTER TABLE view_validator_block_commitments ADD COLUMN is_proposer BOOLEAN NOT NULL;
\ No newline at end of file
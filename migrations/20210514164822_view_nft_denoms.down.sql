@@ -1 +0,0 @@
-DROP TABLE view_nft_denoms;
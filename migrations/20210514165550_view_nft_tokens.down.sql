@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_nft_tokens;
\ No newline at end of file
@@ -1 +0,0 @@
-DROP TABLE view_nft_token_transfers;
\ No newline at end of file
@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_nft_denoms_total;
\ No newline at end of file
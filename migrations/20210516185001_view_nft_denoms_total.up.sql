@@ -1,5 +0,0 @@
-CREATE TABLE view_nft_denoms_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
@@ -1,5 +0,0 @@
-CREATE TABLE view_nft_messages_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
@@ -1,5 +0,0 @@
-CREATE TABLE view_nft_token_transfers_total (
-    identity VARCHAR,
-    total BIGINT NOT NULL,
-    PRIMARY KEY (identity)
-)
\ No newline at end of file
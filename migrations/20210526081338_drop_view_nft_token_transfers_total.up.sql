@@ -1 +0,0 @@
-DROP TABLE IF EXISTS view_nft_token_transfers_total;
\ No newline at end of file
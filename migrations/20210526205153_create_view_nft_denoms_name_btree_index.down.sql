@@ -1 +0,0 @@
-DROP INDEX IF EXISTS view_nft_denoms_name_btree_index;
\ No newline at end of file
@@ -1,3 +0,0 @@
-ALTER TABLE view_nft_tokens
-    DROP minted_at_block_height,
-    DROP last_edited_at_block_height;
\ No newline at end of file
@@ -1,3 +0,0 @@
-ALTER TABLE view_nft_tokens
-    ADD minted_at_block_height BIGINT NOT NULL,
-    ADD last_edited_at_block_height BIGINT NOT NULL;
\ No newline at end of file
@@ -1,2 +0,0 @@
-ALTER TABLE view_nft_denoms
-    DROP created_at_block_height;
\ No newline at end of file
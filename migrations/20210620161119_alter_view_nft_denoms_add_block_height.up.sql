@@ -1,2 +0,0 @@
-ALTER TABLE view_nft_denoms
-    ADD created_at_block_height BIGINT NOT NULL;
\ No newline at end of file
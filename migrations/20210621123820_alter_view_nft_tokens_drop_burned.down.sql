@@ -1,2 +0,0 @@
-ALTER TABLE view_nft_tokens
-    ADD burned BOOL NOT NULL DEFAULT FALSE;
\ No newline at end of file
@@ -1,2 +0,0 @@
-ALTER TABLE view_nft_tokens
-    DROP burned;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE api_keys (
+    id BIGSERIAL,
+    name VARCHAR NOT NULL,
+    key_prefix VARCHAR NOT NULL,
+    key_hash VARCHAR NOT NULL,
+    tier VARCHAR NOT NULL,
+    created_at BIGINT NOT NULL,
+    revoked_at BIGINT NULL,
+    PRIMARY KEY (id),
+    UNIQUE (key_hash)
+);
+
+CREATE TABLE api_key_usages (
+    api_key_id BIGINT NOT NULL REFERENCES api_keys (id),
+    date DATE NOT NULL,
+    request_count BIGINT NOT NULL,
+    rejected_count BIGINT NOT NULL,
+    PRIMARY KEY (api_key_id, date)
+);
@@ -0,0 +1,40 @@
+-- The events of archived partitions are only in the archive files, and the same uuid may be used at
+-- different heights of a partitioned table. Refuse to revert instead of losing events or failing
+-- halfway through the copy.
+DO $$
+DECLARE
+    min_height INT;
+    duplicate_uuid VARCHAR;
+BEGIN
+    -- Events are indexed from the first block, so a higher lowest height means its partitions were
+    -- archived
+    SELECT MIN(height) INTO min_height FROM events;
+    IF min_height > 1 THEN
+        RAISE EXCEPTION 'events below height % are archived, restore them into the events table before reverting the partitioning', min_height;
+    END IF;
+
+    SELECT uuid INTO duplicate_uuid FROM events WHERE uuid IS NOT NULL GROUP BY uuid HAVING COUNT(*) > 1 LIMIT 1;
+    IF duplicate_uuid IS NOT NULL THEN
+        RAISE EXCEPTION 'event uuid % is used at more than one height, the unpartitioned events table requires unique uuids', duplicate_uuid;
+    END IF;
+END $$;
+
+CREATE TABLE events_unpartitioned (
+    id BIGINT NOT NULL DEFAULT nextval('events_id_seq'),
+    uuid VARCHAR,
+    height INT NOT NULL,
+    name VARCHAR NOT NULL,
+    version INT NOT NULL,
+    payload JSONB NOT NULL,
+    PRIMARY KEY(id),
+    UNIQUE(uuid)
+);
+INSERT INTO events_unpartitioned (id, uuid, height, name, version, payload)
+SELECT id, uuid, height, name, version, payload FROM events;
+ALTER SEQUENCE events_id_seq OWNED BY events_unpartitioned.id;
+
+DROP TABLE events;
+ALTER TABLE events_unpartitioned RENAME TO events;
+ALTER TABLE events RENAME CONSTRAINT events_unpartitioned_pkey TO events_pkey;
+ALTER TABLE events RENAME CONSTRAINT events_unpartitioned_uuid_key TO events_uuid_key;
+CREATE INDEX events_block_height_btree_index ON events USING btree (height);
@@ -0,0 +1,50 @@
+-- Re-create events as a table partitioned by height range. Unique constraints of a partitioned
+-- table must include the partition key. Requires Postgres 11 or later.
+ALTER TABLE events RENAME TO events_unpartitioned;
+ALTER TABLE events_unpartitioned DROP CONSTRAINT events_pkey;
+ALTER TABLE events_unpartitioned DROP CONSTRAINT events_uuid_key;
+ALTER INDEX events_block_height_btree_index RENAME TO events_unpartitioned_block_height_btree_index;
+
+CREATE TABLE events (
+    id BIGINT NOT NULL DEFAULT nextval('events_id_seq'),
+    uuid VARCHAR,
+    height INT NOT NULL,
+    name VARCHAR NOT NULL,
+    version INT NOT NULL,
+    payload JSONB NOT NULL,
+    PRIMARY KEY(id, height),
+    UNIQUE(uuid, height)
+) PARTITION BY RANGE (height);
+ALTER SEQUENCE events_id_seq OWNED BY events.id;
+CREATE INDEX events_block_height_btree_index ON events USING btree (height);
+
+-- Stores the events of heights without a range partition. It stays empty as long as the range
+-- partitions are created by the event store before inserting.
+CREATE TABLE events_default PARTITION OF events DEFAULT;
+
+-- The existing events are attached as the first partition instead of being copied, up to the next
+-- multiple of the default partition size of the event store. Attaching only scans the rows to check
+-- the partition bounds and builds the unique indexes of the partitioned table. The height index is
+-- reused.
+DO $$
+DECLARE
+    partition_size CONSTANT INT := 100000;
+    max_height INT;
+    partition_to INT;
+    partition_name VARCHAR;
+BEGIN
+    SELECT MAX(height) INTO max_height FROM events_unpartitioned;
+    IF max_height IS NULL THEN
+        DROP TABLE events_unpartitioned;
+        RETURN;
+    END IF;
+
+    partition_to := (max_height / partition_size + 1) * partition_size;
+    partition_name := 'events_0_' || partition_to;
+    EXECUTE format('ALTER TABLE events_unpartitioned RENAME TO %I', partition_name);
+    EXECUTE format(
+        'ALTER TABLE events ATTACH PARTITION %I FOR VALUES FROM (0) TO (%s)',
+        partition_name,
+        partition_to
+    );
+END $$;
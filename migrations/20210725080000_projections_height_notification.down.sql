@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS projections_height_notification ON projections;
+DROP FUNCTION IF EXISTS notify_projection_height();
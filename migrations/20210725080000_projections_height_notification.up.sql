@@ -0,0 +1,10 @@
+CREATE FUNCTION notify_projection_height() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('projection_heights', NEW.id || ':' || NEW.last_handled_event_height);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER projections_height_notification
+    AFTER INSERT OR UPDATE OF last_handled_event_height ON projections
+    FOR EACH ROW EXECUTE PROCEDURE notify_projection_height();
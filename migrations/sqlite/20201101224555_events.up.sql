@@ -0,0 +1,9 @@
+CREATE TABLE events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    uuid VARCHAR,
+    height INT NOT NULL,
+    name VARCHAR NOT NULL,
+    version INT NOT NULL,
+    payload TEXT NOT NULL,
+    UNIQUE(uuid)
+);
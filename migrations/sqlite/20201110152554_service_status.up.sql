@@ -0,0 +1,4 @@
+CREATE TABLE service_status
+(
+    last_indexed_block_height BIGINT NULL
+);
@@ -0,0 +1 @@
+CREATE INDEX events_block_height_btree_index ON events (height);
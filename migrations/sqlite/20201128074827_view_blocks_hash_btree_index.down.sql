@@ -0,0 +1 @@
+DROP INDEX IF EXISTS view_blocks_hash_btree_index;
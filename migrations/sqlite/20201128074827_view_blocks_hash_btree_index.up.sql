@@ -0,0 +1 @@
+CREATE INDEX view_blocks_hash_btree_index ON view_blocks (hash);
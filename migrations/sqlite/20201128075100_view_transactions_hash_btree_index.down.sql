@@ -0,0 +1 @@
+DROP INDEX IF EXISTS view_transactions_block_hash_btree_index;
@@ -1 +1 @@
-DROP INDEX IF EXISTS view_transactions_block_height_id_btree_index;
\ No newline at end of file
+DROP INDEX IF EXISTS view_transactions_block_height_id_btree_index;
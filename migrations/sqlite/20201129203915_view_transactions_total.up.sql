@@ -2,4 +2,4 @@ CREATE TABLE view_transactions_total (
     identity VARCHAR,
     total BIGINT NOT NULL,
     PRIMARY KEY (identity)
-)
+);
@@ -12,10 +12,13 @@ import (
 	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
 )
 
+var _ projection_entity.DependentProjection = &Account{}
+
 // Account number, sequence number, balances are fetched from the latest state (regardless of current replaying height)
 type Account struct {
 	*rdbprojectionbase.Base
@@ -45,6 +48,12 @@ func (_ *Account) GetEventsToListen() []string {
 	}
 }
 
+// GetDependencies returns the Validator projection. The accounts API serves the accounts along with
+// the validators they operate from the validators view, so the accounts view never gets ahead of it.
+func (_ *Account) GetDependencies() []string {
+	return []string{"Validator"}
+}
+
 func (projection *Account) OnInit() error {
 	return nil
 }
@@ -0,0 +1,23 @@
+package account
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201214121738,
+		Name:    "view_accounts",
+		Up: `CREATE TABLE view_accounts (
+    address VARCHAR,
+    account_type VARCHAR,
+    name VARCHAR NULL,
+    pubkey VARCHAR NULL,
+    account_number BIGINT,
+    sequence_number BIGINT,
+    balance JSONB,
+    PRIMARY KEY(address)
+);`,
+		Down: `DROP TABLE IF EXISTS view_accounts;`,
+	},
+}
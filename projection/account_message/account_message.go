@@ -1,7 +1,9 @@
 package account_message
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
 
@@ -26,20 +28,84 @@ type AccountMessage struct {
 	logger  applogger.Logger
 
 	accountAddressPrefix string
+	config               Config
+}
+
+// Config decides the messages recorded. All messages are recorded by default.
+type Config struct {
+	// Message types to record, e.g. `MsgSend`. All message types are recorded when it is empty.
+	IncludeMessageTypes []string `toml:"include_message_types"`
+	// Message types not to record
+	ExcludeMessageTypes []string `toml:"exclude_message_types"`
+	// Whether to skip the messages of failed transactions
+	ExcludeFailed bool `toml:"exclude_failed"`
+	// Prefix of the names of the view tables, set from the projection Id by the projection registry
+	TablePrefix string `toml:"-"`
+}
+
+func (config Config) Validate() error {
+	if len(config.IncludeMessageTypes) > 0 && len(config.ExcludeMessageTypes) > 0 {
+		return errors.New("only one of include_message_types and exclude_message_types can be set")
+	}
+
+	knownMessageTypes := make(map[string]bool)
+	for _, eventName := range event_usecase.MSG_EVENTS {
+		messageType := strings.TrimSuffix(eventName, event_usecase.MSG_SUCCESS_SUFFIX)
+		messageType = strings.TrimSuffix(messageType, event_usecase.MSG_FAILED_SUFFIX)
+		knownMessageTypes[messageType] = true
+	}
+	for _, messageType := range append(append([]string{}, config.IncludeMessageTypes...), config.ExcludeMessageTypes...) {
+		if !knownMessageTypes[messageType] {
+			return fmt.Errorf("unknown message type: %s", messageType)
+		}
+	}
+	return nil
+}
+
+func (config Config) isIncluded(row *view.AccountMessageRow) bool {
+	if config.ExcludeFailed && !row.Success {
+		return false
+	}
+	if len(config.IncludeMessageTypes) > 0 {
+		return containsString(config.IncludeMessageTypes, row.MessageType)
+	}
+	return !containsString(config.ExcludeMessageTypes, row.MessageType)
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
 }
 
 func NewAccountMessage(
 	logger applogger.Logger,
 	rdbConn rdb.Conn,
 	accountAddressPrefix string,
+) *AccountMessage {
+	return NewAccountMessageWithConfig(logger, rdbConn, "AccountMessage", accountAddressPrefix, Config{})
+}
+
+// NewAccountMessageWithConfig creates the projection under the Id recording the messages decided by
+// the config
+func NewAccountMessageWithConfig(
+	logger applogger.Logger,
+	rdbConn rdb.Conn,
+	projectionId string,
+	accountAddressPrefix string,
+	config Config,
 ) *AccountMessage {
 	return &AccountMessage{
-		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "AccountMessage"),
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), projectionId),
 
 		rdbConn,
 		logger,
 
 		accountAddressPrefix,
+		config,
 	}
 }
 
@@ -81,8 +147,8 @@ func (projection *AccountMessage) HandleEvents(height int64, events []event_enti
 		return nil
 	}
 
-	accountMessagesView := view.NewAccountMessages(rdbTxHandle)
-	accountMessagesTotalView := view.NewAccountMessagesTotal(rdbTxHandle)
+	accountMessagesView := view.NewAccountMessagesWithTablePrefix(rdbTxHandle, projection.config.TablePrefix)
+	accountMessagesTotalView := view.NewAccountMessagesTotalWithTablePrefix(rdbTxHandle, projection.config.TablePrefix)
 
 	var blockTime utctime.UTCTime
 	var blockHash string
@@ -129,6 +195,23 @@ func (projection *AccountMessage) HandleEvents(height int64, events []event_enti
 				},
 				Accounts: involvedAccounts,
 			})
+		} else if typedEvent, ok := event.(*event_usecase.MsgCreateVestingAccount); ok {
+			accountMessages = append(accountMessages, view.AccountMessageRecord{
+				Row: view.AccountMessageRow{
+					BlockHeight:     height,
+					BlockHash:       "",
+					BlockTime:       utctime.UTCTime{},
+					TransactionHash: typedEvent.TxHash(),
+					Success:         typedEvent.TxSuccess(),
+					MessageIndex:    typedEvent.MsgIndex,
+					MessageType:     typedEvent.MsgType(),
+					Data:            typedEvent,
+				},
+				Accounts: []string{
+					typedEvent.FromAddress,
+					typedEvent.ToAddress,
+				},
+			})
 		} else if typedEvent, ok := event.(*event_usecase.MsgSetWithdrawAddress); ok {
 			accountMessages = append(accountMessages, view.AccountMessageRecord{
 				Row: view.AccountMessageRow{
@@ -498,6 +581,9 @@ func (projection *AccountMessage) HandleEvents(height int64, events []event_enti
 	}
 
 	for i, accountMessage := range accountMessages {
+		if !projection.config.isIncluded(&accountMessage.Row) {
+			continue
+		}
 		// TODO: Change to use InsertAll
 		accountMessages[i].Row.BlockHash = blockHash
 		accountMessages[i].Row.BlockTime = blockTime
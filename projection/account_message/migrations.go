@@ -0,0 +1,60 @@
+package account_message
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201217193434,
+		Name:    "view_account_messages",
+		Up: `CREATE TABLE view_account_messages (
+    id BIGSERIAL,
+    block_height BIGINT,
+    block_hash VARCHAR NOT NULL,
+    block_time BIGINT NOT NULL,
+    account VARCHAR NOT NULL,
+    transaction_hash VARCHAR NOT NULL,
+    success BOOLEAN NOT NULL,
+    message_index INT NOT NULL,
+    message_type VARCHAR NOT nULL,
+    data JSONB NOT NULL,
+    PRIMARY KEY (id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_account_messages;`,
+	},
+	{
+		Version: 20201217202516,
+		Name:    "view_account_messages_total",
+		Up: `CREATE TABLE view_account_messages_total (
+     identity VARCHAR,
+     total BIGINT NOT NULL,
+     PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_account_messages_total;`,
+	},
+	{
+		Version: 20210225161055,
+		Name:    "view_account_messages_account_btree_index",
+		Up:      `CREATE INDEX view_account_messages_account_btree_index ON view_account_messages USING btree (account);`,
+		Down:    `DROP INDEX IF EXISTS view_account_messages_account_btree_index;`,
+	},
+	{
+		Version: 20210225161111,
+		Name:    "view_account_messages_account_message_type_btree_index",
+		Up:      `CREATE INDEX view_account_messages_account_message_type_btree_index ON view_account_messages USING btree (account, message_type);`,
+		Down:    `DROP INDEX IF EXISTS view_account_messages_account_message_type_btree_index;`,
+	},
+	{
+		Version: 20210225163350,
+		Name:    "view_account_messages_account_message_type_id_btree_index",
+		Up:      `CREATE INDEX view_account_messages_account_message_type_id_btree_index ON view_account_messages USING btree (account, message_type, id);`,
+		Down:    `DROP INDEX IF EXISTS view_account_messages_account_message_type_id_btree_index;`,
+	},
+	{
+		Version: 20210225163402,
+		Name:    "view_account_messages_account_id_btree_index",
+		Up:      `CREATE INDEX view_account_messages_account_id_btree_index ON view_account_messages USING btree (account, id);`,
+		Down:    `DROP INDEX IF EXISTS view_account_messages_account_id_btree_index;`,
+	},
+}
@@ -15,14 +15,23 @@ import (
 	"github.com/crypto-com/chain-indexing/internal/utctime"
 )
 
+const ACCOUNT_MESSAGES_TABLE_NAME = "view_account_messages"
+
 // BlockTransactions projection view implemented by relational database
 type AccountMessages struct {
-	rdb *rdb.Handle
+	rdb    *rdb.Handle
+	tables Tables
 }
 
 func NewAccountMessages(handle *rdb.Handle) *AccountMessages {
+	return NewAccountMessagesWithTablePrefix(handle, "")
+}
+
+// NewAccountMessagesWithTablePrefix creates the view of the tables named with the table prefix
+func NewAccountMessagesWithTablePrefix(handle *rdb.Handle, tablePrefix string) *AccountMessages {
 	return &AccountMessages{
 		handle,
+		NewTables(tablePrefix),
 	}
 }
 
@@ -33,7 +42,7 @@ func (accountMessagesView *AccountMessages) Insert(messageRow *AccountMessageRow
 	}
 
 	stmtBuilder := accountMessagesView.rdb.StmtBuilder.Insert(
-		"view_account_messages",
+		accountMessagesView.tables.AccountMessages,
 	).Columns(
 		"block_height",
 		"block_hash",
@@ -82,19 +91,19 @@ func (accountMessagesView *AccountMessages) List(
 	pagination *pagination_interface.Pagination,
 ) ([]AccountMessageRow, *pagination_interface.PaginationResult, error) {
 	stmtBuilder := accountMessagesView.rdb.StmtBuilder.Select(
-		"view_account_messages.account",
-		"view_account_messages.block_height",
-		"view_account_messages.block_hash",
-		"view_account_messages.block_time",
-		"view_account_messages.transaction_hash",
-		"view_account_messages.success",
-		"view_account_messages.message_index",
-		"view_account_messages.message_type",
-		"view_account_messages.data",
+		fmt.Sprintf("%s.account", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.block_height", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.block_hash", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.block_time", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.transaction_hash", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.success", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.message_index", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.message_type", accountMessagesView.tables.AccountMessages),
+		fmt.Sprintf("%s.data", accountMessagesView.tables.AccountMessages),
 	).From(
-		"view_account_messages",
+		accountMessagesView.tables.AccountMessages,
 	).Where(
-		"view_account_messages.account = ?", filter.Account,
+		fmt.Sprintf("%s.account = ?", accountMessagesView.tables.AccountMessages), filter.Account,
 	)
 
 	var totalIdentities []string
@@ -102,7 +111,9 @@ func (accountMessagesView *AccountMessages) List(
 		totalIdentities = []string{fmt.Sprintf("%s:-", filter.Account)}
 	} else {
 		totalIdentities = make([]string, 0)
-		stmtBuilder = stmtBuilder.Where(sq.Eq{"view_account_messages.message_type": filter.MaybeMsgTypes})
+		stmtBuilder = stmtBuilder.Where(sq.Eq{
+			fmt.Sprintf("%s.message_type", accountMessagesView.tables.AccountMessages): filter.MaybeMsgTypes,
+		})
 		for _, msgType := range filter.MaybeMsgTypes {
 			totalIdentities = append(totalIdentities, fmt.Sprintf("%s:%s", filter.Account, msgType))
 		}
@@ -119,7 +130,7 @@ func (accountMessagesView *AccountMessages) List(
 		accountMessagesView.rdb,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
-			totalView := NewAccountMessagesTotal(rdbHandle)
+			totalView := NewAccountMessagesTotalWithTablePrefix(rdbHandle, accountMessagesView.tables.Prefix)
 			total, err := totalView.SumBy(totalIdentities)
 			if err != nil {
 				return int64(0), err
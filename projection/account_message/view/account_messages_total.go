@@ -5,12 +5,19 @@ import (
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 )
 
+const ACCOUNT_MESSAGES_TOTAL_TABLE_NAME = "view_account_messages_total"
+
 type AccountMessagesTotal struct {
 	*view.Total
 }
 
 func NewAccountMessagesTotal(rdbHandle *rdb.Handle) *AccountMessagesTotal {
+	return NewAccountMessagesTotalWithTablePrefix(rdbHandle, "")
+}
+
+// NewAccountMessagesTotalWithTablePrefix creates the view of the tables named with the table prefix
+func NewAccountMessagesTotalWithTablePrefix(rdbHandle *rdb.Handle, tablePrefix string) *AccountMessagesTotal {
 	return &AccountMessagesTotal{
-		view.NewTotal(rdbHandle, "view_account_messages_total"),
+		view.NewTotal(rdbHandle, tablePrefix+ACCOUNT_MESSAGES_TOTAL_TABLE_NAME),
 	}
 }
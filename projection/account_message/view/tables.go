@@ -0,0 +1,20 @@
+package view
+
+// Tables are the names of the view tables of an AccountMessage projection. A projection running under
+// an Id other than its type prefixes the names of its tables, so that projections of the same type do
+// not write to the same tables.
+type Tables struct {
+	Prefix string
+
+	AccountMessages      string
+	AccountMessagesTotal string
+}
+
+func NewTables(tablePrefix string) Tables {
+	return Tables{
+		Prefix: tablePrefix,
+
+		AccountMessages:      tablePrefix + ACCOUNT_MESSAGES_TABLE_NAME,
+		AccountMessagesTotal: tablePrefix + ACCOUNT_MESSAGES_TOTAL_TABLE_NAME,
+	}
+}
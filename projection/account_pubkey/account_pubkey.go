@@ -0,0 +1,130 @@
+package account_pubkey
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/projection/account_pubkey/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ projection_entity.Projection = &AccountPubKey{}
+
+// AccountPubKey records the public key of every single-key account revealed by a transaction
+// signer. Multisig accounts are indexed by the MultisigAccount projection instead.
+type AccountPubKey struct {
+	*rdbprojectionbase.Base
+
+	accountAddressPrefix string
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewAccountPubKey(logger applogger.Logger, rdbConn rdb.Conn, accountAddressPrefix string) *AccountPubKey {
+	return &AccountPubKey{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "AccountPubKey"),
+
+		accountAddressPrefix,
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *AccountPubKey) GetEventsToListen() []string {
+	return []string{
+		event_usecase.TRANSACTION_CREATED,
+		event_usecase.TRANSACTION_FAILED,
+	}
+}
+
+func (_ *AccountPubKey) OnInit() error {
+	return nil
+}
+
+func (projection *AccountPubKey) HandleEvents(height int64, events []event_entity.Event) error {
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+
+	pubKeysView := view.NewAccountPubKeys(rdbTxHandle)
+
+	for _, event := range events {
+		var txHash string
+		var signers []event_usecase.TransactionSigner
+		if transactionCreatedEvent, ok := event.(*event_usecase.TransactionCreated); ok {
+			txHash = transactionCreatedEvent.TxHash
+			signers = transactionCreatedEvent.Senders
+		} else if transactionFailedEvent, ok := event.(*event_usecase.TransactionFailed); ok {
+			txHash = transactionFailedEvent.TxHash
+			signers = transactionFailedEvent.Senders
+		} else {
+			continue
+		}
+
+		for _, signer := range signers {
+			if signer.IsMultiSig {
+				continue
+			}
+
+			address, parseErr := AccountAddressFromSigner(projection.accountAddressPrefix, signer)
+			if parseErr != nil {
+				return fmt.Errorf("error parsing signer of transaction %s: %v", txHash, parseErr)
+			}
+
+			if err := pubKeysView.Upsert(&view.AccountPubKeyRow{
+				Address:                  address,
+				PubKey:                   signer.Pubkeys[0],
+				Type:                     signer.Type,
+				FirstSeenBlockHeight:     height,
+				FirstSeenTransactionHash: txHash,
+				LatestSequence:           signer.AccountSequence,
+				LatestSeenBlockHeight:    height,
+			}); err != nil {
+				return fmt.Errorf("error upserting account pubkey: %v", err)
+			}
+		}
+	}
+
+	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err := rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+// AccountAddressFromSigner derives the account address of a single-key transaction signer
+func AccountAddressFromSigner(accountAddressPrefix string, signer event_usecase.TransactionSigner) (string, error) {
+	if signer.IsMultiSig || len(signer.Pubkeys) != 1 {
+		return "", errors.New("signer is not a single-key signer")
+	}
+
+	rawPubKey, err := base64.StdEncoding.DecodeString(signer.Pubkeys[0])
+	if err != nil {
+		return "", fmt.Errorf("error decoding signer public key %s: %v", signer.Pubkeys[0], err)
+	}
+
+	return tmcosmosutils.AccountAddressFromPubKey(accountAddressPrefix, rawPubKey)
+}
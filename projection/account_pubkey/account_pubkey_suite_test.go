@@ -0,0 +1,13 @@
+package account_pubkey_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAccountPubKey(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Account PubKey Suite")
+}
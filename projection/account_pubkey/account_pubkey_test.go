@@ -0,0 +1,48 @@
+package account_pubkey_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/projection/account_pubkey"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ = Describe("AccountPubKey", func() {
+	It("should implement projection", func() {
+		fakeLogger := NewFakeLogger()
+		fakeRdbConn := NewFakeRDbConn()
+		var _ entity_projection.Projection = account_pubkey.NewAccountPubKey(fakeLogger, fakeRdbConn, "tcro")
+	})
+
+	Describe("AccountAddressFromSigner", func() {
+		It("should derive account address from single-key signer", func() {
+			address, err := account_pubkey.AccountAddressFromSigner("tcro", event_usecase.TransactionSigner{
+				Type:            event_usecase.TRANSACTION_SIGNER_SECP256K1,
+				IsMultiSig:      false,
+				Pubkeys:         []string{"Az0uyMbncWU+PY8WrkDTN9u5B5a/7YORmjpnL9qSocCN"},
+				AccountSequence: 1,
+			})
+			Expect(err).To(BeNil())
+			Expect(address).To(Equal("tcro1v0fruf4pzm6ejlr8ehhx0z5mycyp2agzawnslc"))
+		})
+
+		It("should return error when signer is a multisig", func() {
+			_, err := account_pubkey.AccountAddressFromSigner("tcro", event_usecase.TransactionSigner{
+				Type:       event_usecase.TRANSACTION_SIGNER_MULTISIG_LEGACY_AMINO,
+				IsMultiSig: true,
+				Pubkeys: []string{
+					"Az0uyMbncWU+PY8WrkDTN9u5B5a/7YORmjpnL9qSocCN",
+					"AxEbGz9YrMoZt8OjqC4adZyEgoSW3FPNZ/H4/XQ6jUIg",
+				},
+				MaybeThreshold:  primptr.Int(1),
+				AccountSequence: 1,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package account_pubkey
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210709021507,
+		Name:    "view_account_pubkeys",
+		Up: `CREATE TABLE view_account_pubkeys (
+    address VARCHAR NOT NULL,
+    pubkey VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    first_seen_block_height BIGINT NOT NULL,
+    first_seen_transaction_hash VARCHAR NOT NULL,
+    latest_sequence BIGINT NOT NULL,
+    latest_seen_block_height BIGINT NOT NULL,
+    PRIMARY KEY (address)
+);
+
+CREATE INDEX view_account_pubkeys_pubkey_btree_index ON view_account_pubkeys USING btree(pubkey);`,
+		Down: `DROP TABLE IF EXISTS view_account_pubkeys;`,
+	},
+}
@@ -0,0 +1,132 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const ACCOUNT_PUBKEYS_TABLE_NAME = "view_account_pubkeys"
+
+type AccountPubKeys struct {
+	rdb *rdb.Handle
+}
+
+func NewAccountPubKeys(handle *rdb.Handle) *AccountPubKeys {
+	return &AccountPubKeys{
+		handle,
+	}
+}
+
+// Upsert records the public key of an account when it is first seen. When the account already
+// exists only the latest sequence and the latest seen block height are updated.
+func (pubKeysView *AccountPubKeys) Upsert(row *AccountPubKeyRow) error {
+	sql, sqlArgs, err := pubKeysView.rdb.StmtBuilder.Insert(
+		ACCOUNT_PUBKEYS_TABLE_NAME,
+	).Columns(
+		"address",
+		"pubkey",
+		"type",
+		"first_seen_block_height",
+		"first_seen_transaction_hash",
+		"latest_sequence",
+		"latest_seen_block_height",
+	).Values(
+		row.Address,
+		row.PubKey,
+		row.Type,
+		row.FirstSeenBlockHeight,
+		row.FirstSeenTransactionHash,
+		row.LatestSequence,
+		row.LatestSeenBlockHeight,
+	).Suffix(
+		fmt.Sprintf(
+			"ON CONFLICT(address) DO UPDATE SET "+
+				"latest_sequence = GREATEST(%s.latest_sequence, EXCLUDED.latest_sequence), "+
+				"latest_seen_block_height = EXCLUDED.latest_seen_block_height",
+			ACCOUNT_PUBKEYS_TABLE_NAME,
+		),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building account pubkey upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := pubKeysView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting account pubkey into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting account pubkey into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (pubKeysView *AccountPubKeys) FindByAddress(address string) (*AccountPubKeyRow, error) {
+	sql, sqlArgs, err := pubKeysView.selectStmtBuilder().Where(
+		"address = ?", address,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building account pubkey selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	return pubKeysView.scanRow(pubKeysView.rdb.QueryRow(sql, sqlArgs...))
+}
+
+func (pubKeysView *AccountPubKeys) FindByPubKey(pubKey string) (*AccountPubKeyRow, error) {
+	sql, sqlArgs, err := pubKeysView.selectStmtBuilder().Where(
+		"pubkey = ?", pubKey,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building account pubkey selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	return pubKeysView.scanRow(pubKeysView.rdb.QueryRow(sql, sqlArgs...))
+}
+
+func (pubKeysView *AccountPubKeys) selectStmtBuilder() sq.SelectBuilder {
+	return pubKeysView.rdb.StmtBuilder.Select(
+		"address",
+		"pubkey",
+		"type",
+		"first_seen_block_height",
+		"first_seen_transaction_hash",
+		"latest_sequence",
+		"latest_seen_block_height",
+	).From(
+		ACCOUNT_PUBKEYS_TABLE_NAME,
+	)
+}
+
+func (pubKeysView *AccountPubKeys) scanRow(scanner rdb.RowResult) (*AccountPubKeyRow, error) {
+	var row AccountPubKeyRow
+	if err := scanner.Scan(
+		&row.Address,
+		&row.PubKey,
+		&row.Type,
+		&row.FirstSeenBlockHeight,
+		&row.FirstSeenTransactionHash,
+		&row.LatestSequence,
+		&row.LatestSeenBlockHeight,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning account pubkey row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	return &row, nil
+}
+
+type AccountPubKeyRow struct {
+	Address                  string `json:"address"`
+	PubKey                   string `json:"pubKey"`
+	Type                     string `json:"type"`
+	FirstSeenBlockHeight     int64  `json:"firstSeenBlockHeight"`
+	FirstSeenTransactionHash string `json:"firstSeenTransactionHash"`
+	LatestSequence           uint64 `json:"latestSequence"`
+	LatestSeenBlockHeight    int64  `json:"latestSeenBlockHeight"`
+}
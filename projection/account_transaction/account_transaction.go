@@ -216,6 +216,10 @@ func (projection *AccountTransaction) HandleEvents(height int64, events []event_
 				transactionInfos[typedEvent.TxHash()].AddAccount(output.Address)
 			}
 
+		} else if typedEvent, ok := event.(*event_usecase.MsgCreateVestingAccount); ok {
+			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.FromAddress)
+			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.ToAddress)
+
 		} else if typedEvent, ok := event.(*event_usecase.MsgSetWithdrawAddress); ok {
 			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.DelegatorAddress)
 			transactionInfos[typedEvent.TxHash()].AddAccount(typedEvent.WithdrawAddress)
@@ -0,0 +1,71 @@
+package account_transaction
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210113113838,
+		Name:    "view_account_transactions",
+		Up: `CREATE TABLE view_account_transactions (
+   id BIGSERIAL,
+   block_height BIGINT,
+   block_hash VARCHAR NOT NULL,
+   block_time BIGINT NOT NULL,
+   account VARCHAR NOT NULL,
+   transaction_hash VARCHAR NOT NULL,
+   success BOOLEAN NOT NULL,
+   message_types JSONB NOT NULL,
+   PRIMARY KEY (id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_account_transactions;`,
+	},
+	{
+		Version: 20210113113852,
+		Name:    "view_account_transactions_total",
+		Up: `CREATE TABLE view_account_transactions_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_account_transactions_total;`,
+	},
+	{
+		Version: 20210113114120,
+		Name:    "view_account_transaction_data",
+		Up: `CREATE TABLE view_account_transaction_data (
+   id BIGSERIAL,
+   block_height BIGINT,
+   block_hash VARCHAR NOT NULL,
+   block_time BIGINT NOT NULL,
+   hash VARCHAR NOT NULL,
+   index INT NOT NULL,
+   success BOOLEAN NOT NULL,
+   code INT NOT NULL,
+   log VARCHAR NOT NULL,
+   fee JSONB NOT NULL,
+   fee_payer VARCHAR NOT NULL,
+   fee_granter VARCHAR NOT NULL,
+   gas_wanted BIGINT NOT NULL,
+   gas_used BIGINT NOT NULL,
+   memo VARCHAR NOT NULL,
+   timeout_height BIGINT NOT NULL,
+   messages JSONB NOT NULL,
+   PRIMARY KEY (id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_account_transaction_data;`,
+	},
+	{
+		Version: 20210125010749,
+		Name:    "view_account_transactions_account_btree_index",
+		Up:      `CREATE INDEX view_account_transactions_account_btree_index ON view_account_transactions USING btree (account);`,
+		Down:    `DROP INDEX IF EXISTS view_account_transactions_account_btree_index;`,
+	},
+	{
+		Version: 20210127194322,
+		Name:    "view_account_transaction_data_block_height_hash_btree_index",
+		Up:      `CREATE INDEX view_account_transaction_data_block_height_hash_btree_index ON view_account_transaction_data USING btree (block_height, hash);`,
+		Down:    `DROP INDEX IF EXISTS view_account_transaction_data_block_height_hash_btree_index;`,
+	},
+}
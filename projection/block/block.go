@@ -12,7 +12,7 @@ import (
 	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
 )
 
-var _ entity_projection.Projection = &Block{}
+var _ entity_projection.BatchProjection = &Block{}
 
 // TODO: Listen to council node related events and project council node
 type Block struct {
@@ -40,6 +40,19 @@ func (projection *Block) OnInit() error {
 }
 
 func (projection *Block) HandleEvents(height int64, events []event_entity.Event) error {
+	return projection.HandleEventsBatch([]entity_projection.HeightEvents{
+		{
+			Height: height,
+			Events: events,
+		},
+	})
+}
+
+func (projection *Block) HandleEventsBatch(batch []entity_projection.HeightEvents) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
 	rdbTx, err := projection.rdbConn.Begin()
 	if err != nil {
 		return fmt.Errorf("error beginning transaction: %v", err)
@@ -55,16 +68,18 @@ func (projection *Block) HandleEvents(height int64, events []event_entity.Event)
 	rdbTxHandle := rdbTx.ToHandle()
 	blocksView := view.NewBlocks(rdbTxHandle)
 
-	for _, event := range events {
-		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
-			if handleErr := projection.handleBlockCreatedEvent(blocksView, blockCreatedEvent); handleErr != nil {
-				return fmt.Errorf("error handling BlockCreatedEvent: %v", handleErr)
+	for _, heightEvents := range batch {
+		for _, event := range heightEvents.Events {
+			if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+				if handleErr := projection.handleBlockCreatedEvent(blocksView, blockCreatedEvent); handleErr != nil {
+					return fmt.Errorf("error handling BlockCreatedEvent: %v", handleErr)
+				}
+			} else {
+				return fmt.Errorf("received unexpected event %sV%d(%s)", event.Name(), event.Version(), event.UUID())
 			}
-		} else {
-			return fmt.Errorf("received unexpected event %sV%d(%s)", event.Name(), event.Version(), event.UUID())
 		}
 	}
-	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+	if err = projection.UpdateLastHandledEventHeight(rdbTxHandle, batch[len(batch)-1].Height); err != nil {
 		return fmt.Errorf("error updating last handled event height: %v", err)
 	}
 
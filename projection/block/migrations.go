@@ -0,0 +1,35 @@
+package block
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201101225152,
+		Name:    "view_blocks",
+		Up: `CREATE TABLE view_blocks (
+    height BIGINT,
+    hash VARCHAR NOT NULL,
+    time BIGINT NOT NULL,
+    app_hash VARCHAR NOT NULL,
+    committed_council_nodes JSONB NOT NULL,
+    transaction_count INT NOT NULL,
+    UNIQUE(hash),
+    PRIMARY KEY(height)
+);`,
+		Down: `DROP TABLE IF EXISTS view_blocks;`,
+	},
+	{
+		Version: 20201128074651,
+		Name:    "view_blocks_height_brin_index",
+		Up:      `CREATE INDEX view_blocks_height_brin_index ON view_blocks USING brin (height);`,
+		Down:    `DROP INDEX IF EXISTS view_blocks_height_brin_index;`,
+	},
+	{
+		Version: 20201128074827,
+		Name:    "view_blocks_hash_btree_index",
+		Up:      `CREATE INDEX view_blocks_hash_btree_index ON view_blocks USING btree (hash);`,
+		Down:    `DROP INDEX IF EXISTS view_blocks_hash_btree_index;`,
+	},
+}
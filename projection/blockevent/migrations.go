@@ -0,0 +1,55 @@
+package blockevent
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201123140001,
+		Name:    "view_block_events",
+		Up: `CREATE TABLE view_block_events (
+   id BIGSERIAL,
+   block_height BIGINT,
+   block_hash VARCHAR NOT NULL,
+   block_time BIGINT NOT NULL,
+   data JSONB NOT NULL,
+   PRIMARY KEY(id)
+);`,
+		Down: `DROP TABLE IF EXISTS view_block_events;`,
+	},
+	{
+		Version: 20201127172854,
+		Name:    "view_block_events_total",
+		Up: `CREATE TABLE view_block_events_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_block_events_total;`,
+	},
+	{
+		Version: 20201128075211,
+		Name:    "view_blocks_events_block_height_brin_index",
+		Up:      `CREATE INDEX view_block_events_block_height_brin_index ON view_block_events USING brin (block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_block_events_block_height_brin_index;`,
+	},
+	{
+		Version: 20201128075434,
+		Name:    "view_blocks_events_block_height_id_brin_index",
+		Up:      `CREATE INDEX view_block_events_block_height_id_brin_index ON view_block_events USING brin (block_height, id);`,
+		Down:    `DROP INDEX IF EXISTS view_block_events_block_height_id_brin_index;`,
+	},
+	{
+		Version: 20201129155015,
+		Name:    "view_block_events_height_btree_index",
+		Up:      `CREATE INDEX view_block_events_block_height_btree_index ON view_block_events USING btree (block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_block_events_block_height_btree_index;`,
+	},
+	{
+		Version: 20201129161108,
+		Name:    "view_block_events_height_id_btree_index",
+		Up:      `CREATE INDEX view_block_events_block_height_id_btree_index ON view_block_events USING btree (block_height, id);`,
+		Down:    `DROP INDEX IF EXISTS view_block_events_block_height_id_btree_index;`,
+	},
+}
@@ -0,0 +1,18 @@
+package chainstats
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210408183931,
+		Name:    "create_chain_stats",
+		Up: `CREATE TABLE view_chain_stats (
+    metrics VARCHAR,
+    value VARCHAR,
+    PRIMARY KEY (metrics)
+)`,
+		Down: `DROP TABLE IF EXISTS view_chain_stats;`,
+	},
+}
@@ -0,0 +1,318 @@
+package community_pool
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/community_pool/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ projection_entity.Projection = &CommunityPool{}
+
+// CommunityPool projection keeps track of the community pool balance. The community tax of a block
+// is derived from the fee collector balance being distributed minus the rewards allocated to the
+// validators, as the chain does not emit the community tax amount.
+type CommunityPool struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewCommunityPool(logger applogger.Logger, rdbConn rdb.Conn) *CommunityPool {
+	return &CommunityPool{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "CommunityPool"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *CommunityPool) GetEventsToListen() []string {
+	return []string{
+		event_usecase.GENESIS_CREATED,
+		event_usecase.BLOCK_CREATED,
+		event_usecase.MINTED,
+		event_usecase.BLOCK_REWARDED,
+		event_usecase.TRANSACTION_CREATED,
+		event_usecase.TRANSACTION_FAILED,
+		event_usecase.MSG_FUND_COMMUNITY_POOL_CREATED,
+		event_usecase.MSG_SUBMIT_COMMUNITY_POOL_SPEND_PROPOSAL_CREATED,
+		event_usecase.PROPOSAL_INACTIVED,
+		event_usecase.PROPOSAL_ENDED,
+	}
+}
+
+func (_ *CommunityPool) OnInit() error {
+	return nil
+}
+
+func (projection *CommunityPool) HandleEvents(height int64, events []event_entity.Event) error {
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+
+	historyView := view.NewHistory(rdbTxHandle)
+	flowsView := view.NewFlows(rdbTxHandle)
+	spendProposalsView := view.NewSpendProposals(rdbTxHandle)
+	pendingFeesView := view.NewPendingFees(rdbTxHandle)
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
+
+	var maybeGenesisBalance *coin.DecCoins
+	minted := coin.NewEmptyCoins()
+	txFees := coin.NewEmptyCoins()
+	rewards := coin.NewEmptyDecCoins()
+	hasRewards := false
+	funded := coin.NewEmptyCoins()
+	spent := coin.NewEmptyCoins()
+	flows := make([]view.FlowRow, 0)
+	for _, event := range events {
+		if genesisCreatedEvent, ok := event.(*event_usecase.GenesisCreated); ok {
+			genesisTime, parseErr := utctime.Parse(time.RFC3339, genesisCreatedEvent.Genesis.GenesisTime)
+			if parseErr != nil {
+				return fmt.Errorf("error parsing genesis time: %v", parseErr)
+			}
+			blockTime = genesisTime
+
+			genesisBalance, parseErr := CommunityPoolFromGenesis(&genesisCreatedEvent.Genesis)
+			if parseErr != nil {
+				return fmt.Errorf("error getting community pool from genesis: %v", parseErr)
+			}
+			maybeGenesisBalance = &genesisBalance
+			if !genesisBalance.IsZero() {
+				flows = append(flows, view.FlowRow{
+					BlockHeight: height,
+					BlockTime:   blockTime,
+					Direction:   view.FLOW_DIRECTION_INFLOW,
+					Type:        view.FLOW_TYPE_GENESIS,
+					Amount:      genesisBalance,
+				})
+			}
+
+		} else if mintedEvent, ok := event.(*event_usecase.Minted); ok {
+			minted = minted.Add(mintedEvent.Amount...)
+
+		} else if blockRewardedEvent, ok := event.(*event_usecase.BlockRewarded); ok {
+			hasRewards = true
+			rewards = rewards.Add(blockRewardedEvent.Amount...)
+
+		} else if transactionCreatedEvent, ok := event.(*event_usecase.TransactionCreated); ok {
+			txFees = txFees.Add(transactionCreatedEvent.Fee...)
+		} else if transactionFailedEvent, ok := event.(*event_usecase.TransactionFailed); ok {
+			txFees = txFees.Add(transactionFailedEvent.Fee...)
+
+		} else if msgFundCommunityPool, ok := event.(*event_usecase.MsgFundCommunityPool); ok {
+			funded = funded.Add(msgFundCommunityPool.Amount...)
+			flows = append(flows, view.FlowRow{
+				BlockHeight:          height,
+				BlockTime:            blockTime,
+				MaybeTransactionHash: primptr.String(msgFundCommunityPool.TxHash()),
+				Direction:            view.FLOW_DIRECTION_INFLOW,
+				Type:                 view.FLOW_TYPE_FUND_COMMUNITY_POOL,
+				MaybeAddress:         primptr.String(msgFundCommunityPool.Depositor),
+				Amount:               coin.NewDecCoinsFromCoins(msgFundCommunityPool.Amount...),
+			})
+
+		} else if msgSubmitProposal, ok := event.(*event_usecase.MsgSubmitCommunityPoolSpendProposal); ok {
+			if err := spendProposalsView.Insert(&view.SpendProposalRow{
+				ProposalId:       *msgSubmitProposal.MaybeProposalId,
+				RecipientAddress: msgSubmitProposal.Content.RecipientAddress,
+				Amount:           msgSubmitProposal.Content.Amount,
+			}); err != nil {
+				return fmt.Errorf("error inserting community pool spend proposal: %v", err)
+			}
+
+		} else if proposalEnded, ok := event.(*event_usecase.ProposalEnded); ok {
+			spendProposal, findErr := spendProposalsView.FindBy(proposalEnded.ProposalId)
+			if findErr != nil {
+				if errors.Is(findErr, rdb.ErrNoRows) {
+					// Not a community pool spend proposal
+					continue
+				}
+				return fmt.Errorf("error finding community pool spend proposal: %v", findErr)
+			}
+
+			if proposalEnded.Result == "proposal_passed" {
+				spent = spent.Add(spendProposal.Amount...)
+				flows = append(flows, view.FlowRow{
+					BlockHeight:     height,
+					BlockTime:       blockTime,
+					Direction:       view.FLOW_DIRECTION_OUTFLOW,
+					Type:            view.FLOW_TYPE_SPEND_PROPOSAL,
+					MaybeAddress:    primptr.String(spendProposal.RecipientAddress),
+					MaybeProposalId: primptr.String(spendProposal.ProposalId),
+					Amount:          coin.NewDecCoinsFromCoins(spendProposal.Amount...),
+				})
+			}
+			if err := spendProposalsView.Delete(proposalEnded.ProposalId); err != nil {
+				return fmt.Errorf("error deleting ended community pool spend proposal: %v", err)
+			}
+
+		} else if proposalInactived, ok := event.(*event_usecase.ProposalInactived); ok {
+			if err := spendProposalsView.Delete(proposalInactived.ProposalId); err != nil {
+				return fmt.Errorf("error deleting inactive community pool spend proposal: %v", err)
+			}
+		}
+	}
+
+	// Fee collector balance is made up of the undistributed balance from previous block and the
+	// coins minted in this block
+	feeCollectorBalance := minted
+	prevPendingFees, err := pendingFeesView.FindBy(height - 1)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			return fmt.Errorf("error finding pending fees of previous block: %v", err)
+		}
+	} else {
+		feeCollectorBalance = feeCollectorBalance.Add(prevPendingFees...)
+		if err := pendingFeesView.DeleteBy(height - 1); err != nil {
+			return fmt.Errorf("error deleting pending fees of previous block: %v", err)
+		}
+	}
+
+	communityTax := coin.NewEmptyDecCoins()
+	pendingFees := txFees
+	if hasRewards {
+		var isValid bool
+		communityTax, isValid = CommunityTax(feeCollectorBalance, rewards)
+		if !isValid {
+			projection.logger.Errorf(
+				"allocated rewards %s exceed fee collector balance %s at height %d",
+				rewards, feeCollectorBalance, height,
+			)
+			communityTax = coin.NewEmptyDecCoins()
+		}
+	} else {
+		// Fee collector balance is carried to the next block when there is no distribution
+		pendingFees = pendingFees.Add(feeCollectorBalance...)
+	}
+	if !pendingFees.IsZero() {
+		if err := pendingFeesView.Insert(height, pendingFees); err != nil {
+			return fmt.Errorf("error inserting pending fees: %v", err)
+		}
+	}
+
+	for i := range flows {
+		if err := flowsView.Insert(&flows[i]); err != nil {
+			return fmt.Errorf("error inserting community pool flow: %v", err)
+		}
+	}
+
+	if maybeGenesisBalance != nil || !communityTax.IsZero() || !funded.IsZero() || !spent.IsZero() {
+		prevBalance := coin.NewEmptyDecCoins()
+		if maybeGenesisBalance != nil {
+			prevBalance = *maybeGenesisBalance
+		} else {
+			latestHistory, findErr := historyView.FindLatest()
+			if findErr != nil {
+				if !errors.Is(findErr, rdb.ErrNoRows) {
+					return fmt.Errorf("error finding latest community pool balance: %v", findErr)
+				}
+			} else {
+				prevBalance = latestHistory.Balance
+			}
+		}
+
+		balance := prevBalance.Add(communityTax...).Add(coin.NewDecCoinsFromCoins(funded...)...)
+		balance, isNegative := balance.SafeSub(coin.NewDecCoinsFromCoins(spent...))
+		if isNegative {
+			projection.logger.Errorf("negative community pool balance at height %d", height)
+			balance = removeNegativeDecCoins(balance)
+		}
+
+		if err := historyView.Insert(&view.HistoryRow{
+			BlockHeight:  height,
+			BlockTime:    blockTime,
+			Balance:      balance,
+			CommunityTax: communityTax,
+			Funded:       funded,
+			Spent:        spent,
+		}); err != nil {
+			return fmt.Errorf("error inserting community pool history: %v", err)
+		}
+	}
+
+	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err := rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+// CommunityPoolFromGenesis returns the community pool balance in the genesis fee pool
+func CommunityPoolFromGenesis(genesis *genesis.Genesis) (coin.DecCoins, error) {
+	balance := coin.NewEmptyDecCoins()
+	for _, rawAmount := range genesis.AppState.Distribution.FeePool.CommunityPool {
+		amount, ok := rawAmount.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid coin in the community pool: %v", rawAmount)
+		}
+		denom, ok := amount["denom"].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid coin denom in the community pool: %v", rawAmount)
+		}
+		amountStr, ok := amount["amount"].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid coin amount in the community pool: %v", rawAmount)
+		}
+
+		decCoin, err := coin.NewDecCoinFromString(denom, amountStr)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing community pool coin: %v", err)
+		}
+		balance = balance.Add(decCoin)
+	}
+
+	return balance, nil
+}
+
+// CommunityTax returns the part of the distributed fee collector balance not allocated as rewards.
+// It returns false when the rewards exceed the distributed balance.
+func CommunityTax(feeCollectorBalance coin.Coins, rewards coin.DecCoins) (coin.DecCoins, bool) {
+	communityTax, isNegative := coin.NewDecCoinsFromCoins(feeCollectorBalance...).SafeSub(rewards)
+	if isNegative {
+		return nil, false
+	}
+	return communityTax, true
+}
+
+func removeNegativeDecCoins(coins coin.DecCoins) coin.DecCoins {
+	result := coin.NewEmptyDecCoins()
+	for _, decCoin := range coins {
+		if decCoin.IsPositive() {
+			result = result.Add(decCoin)
+		}
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+package community_pool_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCommunityPool(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Community Pool Suite")
+}
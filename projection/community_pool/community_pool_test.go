@@ -0,0 +1,70 @@
+package community_pool_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/projection/community_pool"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ = Describe("CommunityPool", func() {
+	It("should implement projection", func() {
+		fakeLogger := NewFakeLogger()
+		fakeRdbConn := NewFakeRDbConn()
+		var _ entity_projection.Projection = community_pool.NewCommunityPool(fakeLogger, fakeRdbConn)
+	})
+
+	Describe("CommunityPoolFromGenesis", func() {
+		It("should parse decimal community pool balance", func() {
+			var anyGenesis genesis.Genesis
+			anyGenesis.AppState.Distribution.FeePool.CommunityPool = []interface{}{
+				map[string]interface{}{
+					"denom":  "basetcro",
+					"amount": "1000.500000000000000000",
+				},
+			}
+
+			actual, err := community_pool.CommunityPoolFromGenesis(&anyGenesis)
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(coin.NewDecCoins(
+				coin.NewDecCoinFromDec("basetcro", coin.MustNewDecFromStr("1000.5")),
+			)))
+		})
+
+		It("should return error when community pool coin is invalid", func() {
+			var anyGenesis genesis.Genesis
+			anyGenesis.AppState.Distribution.FeePool.CommunityPool = []interface{}{
+				"1000basetcro",
+			}
+
+			_, err := community_pool.CommunityPoolFromGenesis(&anyGenesis)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("CommunityTax", func() {
+		It("should return fee collector balance not allocated as rewards", func() {
+			actual, ok := community_pool.CommunityTax(
+				coin.NewCoins(coin.NewInt64Coin("basetcro", 1000)),
+				coin.NewDecCoins(coin.NewDecCoinFromDec("basetcro", coin.MustNewDecFromStr("979.5"))),
+			)
+			Expect(ok).To(BeTrue())
+			Expect(actual).To(Equal(coin.NewDecCoins(
+				coin.NewDecCoinFromDec("basetcro", coin.MustNewDecFromStr("20.5")),
+			)))
+		})
+
+		It("should return false when rewards exceed fee collector balance", func() {
+			_, ok := community_pool.CommunityTax(
+				coin.NewCoins(coin.NewInt64Coin("basetcro", 1000)),
+				coin.NewDecCoins(coin.NewInt64DecCoin("basetcro", 1001)),
+			)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
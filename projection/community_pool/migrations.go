@@ -0,0 +1,85 @@
+package community_pool
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210706093012,
+		Name:    "view_community_pool_history",
+		Up: `CREATE TABLE view_community_pool_history (
+    id BIGSERIAL,
+    block_height BIGINT NOT NULL,
+    block_time BIGINT NOT NULL,
+    balance JSONB NOT NULL,
+    community_tax JSONB NOT NULL,
+    funded JSONB NOT NULL,
+    spent JSONB NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX view_community_pool_history_block_height_btree_index ON view_community_pool_history USING btree(block_height);`,
+		Down: `DROP TABLE IF EXISTS view_community_pool_history;`,
+	},
+	{
+		Version: 20210706093020,
+		Name:    "view_community_pool_history_total",
+		Up: `CREATE TABLE view_community_pool_history_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_community_pool_history_total;`,
+	},
+	{
+		Version: 20210706093034,
+		Name:    "view_community_pool_flows",
+		Up: `CREATE TABLE view_community_pool_flows (
+    id BIGSERIAL,
+    block_height BIGINT NOT NULL,
+    block_time BIGINT NOT NULL,
+    maybe_transaction_hash VARCHAR NULL,
+    direction VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    maybe_address VARCHAR NULL,
+    maybe_proposal_id VARCHAR NULL,
+    amount JSONB NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX view_community_pool_flows_direction_btree_index ON view_community_pool_flows USING btree(direction);`,
+		Down: `DROP TABLE IF EXISTS view_community_pool_flows;`,
+	},
+	{
+		Version: 20210706093041,
+		Name:    "view_community_pool_flows_total",
+		Up: `CREATE TABLE view_community_pool_flows_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_community_pool_flows_total;`,
+	},
+	{
+		Version: 20210706093055,
+		Name:    "view_community_pool_spend_proposals",
+		Up: `CREATE TABLE view_community_pool_spend_proposals (
+    proposal_id VARCHAR NOT NULL,
+    recipient_address VARCHAR NOT NULL,
+    amount JSONB NOT NULL,
+    PRIMARY KEY (proposal_id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_community_pool_spend_proposals;`,
+	},
+	{
+		Version: 20210706093103,
+		Name:    "view_community_pool_pending_fees",
+		Up: `CREATE TABLE view_community_pool_pending_fees (
+    block_height BIGINT NOT NULL,
+    amount JSONB NOT NULL,
+    PRIMARY KEY (block_height)
+)`,
+		Down: `DROP TABLE IF EXISTS view_community_pool_pending_fees;`,
+	},
+}
@@ -0,0 +1,199 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const FLOWS_TABLE_NAME = "view_community_pool_flows"
+
+const (
+	FLOW_DIRECTION_INFLOW  = "inflow"
+	FLOW_DIRECTION_OUTFLOW = "outflow"
+)
+
+const (
+	FLOW_TYPE_GENESIS             = "genesis"
+	FLOW_TYPE_FUND_COMMUNITY_POOL = "fund_community_pool"
+	FLOW_TYPE_SPEND_PROPOSAL      = "community_pool_spend_proposal"
+)
+
+// Flows stores the inflows and outflows of the community pool other than the per-block community tax
+type Flows struct {
+	rdb *rdb.Handle
+}
+
+func NewFlows(handle *rdb.Handle) *Flows {
+	return &Flows{
+		handle,
+	}
+}
+
+func (flowsView *Flows) Insert(row *FlowRow) error {
+	sql, sqlArgs, err := flowsView.rdb.StmtBuilder.Insert(
+		FLOWS_TABLE_NAME,
+	).Columns(
+		"block_height",
+		"block_time",
+		"maybe_transaction_hash",
+		"direction",
+		"type",
+		"maybe_address",
+		"maybe_proposal_id",
+		"amount",
+	).Values(
+		row.BlockHeight,
+		flowsView.rdb.Tton(&row.BlockTime),
+		row.MaybeTransactionHash,
+		row.Direction,
+		row.Type,
+		row.MaybeAddress,
+		row.MaybeProposalId,
+		json.MustMarshalToString(row.Amount),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building community pool flow insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := flowsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting community pool flow into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting community pool flow into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	totalView := NewFlowsTotal(flowsView.rdb)
+	if err := totalView.IncrementAll([]string{"-", row.Direction}, 1); err != nil {
+		return fmt.Errorf("error incrementing community pool flows total: %w", err)
+	}
+
+	return nil
+}
+
+func (flowsView *Flows) List(
+	filter FlowsListFilter,
+	order FlowsListOrder,
+	pagination *pagination_interface.Pagination,
+) ([]FlowRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := flowsView.rdb.StmtBuilder.Select(
+		"block_height",
+		"block_time",
+		"maybe_transaction_hash",
+		"direction",
+		"type",
+		"maybe_address",
+		"maybe_proposal_id",
+		"amount",
+	).From(
+		FLOWS_TABLE_NAME,
+	)
+
+	totalIdentity := "-"
+	if filter.MaybeDirection != nil {
+		stmtBuilder = stmtBuilder.Where("direction = ?", *filter.MaybeDirection)
+		totalIdentity = *filter.MaybeDirection
+	}
+
+	if order.Id == view.ORDER_DESC {
+		stmtBuilder = stmtBuilder.OrderBy("id DESC")
+	} else {
+		stmtBuilder = stmtBuilder.OrderBy("id")
+	}
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		flowsView.rdb,
+	).WithCustomTotalQueryFn(
+		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
+			totalView := NewFlowsTotal(rdbHandle)
+			total, err := totalView.FindBy(totalIdentity)
+			if err != nil {
+				return int64(0), err
+			}
+			return total, nil
+		},
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error building community pool flows select SQL: %v, %w", err, rdb.ErrBuildSQLStmt,
+		)
+	}
+
+	rowsResult, err := flowsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing community pool flows select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]FlowRow, 0)
+	for rowsResult.Next() {
+		var row FlowRow
+		var amountJSON string
+		blockTimeReader := flowsView.rdb.NtotReader()
+
+		if err = rowsResult.Scan(
+			&row.BlockHeight,
+			blockTimeReader.ScannableArg(),
+			&row.MaybeTransactionHash,
+			&row.Direction,
+			&row.Type,
+			&row.MaybeAddress,
+			&row.MaybeProposalId,
+			&amountJSON,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning community pool flow row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		blockTime, parseErr := blockTimeReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf(
+				"error parsing community pool flow block time: %v: %w", parseErr, rdb.ErrQuery,
+			)
+		}
+		row.BlockTime = *blockTime
+
+		json.MustUnmarshalFromString(amountJSON, &row.Amount)
+
+		rows = append(rows, row)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return rows, paginationResult, nil
+}
+
+type FlowRow struct {
+	BlockHeight          int64           `json:"blockHeight"`
+	BlockTime            utctime.UTCTime `json:"blockTime"`
+	MaybeTransactionHash *string         `json:"transactionHash"`
+	Direction            string          `json:"direction"`
+	Type                 string          `json:"type"`
+	MaybeAddress         *string         `json:"address"`
+	MaybeProposalId      *string         `json:"proposalId"`
+	Amount               coin.DecCoins   `json:"amount"`
+}
+
+type FlowsListFilter struct {
+	MaybeDirection *string
+}
+
+type FlowsListOrder struct {
+	Id view.ORDER
+}
@@ -0,0 +1,18 @@
+package view
+
+import (
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const FLOWS_TOTAL_TABLE_NAME = "view_community_pool_flows_total"
+
+type FlowsTotal struct {
+	*view.Total
+}
+
+func NewFlowsTotal(rdbHandle *rdb.Handle) *FlowsTotal {
+	return &FlowsTotal{
+		view.NewTotal(rdbHandle, FLOWS_TOTAL_TABLE_NAME),
+	}
+}
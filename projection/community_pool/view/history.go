@@ -0,0 +1,196 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const HISTORY_TABLE_NAME = "view_community_pool_history"
+
+// History stores the community pool balance after each block with balance changes
+type History struct {
+	rdb *rdb.Handle
+}
+
+func NewHistory(handle *rdb.Handle) *History {
+	return &History{
+		handle,
+	}
+}
+
+func (historyView *History) Insert(row *HistoryRow) error {
+	sql, sqlArgs, err := historyView.rdb.StmtBuilder.Insert(
+		HISTORY_TABLE_NAME,
+	).Columns(
+		"block_height",
+		"block_time",
+		"balance",
+		"community_tax",
+		"funded",
+		"spent",
+	).Values(
+		row.BlockHeight,
+		historyView.rdb.Tton(&row.BlockTime),
+		json.MustMarshalToString(row.Balance),
+		json.MustMarshalToString(row.CommunityTax),
+		json.MustMarshalToString(row.Funded),
+		json.MustMarshalToString(row.Spent),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building community pool history insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := historyView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting community pool history into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting community pool history into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	totalView := NewHistoryTotal(historyView.rdb)
+	if err := totalView.Increment("-", 1); err != nil {
+		return fmt.Errorf("error incrementing community pool history total: %w", err)
+	}
+
+	return nil
+}
+
+func (historyView *History) FindLatest() (*HistoryRow, error) {
+	sql, sqlArgs, err := historyView.selectStmtBuilder().OrderBy(
+		"block_height DESC",
+	).Limit(1).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building latest community pool history selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	row, err := historyView.scanRow(historyView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+func (historyView *History) List(
+	order HistoryListOrder,
+	pagination *pagination_interface.Pagination,
+) ([]HistoryRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := historyView.selectStmtBuilder()
+	if order.Height == view.ORDER_DESC {
+		stmtBuilder = stmtBuilder.OrderBy("block_height DESC")
+	} else {
+		stmtBuilder = stmtBuilder.OrderBy("block_height")
+	}
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		historyView.rdb,
+	).WithCustomTotalQueryFn(
+		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
+			totalView := NewHistoryTotal(rdbHandle)
+			total, err := totalView.FindBy("-")
+			if err != nil {
+				return int64(0), err
+			}
+			return total, nil
+		},
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error building community pool history select SQL: %v, %w", err, rdb.ErrBuildSQLStmt,
+		)
+	}
+
+	rowsResult, err := historyView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing community pool history select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]HistoryRow, 0)
+	for rowsResult.Next() {
+		row, scanErr := historyView.scanRow(rowsResult)
+		if scanErr != nil {
+			return nil, nil, scanErr
+		}
+
+		rows = append(rows, *row)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return rows, paginationResult, nil
+}
+
+func (historyView *History) selectStmtBuilder() sq.SelectBuilder {
+	return historyView.rdb.StmtBuilder.Select(
+		"block_height",
+		"block_time",
+		"balance",
+		"community_tax",
+		"funded",
+		"spent",
+	).From(
+		HISTORY_TABLE_NAME,
+	)
+}
+
+func (historyView *History) scanRow(scanner rdb.RowResult) (*HistoryRow, error) {
+	var row HistoryRow
+	var balanceJSON, communityTaxJSON, fundedJSON, spentJSON string
+	blockTimeReader := historyView.rdb.NtotReader()
+
+	if err := scanner.Scan(
+		&row.BlockHeight,
+		blockTimeReader.ScannableArg(),
+		&balanceJSON,
+		&communityTaxJSON,
+		&fundedJSON,
+		&spentJSON,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning community pool history row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	blockTime, parseErr := blockTimeReader.Parse()
+	if parseErr != nil {
+		return nil, fmt.Errorf("error parsing community pool history block time: %v: %w", parseErr, rdb.ErrQuery)
+	}
+	row.BlockTime = *blockTime
+
+	json.MustUnmarshalFromString(balanceJSON, &row.Balance)
+	json.MustUnmarshalFromString(communityTaxJSON, &row.CommunityTax)
+	json.MustUnmarshalFromString(fundedJSON, &row.Funded)
+	json.MustUnmarshalFromString(spentJSON, &row.Spent)
+
+	return &row, nil
+}
+
+type HistoryRow struct {
+	BlockHeight  int64           `json:"blockHeight"`
+	BlockTime    utctime.UTCTime `json:"blockTime"`
+	Balance      coin.DecCoins   `json:"balance"`
+	CommunityTax coin.DecCoins   `json:"communityTax"`
+	Funded       coin.Coins      `json:"funded"`
+	Spent        coin.Coins      `json:"spent"`
+}
+
+type HistoryListOrder struct {
+	Height view.ORDER
+}
@@ -0,0 +1,18 @@
+package view
+
+import (
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const HISTORY_TOTAL_TABLE_NAME = "view_community_pool_history_total"
+
+type HistoryTotal struct {
+	*view.Total
+}
+
+func NewHistoryTotal(rdbHandle *rdb.Handle) *HistoryTotal {
+	return &HistoryTotal{
+		view.NewTotal(rdbHandle, HISTORY_TOTAL_TABLE_NAME),
+	}
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const PENDING_FEES_TABLE_NAME = "view_community_pool_pending_fees"
+
+// PendingFees stores the transaction fees collected at a block. The fees are distributed, and so
+// taxed to the community pool, at the beginning of the next block.
+type PendingFees struct {
+	rdb *rdb.Handle
+}
+
+func NewPendingFees(handle *rdb.Handle) *PendingFees {
+	return &PendingFees{
+		handle,
+	}
+}
+
+func (pendingFeesView *PendingFees) Insert(blockHeight int64, amount coin.Coins) error {
+	sql, sqlArgs, err := pendingFeesView.rdb.StmtBuilder.Insert(
+		PENDING_FEES_TABLE_NAME,
+	).Columns(
+		"block_height",
+		"amount",
+	).Values(
+		blockHeight,
+		json.MustMarshalToString(amount),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building pending fees insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := pendingFeesView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting pending fees into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting pending fees into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (pendingFeesView *PendingFees) FindBy(blockHeight int64) (coin.Coins, error) {
+	sql, sqlArgs, err := pendingFeesView.rdb.StmtBuilder.Select(
+		"amount",
+	).From(
+		PENDING_FEES_TABLE_NAME,
+	).Where(
+		"block_height = ?", blockHeight,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building pending fees selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	var amountJSON string
+	if err = pendingFeesView.rdb.QueryRow(sql, sqlArgs...).Scan(&amountJSON); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning pending fees row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	var amount coin.Coins
+	json.MustUnmarshalFromString(amountJSON, &amount)
+
+	return amount, nil
+}
+
+func (pendingFeesView *PendingFees) DeleteBy(blockHeight int64) error {
+	sql, sqlArgs, err := pendingFeesView.rdb.StmtBuilder.Delete(
+		PENDING_FEES_TABLE_NAME,
+	).Where(
+		"block_height = ?", blockHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building pending fees deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = pendingFeesView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting pending fees from the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const SPEND_PROPOSALS_TABLE_NAME = "view_community_pool_spend_proposals"
+
+// SpendProposals keeps the submitted community pool spend proposals until they are ended
+type SpendProposals struct {
+	rdb *rdb.Handle
+}
+
+func NewSpendProposals(handle *rdb.Handle) *SpendProposals {
+	return &SpendProposals{
+		handle,
+	}
+}
+
+func (spendProposalsView *SpendProposals) Insert(row *SpendProposalRow) error {
+	sql, sqlArgs, err := spendProposalsView.rdb.StmtBuilder.Insert(
+		SPEND_PROPOSALS_TABLE_NAME,
+	).Columns(
+		"proposal_id",
+		"recipient_address",
+		"amount",
+	).Values(
+		row.ProposalId,
+		row.RecipientAddress,
+		json.MustMarshalToString(row.Amount),
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building spend proposal insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := spendProposalsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting spend proposal into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting spend proposal into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (spendProposalsView *SpendProposals) FindBy(proposalId string) (*SpendProposalRow, error) {
+	sql, sqlArgs, err := spendProposalsView.rdb.StmtBuilder.Select(
+		"proposal_id",
+		"recipient_address",
+		"amount",
+	).From(
+		SPEND_PROPOSALS_TABLE_NAME,
+	).Where(
+		"proposal_id = ?", proposalId,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building spend proposal selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	var row SpendProposalRow
+	var amountJSON string
+	if err = spendProposalsView.rdb.QueryRow(sql, sqlArgs...).Scan(
+		&row.ProposalId,
+		&row.RecipientAddress,
+		&amountJSON,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning spend proposal row: %v: %w", err, rdb.ErrQuery)
+	}
+	json.MustUnmarshalFromString(amountJSON, &row.Amount)
+
+	return &row, nil
+}
+
+func (spendProposalsView *SpendProposals) Delete(proposalId string) error {
+	sql, sqlArgs, err := spendProposalsView.rdb.StmtBuilder.Delete(
+		SPEND_PROPOSALS_TABLE_NAME,
+	).Where(
+		"proposal_id = ?", proposalId,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building spend proposal deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = spendProposalsView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting spend proposal from the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+type SpendProposalRow struct {
+	ProposalId       string
+	RecipientAddress string
+	Amount           coin.Coins
+}
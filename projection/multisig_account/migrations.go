@@ -0,0 +1,74 @@
+package multisig_account
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210708034215,
+		Name:    "view_multisig_accounts",
+		Up: `CREATE TABLE view_multisig_accounts (
+    address VARCHAR NOT NULL,
+    threshold INT NOT NULL,
+    members JSONB NOT NULL,
+    created_at_block_height BIGINT NOT NULL,
+    created_at_transaction_hash VARCHAR NOT NULL,
+    last_active_block_height BIGINT NOT NULL,
+    PRIMARY KEY (address)
+);`,
+		Down: `DROP TABLE IF EXISTS view_multisig_accounts;`,
+	},
+	{
+		Version: 20210708034223,
+		Name:    "view_multisig_account_members",
+		Up: `CREATE TABLE view_multisig_account_members (
+    multisig_address VARCHAR NOT NULL,
+    member_address VARCHAR NOT NULL,
+    member_pubkey VARCHAR NOT NULL,
+    created_at_block_height BIGINT NOT NULL,
+    PRIMARY KEY (multisig_address, member_address)
+);
+
+CREATE INDEX view_multisig_account_members_member_address_btree_index ON view_multisig_account_members USING btree(member_address);`,
+		Down: `DROP TABLE IF EXISTS view_multisig_account_members;`,
+	},
+	{
+		Version: 20210708034230,
+		Name:    "view_multisig_account_members_total",
+		Up: `CREATE TABLE view_multisig_account_members_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_multisig_account_members_total;`,
+	},
+	{
+		Version: 20210708034238,
+		Name:    "view_multisig_account_transactions",
+		Up: `CREATE TABLE view_multisig_account_transactions (
+    id BIGSERIAL,
+    multisig_address VARCHAR NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_time BIGINT NOT NULL,
+    transaction_hash VARCHAR NOT NULL,
+    success BOOLEAN NOT NULL,
+    account_sequence BIGINT NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE (multisig_address, transaction_hash)
+);
+
+CREATE INDEX view_multisig_account_transactions_multisig_address_btree_index ON view_multisig_account_transactions USING btree(multisig_address, block_height);`,
+		Down: `DROP TABLE IF EXISTS view_multisig_account_transactions;`,
+	},
+	{
+		Version: 20210708034245,
+		Name:    "view_multisig_account_transactions_total",
+		Up: `CREATE TABLE view_multisig_account_transactions_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_multisig_account_transactions_total;`,
+	},
+}
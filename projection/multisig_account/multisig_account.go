@@ -0,0 +1,217 @@
+package multisig_account
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/multisig_account/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ projection_entity.Projection = &MultisigAccount{}
+
+type MultisigAccount struct {
+	*rdbprojectionbase.Base
+
+	accountAddressPrefix string
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewMultisigAccount(logger applogger.Logger, rdbConn rdb.Conn, accountAddressPrefix string) *MultisigAccount {
+	return &MultisigAccount{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "MultisigAccount"),
+
+		accountAddressPrefix,
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *MultisigAccount) GetEventsToListen() []string {
+	return []string{
+		event_usecase.BLOCK_CREATED,
+		event_usecase.TRANSACTION_CREATED,
+		event_usecase.TRANSACTION_FAILED,
+	}
+}
+
+func (_ *MultisigAccount) OnInit() error {
+	return nil
+}
+
+func (projection *MultisigAccount) HandleEvents(height int64, events []event_entity.Event) error {
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
+
+	for _, event := range events {
+		if transactionCreatedEvent, ok := event.(*event_usecase.TransactionCreated); ok {
+			if err := projection.handleTransactionSigners(
+				rdbTxHandle, height, blockTime, transactionCreatedEvent.TxHash, true, transactionCreatedEvent.Senders,
+			); err != nil {
+				return err
+			}
+		} else if transactionFailedEvent, ok := event.(*event_usecase.TransactionFailed); ok {
+			if err := projection.handleTransactionSigners(
+				rdbTxHandle, height, blockTime, transactionFailedEvent.TxHash, false, transactionFailedEvent.Senders,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err := rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+func (projection *MultisigAccount) handleTransactionSigners(
+	rdbTxHandle *rdb.Handle,
+	height int64,
+	blockTime utctime.UTCTime,
+	txHash string,
+	success bool,
+	signers []event_usecase.TransactionSigner,
+) error {
+	accountsView := view.NewMultisigAccounts(rdbTxHandle)
+	membersView := view.NewMultisigAccountMembers(rdbTxHandle)
+	transactionsView := view.NewMultisigAccountTransactions(rdbTxHandle)
+
+	for _, signer := range signers {
+		if !signer.IsMultiSig {
+			continue
+		}
+
+		account, err := MultisigAccountFromSigner(projection.accountAddressPrefix, signer)
+		if err != nil {
+			return fmt.Errorf("error parsing multisig signer of transaction %s: %v", txHash, err)
+		}
+
+		_, err = accountsView.FindBy(account.Address)
+		if err == nil {
+			if err := accountsView.UpdateLastActiveBlockHeight(account.Address, height); err != nil {
+				return fmt.Errorf("error updating multisig account last active block height: %v", err)
+			}
+		} else if errors.Is(err, rdb.ErrNoRows) {
+			account.CreatedAtBlockHeight = height
+			account.CreatedAtTransactionHash = txHash
+			account.LastActiveBlockHeight = height
+			if err := accountsView.Insert(account); err != nil {
+				return fmt.Errorf("error inserting multisig account: %v", err)
+			}
+
+			// The same key may appear more than once in a multisig public key
+			insertedMembers := make(map[string]bool)
+			for _, member := range account.Members {
+				if insertedMembers[member.Address] {
+					continue
+				}
+				insertedMembers[member.Address] = true
+
+				if err := membersView.Insert(&view.MultisigAccountMemberRow{
+					MultisigAddress:      account.Address,
+					MemberAddress:        member.Address,
+					MemberPubkey:         member.Pubkey,
+					CreatedAtBlockHeight: height,
+				}); err != nil {
+					return fmt.Errorf("error inserting multisig account member: %v", err)
+				}
+			}
+		} else {
+			return fmt.Errorf("error finding multisig account: %v", err)
+		}
+
+		if err := transactionsView.Insert(&view.MultisigAccountTransactionRow{
+			MultisigAddress: account.Address,
+			BlockHeight:     height,
+			BlockTime:       blockTime,
+			TransactionHash: txHash,
+			Success:         success,
+			AccountSequence: signer.AccountSequence,
+		}); err != nil {
+			return fmt.Errorf("error inserting multisig account transaction: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// MultisigAccountFromSigner derives the multisig account address and its member addresses from a
+// multisig transaction signer. The public keys are kept in the order of the threshold public key.
+func MultisigAccountFromSigner(
+	accountAddressPrefix string,
+	signer event_usecase.TransactionSigner,
+) (*view.MultisigAccountRow, error) {
+	if !signer.IsMultiSig {
+		return nil, errors.New("signer is not a multisig")
+	}
+	if signer.MaybeThreshold == nil {
+		return nil, errors.New("missing multisig threshold")
+	}
+
+	rawPubKeys := make([][]byte, 0, len(signer.Pubkeys))
+	members := make([]view.MultisigAccountMember, 0, len(signer.Pubkeys))
+	for _, pubKey := range signer.Pubkeys {
+		rawPubKey, err := base64.StdEncoding.DecodeString(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding multisig member public key %s: %v", pubKey, err)
+		}
+		memberAddress, err := tmcosmosutils.AccountAddressFromPubKey(accountAddressPrefix, rawPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("error converting multisig member public key to address: %v", err)
+		}
+
+		rawPubKeys = append(rawPubKeys, rawPubKey)
+		members = append(members, view.MultisigAccountMember{
+			Address: memberAddress,
+			Pubkey:  pubKey,
+		})
+	}
+
+	address, err := tmcosmosutils.MultiSigAddressFromPubKeys(
+		accountAddressPrefix, rawPubKeys, *signer.MaybeThreshold, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error converting multisig public keys to address: %v", err)
+	}
+
+	return &view.MultisigAccountRow{
+		Address:   address,
+		Threshold: *signer.MaybeThreshold,
+		Members:   members,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package multisig_account_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMultisigAccount(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Multisig Account Suite")
+}
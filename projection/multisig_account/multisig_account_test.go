@@ -0,0 +1,56 @@
+package multisig_account_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/projection/multisig_account"
+	"github.com/crypto-com/chain-indexing/projection/multisig_account/view"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ = Describe("MultisigAccount", func() {
+	It("should implement projection", func() {
+		fakeLogger := NewFakeLogger()
+		fakeRdbConn := NewFakeRDbConn()
+		var _ entity_projection.Projection = multisig_account.NewMultisigAccount(fakeLogger, fakeRdbConn, "tcro")
+	})
+
+	Describe("MultisigAccountFromSigner", func() {
+		It("should return error when signer is not a multisig", func() {
+			_, err := multisig_account.MultisigAccountFromSigner("tcro", event_usecase.TransactionSigner{
+				Type:            event_usecase.TRANSACTION_SIGNER_SECP256K1,
+				IsMultiSig:      false,
+				Pubkeys:         []string{"Az0uyMbncWU+PY8WrkDTN9u5B5a/7YORmjpnL9qSocCN"},
+				AccountSequence: 1,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should derive multisig and member addresses following the public key orders", func() {
+			account, err := multisig_account.MultisigAccountFromSigner("tcro", event_usecase.TransactionSigner{
+				Type:       event_usecase.TRANSACTION_SIGNER_MULTISIG_LEGACY_AMINO,
+				IsMultiSig: true,
+				Pubkeys: []string{
+					"Az0uyMbncWU+PY8WrkDTN9u5B5a/7YORmjpnL9qSocCN",
+					"AxEbGz9YrMoZt8OjqC4adZyEgoSW3FPNZ/H4/XQ6jUIg",
+					"A/ForX4DkKkvW8Z9nqJ1lu+tPxr64kOj56J9TJvAJgbE",
+				},
+				MaybeThreshold:  primptr.Int(2),
+				AccountSequence: 3,
+			})
+			Expect(err).To(BeNil())
+			Expect(account.Address).To(Equal("tcro1xc5uw8j6h3cjd7m2l9pn7xzg97q5pv9mdvp8ly"))
+			Expect(account.Threshold).To(Equal(2))
+			Expect(account.Members).To(HaveLen(3))
+			Expect(account.Members[0]).To(Equal(view.MultisigAccountMember{
+				Address: "tcro1v0fruf4pzm6ejlr8ehhx0z5mycyp2agzawnslc",
+				Pubkey:  "Az0uyMbncWU+PY8WrkDTN9u5B5a/7YORmjpnL9qSocCN",
+			}))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME = "view_multisig_account_members"
+
+// MultisigAccountMembers indexes the member addresses of multisig accounts so that the memberships
+// of an address can be looked up
+type MultisigAccountMembers struct {
+	rdb *rdb.Handle
+}
+
+func NewMultisigAccountMembers(handle *rdb.Handle) *MultisigAccountMembers {
+	return &MultisigAccountMembers{
+		handle,
+	}
+}
+
+func (membersView *MultisigAccountMembers) Insert(row *MultisigAccountMemberRow) error {
+	sql, sqlArgs, err := membersView.rdb.StmtBuilder.Insert(
+		MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME,
+	).Columns(
+		"multisig_address",
+		"member_address",
+		"member_pubkey",
+		"created_at_block_height",
+	).Values(
+		row.MultisigAddress,
+		row.MemberAddress,
+		row.MemberPubkey,
+		row.CreatedAtBlockHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building multisig account member insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := membersView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting multisig account member into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting multisig account member into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	totalView := NewMultisigAccountMembersTotal(membersView.rdb)
+	if err := totalView.Increment(row.MemberAddress, 1); err != nil {
+		return fmt.Errorf("error incrementing multisig account members total: %w", err)
+	}
+
+	return nil
+}
+
+type MultisigAccountMemberRow struct {
+	MultisigAddress      string
+	MemberAddress        string
+	MemberPubkey         string
+	CreatedAtBlockHeight int64
+}
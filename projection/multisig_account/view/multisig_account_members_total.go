@@ -0,0 +1,18 @@
+package view
+
+import (
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const MULTISIG_ACCOUNT_MEMBERS_TOTAL_TABLE_NAME = "view_multisig_account_members_total"
+
+type MultisigAccountMembersTotal struct {
+	*view.Total
+}
+
+func NewMultisigAccountMembersTotal(rdbHandle *rdb.Handle) *MultisigAccountMembersTotal {
+	return &MultisigAccountMembersTotal{
+		view.NewTotal(rdbHandle, MULTISIG_ACCOUNT_MEMBERS_TOTAL_TABLE_NAME),
+	}
+}
@@ -0,0 +1,171 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+)
+
+const MULTISIG_ACCOUNT_TRANSACTIONS_TABLE_NAME = "view_multisig_account_transactions"
+
+// MultisigAccountTransactions stores the transactions signed by multisig accounts
+type MultisigAccountTransactions struct {
+	rdb *rdb.Handle
+}
+
+func NewMultisigAccountTransactions(handle *rdb.Handle) *MultisigAccountTransactions {
+	return &MultisigAccountTransactions{
+		handle,
+	}
+}
+
+func (transactionsView *MultisigAccountTransactions) Insert(row *MultisigAccountTransactionRow) error {
+	sql, sqlArgs, err := transactionsView.rdb.StmtBuilder.Insert(
+		MULTISIG_ACCOUNT_TRANSACTIONS_TABLE_NAME,
+	).Columns(
+		"multisig_address",
+		"block_height",
+		"block_time",
+		"transaction_hash",
+		"success",
+		"account_sequence",
+	).Values(
+		row.MultisigAddress,
+		row.BlockHeight,
+		transactionsView.rdb.Tton(&row.BlockTime),
+		row.TransactionHash,
+		row.Success,
+		row.AccountSequence,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf(
+			"error building multisig account transaction insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt,
+		)
+	}
+
+	result, err := transactionsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting multisig account transaction into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf(
+			"error inserting multisig account transaction into the table: no rows inserted: %w", rdb.ErrWrite,
+		)
+	}
+
+	totalView := NewMultisigAccountTransactionsTotal(transactionsView.rdb)
+	if err := totalView.Increment(row.MultisigAddress, 1); err != nil {
+		return fmt.Errorf("error incrementing multisig account transactions total: %w", err)
+	}
+
+	return nil
+}
+
+func (transactionsView *MultisigAccountTransactions) ListByAddress(
+	multisigAddress string,
+	order MultisigAccountTransactionsListOrder,
+	pagination *pagination_interface.Pagination,
+) ([]MultisigAccountTransactionRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := transactionsView.rdb.StmtBuilder.Select(
+		"multisig_address",
+		"block_height",
+		"block_time",
+		"transaction_hash",
+		"success",
+		"account_sequence",
+	).From(
+		MULTISIG_ACCOUNT_TRANSACTIONS_TABLE_NAME,
+	).Where(
+		"multisig_address = ?", multisigAddress,
+	)
+
+	if order.Height == view.ORDER_DESC {
+		stmtBuilder = stmtBuilder.OrderBy("block_height DESC", "id DESC")
+	} else {
+		stmtBuilder = stmtBuilder.OrderBy("block_height", "id")
+	}
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		transactionsView.rdb,
+	).WithCustomTotalQueryFn(
+		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
+			totalView := NewMultisigAccountTransactionsTotal(rdbHandle)
+			total, err := totalView.FindBy(multisigAddress)
+			if err != nil {
+				return int64(0), err
+			}
+			return total, nil
+		},
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error building multisig account transactions select SQL: %v, %w", err, rdb.ErrBuildSQLStmt,
+		)
+	}
+
+	rowsResult, err := transactionsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error executing multisig account transactions select SQL: %v: %w", err, rdb.ErrQuery,
+		)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]MultisigAccountTransactionRow, 0)
+	for rowsResult.Next() {
+		var row MultisigAccountTransactionRow
+		blockTimeReader := transactionsView.rdb.NtotReader()
+
+		if err = rowsResult.Scan(
+			&row.MultisigAddress,
+			&row.BlockHeight,
+			blockTimeReader.ScannableArg(),
+			&row.TransactionHash,
+			&row.Success,
+			&row.AccountSequence,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning multisig account transaction row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		blockTime, parseErr := blockTimeReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf(
+				"error parsing multisig account transaction block time: %v: %w", parseErr, rdb.ErrQuery,
+			)
+		}
+		row.BlockTime = *blockTime
+
+		rows = append(rows, row)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return rows, paginationResult, nil
+}
+
+type MultisigAccountTransactionRow struct {
+	MultisigAddress string          `json:"multisigAddress"`
+	BlockHeight     int64           `json:"blockHeight"`
+	BlockTime       utctime.UTCTime `json:"blockTime"`
+	TransactionHash string          `json:"transactionHash"`
+	Success         bool            `json:"success"`
+	AccountSequence uint64          `json:"accountSequence"`
+}
+
+type MultisigAccountTransactionsListOrder struct {
+	Height view.ORDER
+}
@@ -0,0 +1,18 @@
+package view
+
+import (
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const MULTISIG_ACCOUNT_TRANSACTIONS_TOTAL_TABLE_NAME = "view_multisig_account_transactions_total"
+
+type MultisigAccountTransactionsTotal struct {
+	*view.Total
+}
+
+func NewMultisigAccountTransactionsTotal(rdbHandle *rdb.Handle) *MultisigAccountTransactionsTotal {
+	return &MultisigAccountTransactionsTotal{
+		view.NewTotal(rdbHandle, MULTISIG_ACCOUNT_TRANSACTIONS_TOTAL_TABLE_NAME),
+	}
+}
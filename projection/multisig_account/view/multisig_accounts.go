@@ -0,0 +1,213 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+)
+
+const MULTISIG_ACCOUNTS_TABLE_NAME = "view_multisig_accounts"
+
+type MultisigAccounts struct {
+	rdb *rdb.Handle
+}
+
+func NewMultisigAccounts(handle *rdb.Handle) *MultisigAccounts {
+	return &MultisigAccounts{
+		handle,
+	}
+}
+
+func (accountsView *MultisigAccounts) Insert(row *MultisigAccountRow) error {
+	sql, sqlArgs, err := accountsView.rdb.StmtBuilder.Insert(
+		MULTISIG_ACCOUNTS_TABLE_NAME,
+	).Columns(
+		"address",
+		"threshold",
+		"members",
+		"created_at_block_height",
+		"created_at_transaction_hash",
+		"last_active_block_height",
+	).Values(
+		row.Address,
+		row.Threshold,
+		json.MustMarshalToString(row.Members),
+		row.CreatedAtBlockHeight,
+		row.CreatedAtTransactionHash,
+		row.LastActiveBlockHeight,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building multisig account insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := accountsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting multisig account into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting multisig account into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (accountsView *MultisigAccounts) UpdateLastActiveBlockHeight(address string, height int64) error {
+	sql, sqlArgs, err := accountsView.rdb.StmtBuilder.Update(
+		MULTISIG_ACCOUNTS_TABLE_NAME,
+	).Set(
+		"last_active_block_height", height,
+	).Where(
+		"address = ?", address,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building multisig account update sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := accountsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error updating multisig account: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error updating multisig account: no rows updated: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (accountsView *MultisigAccounts) FindBy(address string) (*MultisigAccountRow, error) {
+	sql, sqlArgs, err := accountsView.selectStmtBuilder().Where(
+		"address = ?", address,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building multisig account selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	row, err := accountsView.scanRow(accountsView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// ListByMember returns the multisig accounts the specified address is a member of
+func (accountsView *MultisigAccounts) ListByMember(
+	memberAddress string,
+	pagination *pagination_interface.Pagination,
+) ([]MultisigAccountRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := accountsView.rdb.StmtBuilder.Select(
+		fmt.Sprintf("%s.address", MULTISIG_ACCOUNTS_TABLE_NAME),
+		fmt.Sprintf("%s.threshold", MULTISIG_ACCOUNTS_TABLE_NAME),
+		fmt.Sprintf("%s.members", MULTISIG_ACCOUNTS_TABLE_NAME),
+		fmt.Sprintf("%s.created_at_block_height", MULTISIG_ACCOUNTS_TABLE_NAME),
+		fmt.Sprintf("%s.created_at_transaction_hash", MULTISIG_ACCOUNTS_TABLE_NAME),
+		fmt.Sprintf("%s.last_active_block_height", MULTISIG_ACCOUNTS_TABLE_NAME),
+	).From(
+		MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME,
+	).InnerJoin(fmt.Sprintf(
+		"%s ON %s.multisig_address = %s.address",
+		MULTISIG_ACCOUNTS_TABLE_NAME, MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME, MULTISIG_ACCOUNTS_TABLE_NAME,
+	)).Where(
+		fmt.Sprintf("%s.member_address = ?", MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME), memberAddress,
+	).OrderBy(
+		fmt.Sprintf("%s.created_at_block_height", MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME),
+		fmt.Sprintf("%s.multisig_address", MULTISIG_ACCOUNT_MEMBERS_TABLE_NAME),
+	)
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		accountsView.rdb,
+	).WithCustomTotalQueryFn(
+		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
+			totalView := NewMultisigAccountMembersTotal(rdbHandle)
+			total, err := totalView.FindBy(memberAddress)
+			if err != nil {
+				return int64(0), err
+			}
+			return total, nil
+		},
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error building multisig memberships select SQL: %v, %w", err, rdb.ErrBuildSQLStmt,
+		)
+	}
+
+	rowsResult, err := accountsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing multisig memberships select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]MultisigAccountRow, 0)
+	for rowsResult.Next() {
+		row, scanErr := accountsView.scanRow(rowsResult)
+		if scanErr != nil {
+			return nil, nil, scanErr
+		}
+
+		rows = append(rows, *row)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return rows, paginationResult, nil
+}
+
+func (accountsView *MultisigAccounts) selectStmtBuilder() sq.SelectBuilder {
+	return accountsView.rdb.StmtBuilder.Select(
+		"address",
+		"threshold",
+		"members",
+		"created_at_block_height",
+		"created_at_transaction_hash",
+		"last_active_block_height",
+	).From(
+		MULTISIG_ACCOUNTS_TABLE_NAME,
+	)
+}
+
+func (accountsView *MultisigAccounts) scanRow(scanner rdb.RowResult) (*MultisigAccountRow, error) {
+	var row MultisigAccountRow
+	var membersJSON string
+	if err := scanner.Scan(
+		&row.Address,
+		&row.Threshold,
+		&membersJSON,
+		&row.CreatedAtBlockHeight,
+		&row.CreatedAtTransactionHash,
+		&row.LastActiveBlockHeight,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning multisig account row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	json.MustUnmarshalFromString(membersJSON, &row.Members)
+
+	return &row, nil
+}
+
+type MultisigAccountRow struct {
+	Address                  string                  `json:"address"`
+	Threshold                int                     `json:"threshold"`
+	Members                  []MultisigAccountMember `json:"members"`
+	CreatedAtBlockHeight     int64                   `json:"createdAtBlockHeight"`
+	CreatedAtTransactionHash string                  `json:"createdAtTransactionHash"`
+	LastActiveBlockHeight    int64                   `json:"lastActiveBlockHeight"`
+}
+
+type MultisigAccountMember struct {
+	Address string `json:"address"`
+	Pubkey  string `json:"pubkey"`
+}
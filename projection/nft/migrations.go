@@ -0,0 +1,188 @@
+package nft
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210514164822,
+		Name:    "view_nft_denoms",
+		Up: `CREATE TABLE view_nft_denoms (
+    id BIGSERIAL,
+    denom_id VARCHAR NOT NULL,
+    name VARCHAR NULL,
+    schema VARCHAR NULL,
+    creator VARCHAR NOT NULL,
+    created_at BIGINT NOT NULL,
+    PRIMARY KEY(denom_id),
+    UNIQUE (id)
+)`,
+		Down: `DROP TABLE view_nft_denoms;`,
+	},
+	{
+		Version: 20210514165550,
+		Name:    "view_nft_tokens",
+		Up: `CREATE TABLE view_nft_tokens (
+    id BIGSERIAL,
+    denom_id VARCHAR NOT NULL,
+    token_id VARCHAR NOT NULL,
+    drop VARCHAR NULL,
+    burned BOOL NOT NULL,
+    name VARCHAR NULL,
+    uri VARCHAR NULL,
+    data VARCHAR NULL,
+    minter VARCHAR NOT NULL,
+    owner VARCHAR NOT NULL,
+    minted_at BIGINT NOT NULL,
+    last_edited_at BIGINT NOT NULL,
+    PRIMARY KEY(id),
+    UNIQUE(denom_id, token_id)
+);
+
+CREATE INDEX view_nft_tokens_drop_btree_index ON view_nft_tokens USING btree (drop);
+
+CREATE INDEX view_nft_tokens_token_id_btree_index ON view_nft_tokens USING btree (token_id);`,
+		Down: `DROP TABLE IF EXISTS view_nft_tokens;`,
+	},
+	{
+		Version: 20210514174506,
+		Name:    "view_nft_token_transfers",
+		Up: `CREATE TABLE view_nft_token_transfers (
+    id BIGSERIAL,
+    denom_id VARCHAR NOT NULL,
+    token_id VARCHAR NOT NULL,
+    block_height BIGINT NOT NULL,
+    transaction_hash VARCHAR NOT NULL,
+    sender VARCHAR NOT NULL,
+    recipient VARCHAR NOT NULL,
+    transferred_at BIGINT NOT NULL,
+    PRIMARY KEY(id)
+)`,
+		Down: `DROP TABLE view_nft_token_transfers;`,
+	},
+	{
+		Version: 20210516185001,
+		Name:    "view_nft_denoms_total",
+		Up: `CREATE TABLE view_nft_denoms_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_nft_denoms_total;`,
+	},
+	{
+		Version: 20210516185327,
+		Name:    "view_nft_tokens_total",
+		Up: `CREATE TABLE view_nft_tokens_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_nft_tokens_total;`,
+	},
+	{
+		Version: 20210526001539,
+		Name:    "view_nft_messages",
+		Up: `CREATE TABLE view_nft_messages (
+    id BIGSERIAL,
+    block_height BIGINT,
+    block_hash VARCHAR NOT NULL,
+    block_time BIGINT NOT NULL,
+    denom_id VARCHAR NOT NULL,
+    maybe_token_id VARCHAR NULL,
+    maybe_drop VARCHAR NULL,
+    transaction_hash VARCHAR NOT NULL,
+    success BOOLEAN NOT NULL,
+    message_index INT NOT NULL,
+    message_type VARCHAR NOT nULL,
+    data JSONB NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX view_nft_messages_drop_message_type_btree_index ON view_nft_messages USING btree (maybe_drop, message_type);
+CREATE INDEX view_nft_messages_denom_message_type_btree_index ON view_nft_messages USING btree (denom_id, maybe_token_id);
+CREATE INDEX view_nft_messages_denom_token_message_type_btree_index ON view_nft_messages USING btree (denom_id, maybe_token_id, message_type);`,
+		Down: `DROP TABLE IF EXISTS view_nft_messages;`,
+	},
+	{
+		Version: 20210526001541,
+		Name:    "view_nft_messages_total",
+		Up: `CREATE TABLE view_nft_messages_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_nft_messages_total;`,
+	},
+	{
+		Version: 20210526080911,
+		Name:    "drop_view_nft_token_transfers",
+		Up:      `DROP TABLE IF EXISTS view_nft_token_transfers;`,
+		Down: `CREATE TABLE view_nft_token_transfers (
+    id BIGSERIAL,
+    denom_id VARCHAR NOT NULL,
+    token_id VARCHAR NOT NULL,
+    block_height BIGINT NOT NULL,
+    transaction_hash VARCHAR NOT NULL,
+    sender VARCHAR NOT NULL,
+    recipient VARCHAR NOT NULL,
+    transferred_at BIGINT NOT NULL,
+    PRIMARY KEY(id)
+)`,
+	},
+	{
+		Version: 20210526081338,
+		Name:    "drop_view_nft_token_transfers_total",
+		Up:      `DROP TABLE IF EXISTS view_nft_token_transfers_total;`,
+		Down: `CREATE TABLE view_nft_token_transfers_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+	},
+	{
+		Version: 20210526205153,
+		Name:    "create_view_nft_denoms_name_btree_index",
+		Up:      `CREATE INDEX view_nft_denoms_name_btree_index ON view_nft_denoms USING btree (name);`,
+		Down:    `DROP INDEX IF EXISTS view_nft_denoms_name_btree_index;`,
+	},
+	{
+		Version: 20210620161041,
+		Name:    "alter_view_nft_tokens_add_block_height",
+		Up: `ALTER TABLE view_nft_tokens
+    ADD minted_at_block_height BIGINT NOT NULL,
+    ADD last_edited_at_block_height BIGINT NOT NULL;`,
+		Down: `ALTER TABLE view_nft_tokens
+    DROP minted_at_block_height,
+    DROP last_edited_at_block_height;`,
+	},
+	{
+		Version: 20210620161119,
+		Name:    "alter_view_nft_denoms_add_block_height",
+		Up: `ALTER TABLE view_nft_denoms
+    ADD created_at_block_height BIGINT NOT NULL;`,
+		Down: `ALTER TABLE view_nft_denoms
+    DROP created_at_block_height;`,
+	},
+	{
+		Version: 20210621123820,
+		Name:    "alter_view_nft_tokens_drop_burned",
+		Up: `ALTER TABLE view_nft_tokens
+    DROP burned;`,
+		Down: `ALTER TABLE view_nft_tokens
+    ADD burned BOOL NOT NULL DEFAULT FALSE;`,
+	},
+	{
+		Version: 20210710031232,
+		Name:    "view_nft_denoms_name_gin_trgm_index",
+		Up:      `CREATE INDEX view_nft_denoms_name_gin_trgm_index ON view_nft_denoms USING gin (name gin_trgm_ops, denom_id gin_trgm_ops);`,
+		Down:    `DROP INDEX IF EXISTS view_nft_denoms_name_gin_trgm_index;`,
+	},
+	{
+		Version: 20210710031238,
+		Name:    "view_nft_tokens_name_gin_trgm_index",
+		Up:      `CREATE INDEX view_nft_tokens_name_gin_trgm_index ON view_nft_tokens USING gin (name gin_trgm_ops, token_id gin_trgm_ops);`,
+		Down:    `DROP INDEX IF EXISTS view_nft_tokens_name_gin_trgm_index;`,
+	},
+}
@@ -1,6 +1,7 @@
 package nft
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/crypto-com/chain-indexing/projection/nft/utils"
@@ -29,8 +30,19 @@ type NFT struct {
 }
 
 type Config struct {
-	EnableDrop       bool
-	DropDataAccessor string
+	// Whether to record the drop of the tokens, read from the token data
+	EnableDrop bool `toml:"enable_drop"`
+	// Key of the drop in the JSON token data
+	DropDataAccessor string `toml:"drop_data_accessor"`
+	// Prefix of the names of the view tables, set from the projection Id by the projection registry
+	TablePrefix string `toml:"-"`
+}
+
+func (config Config) Validate() error {
+	if config.EnableDrop && config.DropDataAccessor == "" {
+		return errors.New("drop_data_accessor is required when enable_drop is true")
+	}
+	return nil
 }
 
 func NewNFT(logger applogger.Logger, rdbConn rdb.Conn, config Config) *NFT {
@@ -38,6 +50,11 @@ func NewNFT(logger applogger.Logger, rdbConn rdb.Conn, config Config) *NFT {
 	if config.EnableDrop {
 		projectionId = "CryptoComNFT"
 	}
+	return NewNFTWithId(logger, rdbConn, projectionId, config)
+}
+
+// NewNFTWithId creates the projection under the Id, so that it can run with different configs
+func NewNFTWithId(logger applogger.Logger, rdbConn rdb.Conn, projectionId string, config Config) *NFT {
 	return &NFT{
 		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), projectionId),
 
@@ -77,12 +94,12 @@ func (nft *NFT) HandleEvents(height int64, events []event_entity.Event) error {
 
 	rdbTxHandle := rdbTx.ToHandle()
 
-	denomsView := view.NewDenoms(rdbTxHandle)
-	denomsTotalView := view.NewDenomsTotal(rdbTxHandle)
-	tokensView := view.NewTokens(rdbTxHandle)
-	tokensTotalView := view.NewTokensTotal(rdbTxHandle)
-	nftMessagesView := view.NewMessages(rdbTxHandle)
-	nftMessagesTotalView := view.NewMessagesTotal(rdbTxHandle)
+	denomsView := view.NewDenomsWithTablePrefix(rdbTxHandle, nft.config.TablePrefix)
+	denomsTotalView := view.NewDenomsTotalWithTablePrefix(rdbTxHandle, nft.config.TablePrefix)
+	tokensView := view.NewTokensWithTablePrefix(rdbTxHandle, nft.config.TablePrefix)
+	tokensTotalView := view.NewTokensTotalWithTablePrefix(rdbTxHandle, nft.config.TablePrefix)
+	nftMessagesView := view.NewMessagesWithTablePrefix(rdbTxHandle, nft.config.TablePrefix)
+	nftMessagesTotalView := view.NewMessagesTotalWithTablePrefix(rdbTxHandle, nft.config.TablePrefix)
 
 	var blockTime utctime.UTCTime
 	var blockHash string
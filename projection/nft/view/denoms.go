@@ -15,12 +15,19 @@ import (
 const DENOMS_TABLE_NAME = "view_nft_denoms"
 
 type Denoms struct {
-	rdb *rdb.Handle
+	rdb    *rdb.Handle
+	tables Tables
 }
 
 func NewDenoms(handle *rdb.Handle) *Denoms {
+	return NewDenomsWithTablePrefix(handle, "")
+}
+
+// NewDenomsWithTablePrefix creates the view of the tables named with the table prefix
+func NewDenomsWithTablePrefix(handle *rdb.Handle, tablePrefix string) *Denoms {
 	return &Denoms{
 		handle,
+		NewTables(tablePrefix),
 	}
 }
 
@@ -28,7 +35,7 @@ func (denomsView *Denoms) Insert(denomRow *DenomRow) error {
 	var err error
 
 	sql, sqlArgs, err := denomsView.rdb.StmtBuilder.Insert(
-		DENOMS_TABLE_NAME,
+		denomsView.tables.Denoms,
 	).Columns(
 		"denom_id",
 		"name",
@@ -68,7 +75,7 @@ func (denomsView *Denoms) FindById(denomId string) (*DenomRow, error) {
 		"created_at",
 		"created_at_block_height",
 	).From(
-		DENOMS_TABLE_NAME,
+		denomsView.tables.Denoms,
 	).Where(
 		"denom_id = ?", denomId,
 	)
@@ -113,7 +120,7 @@ func (denomsView *Denoms) FindByName(denomName string) (*DenomRow, error) {
 		"created_at",
 		"created_at_block_height",
 	).From(
-		DENOMS_TABLE_NAME,
+		denomsView.tables.Denoms,
 	).Where(
 		"name = ?", denomName,
 	)
@@ -162,7 +169,7 @@ func (denomsView *Denoms) List(
 		"created_at",
 		"created_at_block_height",
 	).From(
-		DENOMS_TABLE_NAME,
+		denomsView.tables.Denoms,
 	)
 
 	if filter.MaybeCreator != nil {
@@ -180,7 +187,7 @@ func (denomsView *Denoms) List(
 		denomsView.rdb,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
-			totalView := NewDenomsTotal(rdbHandle)
+			totalView := NewDenomsTotalWithTablePrefix(rdbHandle, denomsView.tables.Prefix)
 			identifier := "-"
 			if filter.MaybeCreator != nil {
 				identifier = *filter.MaybeCreator
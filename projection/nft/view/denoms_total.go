@@ -12,7 +12,12 @@ type DenomsTotal struct {
 }
 
 func NewDenomsTotal(rdbHandle *rdb.Handle) *DenomsTotal {
+	return NewDenomsTotalWithTablePrefix(rdbHandle, "")
+}
+
+// NewDenomsTotalWithTablePrefix creates the view of the tables named with the table prefix
+func NewDenomsTotalWithTablePrefix(rdbHandle *rdb.Handle, tablePrefix string) *DenomsTotal {
 	return &DenomsTotal{
-		view.NewTotal(rdbHandle, DENOMS_TOTAL_TABLE_NAME),
+		view.NewTotal(rdbHandle, tablePrefix+DENOMS_TOTAL_TABLE_NAME),
 	}
 }
@@ -18,12 +18,19 @@ import (
 const MESSAGES_TABLE_NAME = "view_nft_messages"
 
 type Messages struct {
-	rdb *rdb.Handle
+	rdb    *rdb.Handle
+	tables Tables
 }
 
 func NewMessages(handle *rdb.Handle) *Messages {
+	return NewMessagesWithTablePrefix(handle, "")
+}
+
+// NewMessagesWithTablePrefix creates the view of the tables named with the table prefix
+func NewMessagesWithTablePrefix(handle *rdb.Handle, tablePrefix string) *Messages {
 	return &Messages{
 		handle,
+		NewTables(tablePrefix),
 	}
 }
 
@@ -34,7 +41,7 @@ func (nftMessagesView *Messages) Insert(messageRow *MessageRow) error {
 	}
 
 	stmtBuilder := nftMessagesView.rdb.StmtBuilder.Insert(
-		MESSAGES_TABLE_NAME,
+		nftMessagesView.tables.Messages,
 	).Columns(
 		"block_height",
 		"block_hash",
@@ -98,7 +105,7 @@ func (nftMessagesView *Messages) List(
 		"message_type",
 		"data",
 	).From(
-		MESSAGES_TABLE_NAME,
+		nftMessagesView.tables.Messages,
 	)
 
 	if filter.MaybeDenomId != nil {
@@ -111,7 +118,7 @@ func (nftMessagesView *Messages) List(
 		stmtBuilder = stmtBuilder.Where("maybe_drop = ?", *filter.MaybeDrop)
 	}
 	if filter.MaybeMsgTypes != nil {
-		stmtBuilder = stmtBuilder.Where(sq.Eq{"view_nft_messages.message_type": filter.MaybeMsgTypes})
+		stmtBuilder = stmtBuilder.Where(sq.Eq{nftMessagesView.tables.Messages + ".message_type": filter.MaybeMsgTypes})
 	}
 
 	if order.Id == view.ORDER_DESC {
@@ -148,7 +155,7 @@ func (nftMessagesView *Messages) List(
 				}
 			}
 
-			totalView := NewMessagesTotal(rdbHandle)
+			totalView := NewMessagesTotalWithTablePrefix(rdbHandle, nftMessagesView.tables.Prefix)
 			total, err := totalView.SumBy(totalIdentities)
 			if err != nil {
 				return int64(0), err
@@ -222,7 +229,7 @@ func (nftMessagesView *Messages) List(
 
 func (nftMessagesView *Messages) DeleteAllByDenomTokenIds(denomId string, tokenId string) (int64, error) {
 	sql, sqlArgs, err := nftMessagesView.rdb.StmtBuilder.Delete(
-		MESSAGES_TABLE_NAME,
+		nftMessagesView.tables.Messages,
 	).Where("denom_id = ? AND maybe_token_id = ?", denomId, tokenId).ToSql()
 	if err != nil {
 		return 0, fmt.Errorf("error building NFT messages deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
@@ -5,12 +5,19 @@ import (
 	"github.com/crypto-com/chain-indexing/appinterface/rdb"
 )
 
+const MESSAGES_TOTAL_TABLE_NAME = "view_nft_messages_total"
+
 type MessagesTotal struct {
 	*view.Total
 }
 
 func NewMessagesTotal(rdbHandle *rdb.Handle) *MessagesTotal {
+	return NewMessagesTotalWithTablePrefix(rdbHandle, "")
+}
+
+// NewMessagesTotalWithTablePrefix creates the view of the tables named with the table prefix
+func NewMessagesTotalWithTablePrefix(rdbHandle *rdb.Handle, tablePrefix string) *MessagesTotal {
 	return &MessagesTotal{
-		view.NewTotal(rdbHandle, "view_nft_messages_total"),
+		view.NewTotal(rdbHandle, tablePrefix+MESSAGES_TOTAL_TABLE_NAME),
 	}
 }
@@ -0,0 +1,30 @@
+package view
+
+// Tables are the names of the view tables of an NFT projection. A projection running under an Id
+// other than its type prefixes the names of its tables, so that projections of the same type do not
+// write to the same tables.
+type Tables struct {
+	Prefix string
+
+	Denoms         string
+	DenomsTotal    string
+	Tokens         string
+	TokensTotal    string
+	Messages       string
+	MessagesTotal  string
+	TokenTransfers string
+}
+
+func NewTables(tablePrefix string) Tables {
+	return Tables{
+		Prefix: tablePrefix,
+
+		Denoms:         tablePrefix + DENOMS_TABLE_NAME,
+		DenomsTotal:    tablePrefix + DENOMS_TOTAL_TABLE_NAME,
+		Tokens:         tablePrefix + TOKENS_TABLE_NAME,
+		TokensTotal:    tablePrefix + TOKENS_TOTAL_TABLE_NAME,
+		Messages:       tablePrefix + MESSAGES_TABLE_NAME,
+		MessagesTotal:  tablePrefix + MESSAGES_TOTAL_TABLE_NAME,
+		TokenTransfers: tablePrefix + TOKEN_TRANSFERS_TABLE_NAME,
+	}
+}
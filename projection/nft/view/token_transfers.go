@@ -16,12 +16,19 @@ import (
 const TOKEN_TRANSFERS_TABLE_NAME = "view_nft_token_transfers"
 
 type TokenTransfers struct {
-	rdb *rdb.Handle
+	rdb    *rdb.Handle
+	tables Tables
 }
 
 func NewTokenTransfers(handle *rdb.Handle) *TokenTransfers {
+	return NewTokenTransfersWithTablePrefix(handle, "")
+}
+
+// NewTokenTransfersWithTablePrefix creates the view of the tables named with the table prefix
+func NewTokenTransfersWithTablePrefix(handle *rdb.Handle, tablePrefix string) *TokenTransfers {
 	return &TokenTransfers{
 		handle,
+		NewTables(tablePrefix),
 	}
 }
 
@@ -31,7 +38,7 @@ func (tokenTransfersView *TokenTransfers) Insert(
 	var err error
 
 	sql, sqlArgs, err := tokenTransfersView.rdb.StmtBuilder.Insert(
-		TOKEN_TRANSFERS_TABLE_NAME,
+		tokenTransfersView.tables.TokenTransfers,
 	).Columns(
 		"denom_id",
 		"token_id",
@@ -70,67 +77,67 @@ func (tokenTransfersView *TokenTransfers) List(
 	pagination *pagination_interface.Pagination,
 ) ([]TokenTransferRowWithDenomAndTokenDetails, *pagination_interface.PaginationResult, error) {
 	stmtBuilder := tokenTransfersView.rdb.StmtBuilder.Select(
-		fmt.Sprintf("%s.denom_id", TOKEN_TRANSFERS_TABLE_NAME),
-		fmt.Sprintf("%s.name AS denom_name", DENOMS_TABLE_NAME),
-		fmt.Sprintf("%s.schema AS denom_schema", DENOMS_TABLE_NAME),
-		fmt.Sprintf("%s.token_id", TOKEN_TRANSFERS_TABLE_NAME),
-		fmt.Sprintf("%s.drop AS token_drop", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.name AS token_name", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.uri AS token_uri", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.data AS token_data", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minter AS token_minter", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.block_height", TOKEN_TRANSFERS_TABLE_NAME),
-		fmt.Sprintf("%s.transaction_hash", TOKEN_TRANSFERS_TABLE_NAME),
-		fmt.Sprintf("%s.sender", TOKEN_TRANSFERS_TABLE_NAME),
-		fmt.Sprintf("%s.recipient", TOKEN_TRANSFERS_TABLE_NAME),
-		fmt.Sprintf("%s.transferred_at", TOKEN_TRANSFERS_TABLE_NAME),
+		fmt.Sprintf("%s.denom_id", tokenTransfersView.tables.TokenTransfers),
+		fmt.Sprintf("%s.name AS denom_name", tokenTransfersView.tables.Denoms),
+		fmt.Sprintf("%s.schema AS denom_schema", tokenTransfersView.tables.Denoms),
+		fmt.Sprintf("%s.token_id", tokenTransfersView.tables.TokenTransfers),
+		fmt.Sprintf("%s.drop AS token_drop", tokenTransfersView.tables.Tokens),
+		fmt.Sprintf("%s.name AS token_name", tokenTransfersView.tables.Tokens),
+		fmt.Sprintf("%s.uri AS token_uri", tokenTransfersView.tables.Tokens),
+		fmt.Sprintf("%s.data AS token_data", tokenTransfersView.tables.Tokens),
+		fmt.Sprintf("%s.minter AS token_minter", tokenTransfersView.tables.Tokens),
+		fmt.Sprintf("%s.block_height", tokenTransfersView.tables.TokenTransfers),
+		fmt.Sprintf("%s.transaction_hash", tokenTransfersView.tables.TokenTransfers),
+		fmt.Sprintf("%s.sender", tokenTransfersView.tables.TokenTransfers),
+		fmt.Sprintf("%s.recipient", tokenTransfersView.tables.TokenTransfers),
+		fmt.Sprintf("%s.transferred_at", tokenTransfersView.tables.TokenTransfers),
 	).From(
-		TOKEN_TRANSFERS_TABLE_NAME,
+		tokenTransfersView.tables.TokenTransfers,
 	).LeftJoin(
 		fmt.Sprintf(
 			"%s ON %s.denom_id = %s.denom_id",
-			DENOMS_TABLE_NAME, DENOMS_TABLE_NAME, TOKEN_TRANSFERS_TABLE_NAME,
+			tokenTransfersView.tables.Denoms, tokenTransfersView.tables.Denoms, tokenTransfersView.tables.TokenTransfers,
 		),
 	).LeftJoin(
 		fmt.Sprintf(
 			"%s ON %s.token_id = %s.token_id",
-			TOKENS_TABLE_NAME, TOKENS_TABLE_NAME, TOKEN_TRANSFERS_TABLE_NAME,
+			tokenTransfersView.tables.Tokens, tokenTransfersView.tables.Tokens, tokenTransfersView.tables.TokenTransfers,
 		),
 	)
 
 	if filter.MaybeDenomId != nil {
 		stmtBuilder = stmtBuilder.Where(
-			fmt.Sprintf("%s.denom_id = ?", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.denom_id = ?", tokenTransfersView.tables.TokenTransfers),
 			*filter.MaybeDenomId,
 		)
 	}
 	if filter.MaybeTokenId != nil {
 		stmtBuilder = stmtBuilder.Where(
-			fmt.Sprintf("%s.token_id = ?", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.token_id = ?", tokenTransfersView.tables.TokenTransfers),
 			*filter.MaybeTokenId,
 		)
 	}
 	if filter.MaybeDrop != nil {
 		stmtBuilder = stmtBuilder.Where(
-			fmt.Sprintf("%s.drop = ?", TOKENS_TABLE_NAME),
+			fmt.Sprintf("%s.drop = ?", tokenTransfersView.tables.Tokens),
 			*filter.MaybeDrop,
 		)
 	}
 	if filter.MaybeBlockHeight != nil {
 		stmtBuilder = stmtBuilder.Where(
-			fmt.Sprintf("%s.block_height = ?", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.block_height = ?", tokenTransfersView.tables.TokenTransfers),
 			*filter.MaybeBlockHeight,
 		)
 	}
 	if filter.MaybeSender != nil {
 		stmtBuilder = stmtBuilder.Where(
-			fmt.Sprintf("%s.sender = ?", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.sender = ?", tokenTransfersView.tables.TokenTransfers),
 			*filter.MaybeSender,
 		)
 	}
 	if filter.MaybeRecipient != nil {
 		stmtBuilder = stmtBuilder.Where(
-			fmt.Sprintf("%s.recipient = ?", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.recipient = ?", tokenTransfersView.tables.TokenTransfers),
 			*filter.MaybeRecipient,
 		)
 	}
@@ -149,11 +156,11 @@ func (tokenTransfersView *TokenTransfers) List(
 
 	if order.Id == view.ORDER_DESC {
 		stmtBuilder = stmtBuilder.OrderBy(
-			fmt.Sprintf("%s.id DESC", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.id DESC", tokenTransfersView.tables.TokenTransfers),
 		)
 	} else {
 		stmtBuilder = stmtBuilder.OrderBy(
-			fmt.Sprintf("%s.id", TOKEN_TRANSFERS_TABLE_NAME),
+			fmt.Sprintf("%s.id", tokenTransfersView.tables.TokenTransfers),
 		)
 	}
 
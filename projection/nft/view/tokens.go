@@ -16,12 +16,19 @@ import (
 const TOKENS_TABLE_NAME = "view_nft_tokens"
 
 type Tokens struct {
-	rdb *rdb.Handle
+	rdb    *rdb.Handle
+	tables Tables
 }
 
 func NewTokens(handle *rdb.Handle) *Tokens {
+	return NewTokensWithTablePrefix(handle, "")
+}
+
+// NewTokensWithTablePrefix creates the view of the tables named with the table prefix
+func NewTokensWithTablePrefix(handle *rdb.Handle, tablePrefix string) *Tokens {
 	return &Tokens{
 		handle,
+		NewTables(tablePrefix),
 	}
 }
 
@@ -29,7 +36,7 @@ func (tokensView *Tokens) Insert(tokenRow *TokenRow) error {
 	var err error
 
 	sql, sqlArgs, err := tokensView.rdb.StmtBuilder.Insert(
-		TOKENS_TABLE_NAME,
+		tokensView.tables.Tokens,
 	).Columns(
 		"denom_id",
 		"token_id",
@@ -76,7 +83,7 @@ func (tokensView *Tokens) Delete(denomId string, tokenId string) (int64, error)
 	var err error
 
 	sql, sqlArgs, err := tokensView.rdb.StmtBuilder.Delete(
-		TOKENS_TABLE_NAME,
+		tokensView.tables.Tokens,
 	).Where(
 		"denom_id = ? AND token_id = ?", denomId, tokenId,
 	).ToSql()
@@ -96,31 +103,31 @@ func (tokensView *Tokens) FindById(
 	denomId string, tokenId string,
 ) (*TokenRowWithDenomname, error) {
 	selectStmtBuilder := tokensView.rdb.StmtBuilder.Select(
-		fmt.Sprintf("%s.denom_id", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.name AS denom_name", DENOMS_TABLE_NAME),
-		fmt.Sprintf("%s.schema AS denom_schema", DENOMS_TABLE_NAME),
-		fmt.Sprintf("%s.token_id", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.drop", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.name", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.uri", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.data", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minter", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.owner", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minted_at", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minted_at_block_height", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.last_edited_at", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.last_edited_at_block_height", TOKENS_TABLE_NAME),
+		fmt.Sprintf("%s.denom_id", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.name AS denom_name", tokensView.tables.Denoms),
+		fmt.Sprintf("%s.schema AS denom_schema", tokensView.tables.Denoms),
+		fmt.Sprintf("%s.token_id", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.drop", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.name", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.uri", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.data", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.minter", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.owner", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.minted_at", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.minted_at_block_height", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.last_edited_at", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.last_edited_at_block_height", tokensView.tables.Tokens),
 	).From(
-		TOKENS_TABLE_NAME,
+		tokensView.tables.Tokens,
 	).LeftJoin(
 		fmt.Sprintf(
 			"%s ON %s.denom_id = %s.denom_id",
-			DENOMS_TABLE_NAME, DENOMS_TABLE_NAME, TOKENS_TABLE_NAME,
+			tokensView.tables.Denoms, tokensView.tables.Denoms, tokensView.tables.Tokens,
 		),
 	).Where(
 		fmt.Sprintf(
 			"%s.denom_id = ? AND %s.token_id = ?",
-			TOKENS_TABLE_NAME, TOKENS_TABLE_NAME,
+			tokensView.tables.Tokens, tokensView.tables.Tokens,
 		),
 		denomId, tokenId,
 	)
@@ -173,7 +180,7 @@ func (tokensView *Tokens) FindById(
 
 func (tokensView *Tokens) Update(tokenRow TokenRow) error {
 	sql, sqlArgs, err := tokensView.rdb.StmtBuilder.Update(
-		TOKENS_TABLE_NAME,
+		tokensView.tables.Tokens,
 	).SetMap(map[string]interface{}{
 		"drop":                        tokenRow.MaybeDrop,
 		"name":                        tokenRow.Name,
@@ -206,50 +213,50 @@ func (tokensView *Tokens) List(
 	pagination *pagination_interface.Pagination,
 ) ([]TokenRowWithDenomname, *pagination_interface.PaginationResult, error) {
 	stmtBuilder := tokensView.rdb.StmtBuilder.Select(
-		fmt.Sprintf("%s.denom_id", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.name AS denom_name", DENOMS_TABLE_NAME),
-		fmt.Sprintf("%s.schema AS denom_schema", DENOMS_TABLE_NAME),
-		fmt.Sprintf("%s.token_id", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.drop", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.name", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.uri", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.data", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minter", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.owner", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minted_at", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.minted_at_block_height", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.last_edited_at", TOKENS_TABLE_NAME),
-		fmt.Sprintf("%s.last_edited_at_block_height", TOKENS_TABLE_NAME),
+		fmt.Sprintf("%s.denom_id", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.name AS denom_name", tokensView.tables.Denoms),
+		fmt.Sprintf("%s.schema AS denom_schema", tokensView.tables.Denoms),
+		fmt.Sprintf("%s.token_id", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.drop", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.name", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.uri", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.data", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.minter", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.owner", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.minted_at", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.minted_at_block_height", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.last_edited_at", tokensView.tables.Tokens),
+		fmt.Sprintf("%s.last_edited_at_block_height", tokensView.tables.Tokens),
 	).From(
-		TOKENS_TABLE_NAME,
+		tokensView.tables.Tokens,
 	).LeftJoin(
 		fmt.Sprintf(
 			"%s ON %s.denom_id = %s.denom_id",
-			DENOMS_TABLE_NAME, DENOMS_TABLE_NAME, TOKENS_TABLE_NAME,
+			tokensView.tables.Denoms, tokensView.tables.Denoms, tokensView.tables.Tokens,
 		),
 	)
 
 	if filter.MaybeDenomId != nil {
-		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.denom_id = ?", DENOMS_TABLE_NAME), *filter.MaybeDenomId)
+		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.denom_id = ?", tokensView.tables.Denoms), *filter.MaybeDenomId)
 	}
 	if filter.MaybeDrop != nil {
-		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.drop = ?", TOKENS_TABLE_NAME), *filter.MaybeDrop)
+		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.drop = ?", tokensView.tables.Tokens), *filter.MaybeDrop)
 	}
 	if filter.MaybeMinter != nil {
-		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.minter = ?", TOKENS_TABLE_NAME), *filter.MaybeMinter)
+		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.minter = ?", tokensView.tables.Tokens), *filter.MaybeMinter)
 	}
 	if filter.MaybeOwner != nil {
-		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.owner = ?", TOKENS_TABLE_NAME), *filter.MaybeOwner)
+		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.owner = ?", tokensView.tables.Tokens), *filter.MaybeOwner)
 	}
 
 	if order.MintedAt == view.ORDER_DESC {
-		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.minted_at DESC", TOKENS_TABLE_NAME))
+		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.minted_at DESC", tokensView.tables.Tokens))
 	} else if order.LastEditedAt == view.ORDER_ASC {
-		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.last_edited_at", TOKENS_TABLE_NAME))
+		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.last_edited_at", tokensView.tables.Tokens))
 	} else if order.LastEditedAt == view.ORDER_DESC {
-		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.last_edited_at DESC", TOKENS_TABLE_NAME))
+		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.last_edited_at DESC", tokensView.tables.Tokens))
 	} else {
-		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.minted_at", TOKENS_TABLE_NAME))
+		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.minted_at", tokensView.tables.Tokens))
 	}
 
 	rDbPagination := rdb.NewRDbPaginationBuilder(
@@ -257,7 +264,7 @@ func (tokensView *Tokens) List(
 		tokensView.rdb,
 	).WithCustomTotalQueryFn(
 		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
-			totalView := NewTokensTotal(rdbHandle)
+			totalView := NewTokensTotalWithTablePrefix(rdbHandle, tokensView.tables.Prefix)
 
 			denomIdIdentifier := "-"
 			dropIdentifier := "-"
@@ -355,7 +362,7 @@ func (tokensView *Tokens) ListDrops(
 	stmtBuilder := tokensView.rdb.StmtBuilder.Select(
 		"DISTINCT drop",
 	).From(
-		TOKENS_TABLE_NAME,
+		tokensView.tables.Tokens,
 	).Where(
 		"drop IS NOT NULL AND drop <> ''",
 	).OrderBy("drop")
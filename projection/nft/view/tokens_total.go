@@ -13,7 +13,12 @@ type TokensTotal struct {
 }
 
 func NewTokensTotal(rdbHandle *rdb.Handle) *TokensTotal {
+	return NewTokensTotalWithTablePrefix(rdbHandle, "")
+}
+
+// NewTokensTotalWithTablePrefix creates the view of the tables named with the table prefix
+func NewTokensTotalWithTablePrefix(rdbHandle *rdb.Handle, tablePrefix string) *TokensTotal {
 	return &TokensTotal{
-		view.NewTotal(rdbHandle, TOKENS_TOTAL_TABLE_NAME),
+		view.NewTotal(rdbHandle, tablePrefix+TOKENS_TOTAL_TABLE_NAME),
 	}
 }
@@ -1,8 +1,6 @@
 package projection
 
 import (
-	"fmt"
-
 	"github.com/crypto-com/chain-indexing/projection/chainstats"
 
 	"github.com/crypto-com/chain-indexing/appinterface/cosmosapp"
@@ -11,53 +9,133 @@ import (
 	applogger "github.com/crypto-com/chain-indexing/internal/logger"
 	"github.com/crypto-com/chain-indexing/projection/account"
 	"github.com/crypto-com/chain-indexing/projection/account_message"
+	"github.com/crypto-com/chain-indexing/projection/account_pubkey"
 	"github.com/crypto-com/chain-indexing/projection/account_transaction"
 	"github.com/crypto-com/chain-indexing/projection/block"
 	"github.com/crypto-com/chain-indexing/projection/blockevent"
+	"github.com/crypto-com/chain-indexing/projection/community_pool"
+	"github.com/crypto-com/chain-indexing/projection/multisig_account"
 	"github.com/crypto-com/chain-indexing/projection/nft"
 	"github.com/crypto-com/chain-indexing/projection/proposal"
+	"github.com/crypto-com/chain-indexing/projection/supply"
 	"github.com/crypto-com/chain-indexing/projection/transaction"
 	"github.com/crypto-com/chain-indexing/projection/validator"
 	"github.com/crypto-com/chain-indexing/projection/validatorstats"
+	"github.com/crypto-com/chain-indexing/projection/vesting_account"
 )
 
-func InitProjection(name string, params InitParams) projection_entity.Projection {
-	switch name {
-	case "Account":
+// InitProjection creates the projection of the Id with the registry of the projections in this
+// repository
+func InitProjection(id string, params InitParams) (projection_entity.Projection, error) {
+	return NewCoreRegistry().InitProjection(id, params)
+}
+
+// NewCoreRegistry creates a registry of the projections in this repository
+func NewCoreRegistry() *Registry {
+	registry := NewRegistry()
+
+	registry.MustRegister("Account", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return account.NewAccount(params.Logger, params.RdbConn, params.CosmosAppClient)
-	case "AccountTransaction":
+	}))
+	registry.MustRegister("AccountTransaction", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return account_transaction.NewAccountTransaction(params.Logger, params.RdbConn, params.AccountAddressPrefix)
-	case "AccountMessage":
-		return account_message.NewAccountMessage(params.Logger, params.RdbConn, params.AccountAddressPrefix)
-	case "Block":
+	}))
+	registry.MustRegister("AccountMessage", newAccountMessage)
+	registry.MustRegister("Block", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return block.NewBlock(params.Logger, params.RdbConn)
-	case "BlockEvent":
+	}))
+	registry.MustRegister("BlockEvent", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return blockevent.NewBlockEvent(params.Logger, params.RdbConn)
-	case "ChainStats":
+	}))
+	registry.MustRegister("CommunityPool", FixedIdFactory(func(params InitParams) projection_entity.Projection {
+		return community_pool.NewCommunityPool(params.Logger, params.RdbConn)
+	}))
+	registry.MustRegister("ChainStats", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return chainstats.NewChainStats(params.Logger, params.RdbConn)
-	case "Proposal":
+	}))
+	registry.MustRegister("Proposal", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return proposal.NewProposal(params.Logger, params.RdbConn, params.ConsNodeAddressPrefix)
-	case "Transaction":
+	}))
+	registry.MustRegister("Supply", FixedIdFactory(func(params InitParams) projection_entity.Projection {
+		return supply.NewSupply(params.Logger, params.RdbConn, params.AccountAddressPrefix)
+	}))
+	registry.MustRegister("Transaction", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return transaction.NewTransaction(params.Logger, params.RdbConn)
-	case "Validator":
+	}))
+	registry.MustRegister("Validator", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return validator.NewValidator(
 			params.Logger, params.RdbConn, params.ConsNodeAddressPrefix,
 		)
-	case "ValidatorStats":
+	}))
+	registry.MustRegister("ValidatorStats", FixedIdFactory(func(params InitParams) projection_entity.Projection {
 		return validatorstats.NewValidatorStats(params.Logger, params.RdbConn)
-	case "NFT":
-		return nft.NewNFT(params.Logger, params.RdbConn, nft.Config{
-			EnableDrop:       false,
-			DropDataAccessor: "",
-		})
-	case "CryptoComNFT":
-		return nft.NewNFT(params.Logger, params.RdbConn, nft.Config{
-			EnableDrop:       true,
-			DropDataAccessor: "dropId",
-		})
+	}))
+	registry.MustRegister("VestingAccount", FixedIdFactory(func(params InitParams) projection_entity.Projection {
+		return vesting_account.NewVestingAccount(params.Logger, params.RdbConn)
+	}))
+	registry.MustRegister("AccountPubKey", FixedIdFactory(func(params InitParams) projection_entity.Projection {
+		return account_pubkey.NewAccountPubKey(params.Logger, params.RdbConn, params.AccountAddressPrefix)
+	}))
+	registry.MustRegister("MultisigAccount", FixedIdFactory(func(params InitParams) projection_entity.Projection {
+		return multisig_account.NewMultisigAccount(params.Logger, params.RdbConn, params.AccountAddressPrefix)
+	}))
+	registry.MustRegister("NFT", newNFTFactory(nft.Config{
+		EnableDrop:       false,
+		DropDataAccessor: "",
+	}))
+	registry.MustRegister("CryptoComNFT", newNFTFactory(nft.Config{
+		EnableDrop:       true,
+		DropDataAccessor: "dropId",
+	}))
+	registry.MustRegisterSharedTables("CryptoComNFT", "NFT")
+
+	registry.MustRegisterMigrations("Account", account.Migrations)
+	registry.MustRegisterMigrations("AccountTransaction", account_transaction.Migrations)
+	registry.MustRegisterMigrations("AccountMessage", account_message.Migrations)
+	registry.MustRegisterMigrations("Block", block.Migrations)
+	registry.MustRegisterMigrations("BlockEvent", blockevent.Migrations)
+	registry.MustRegisterMigrations("CommunityPool", community_pool.Migrations)
+	registry.MustRegisterMigrations("ChainStats", chainstats.Migrations)
+	registry.MustRegisterMigrations("Proposal", proposal.Migrations)
+	registry.MustRegisterMigrations("Supply", supply.Migrations)
+	registry.MustRegisterMigrations("Transaction", transaction.Migrations)
+	registry.MustRegisterMigrations("Validator", validator.Migrations)
+	registry.MustRegisterMigrations("ValidatorStats", validatorstats.Migrations)
+	registry.MustRegisterMigrations("VestingAccount", vesting_account.Migrations)
+	registry.MustRegisterMigrations("AccountPubKey", account_pubkey.Migrations)
+	registry.MustRegisterMigrations("MultisigAccount", multisig_account.Migrations)
+	registry.MustRegisterMigrations("NFT", nft.Migrations)
 	// register more projections here
-	default:
-		panic(fmt.Sprintf("Unrecognized projection: %s", name))
+
+	return registry
+}
+
+func newAccountMessage(id string, params InitParams) (projection_entity.Projection, error) {
+	config := account_message.Config{}
+	if err := DecodeConfig(params.Config, &config); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	config.TablePrefix = params.TablePrefix
+	return account_message.NewAccountMessageWithConfig(
+		params.Logger, params.RdbConn, id, params.AccountAddressPrefix, config,
+	), nil
+}
+
+// newNFTFactory creates the factory of NFT projections with the config defaulting to defaultConfig
+func newNFTFactory(defaultConfig nft.Config) Factory {
+	return func(id string, params InitParams) (projection_entity.Projection, error) {
+		config := defaultConfig
+		if err := DecodeConfig(params.Config, &config); err != nil {
+			return nil, err
+		}
+		if err := config.Validate(); err != nil {
+			return nil, err
+		}
+		config.TablePrefix = params.TablePrefix
+		return nft.NewNFTWithId(params.Logger, params.RdbConn, id, config), nil
 	}
 }
 
@@ -68,4 +146,10 @@ type InitParams struct {
 	CosmosAppClient       cosmosapp.Client
 	AccountAddressPrefix  string
 	ConsNodeAddressPrefix string
+
+	// The `[projection.config.<Id>]` section of the projection, nil when there is none
+	Config map[string]interface{}
+	// Prefix of the names of the view tables of the projection, set by the registry from the Id. A
+	// projection type which can run under another Id passes it to its views.
+	TablePrefix string
 }
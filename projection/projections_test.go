@@ -0,0 +1,85 @@
+package projection_test
+
+import (
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/projection"
+	"github.com/crypto-com/chain-indexing/projection/nft"
+)
+
+var _ = Describe("InitProjection", func() {
+	anyInitParams := func(config map[string]interface{}) projection.InitParams {
+		return projection.InitParams{
+			Logger:  NewFakeLogger(),
+			RdbConn: NewFakeRDbConn(),
+
+			AccountAddressPrefix:  "tcro",
+			ConsNodeAddressPrefix: "tcrocnclcons",
+
+			Config: config,
+		}
+	}
+
+	It("should create the projection of the Id when there is no config", func() {
+		block, err := projection.InitProjection("Block", anyInitParams(nil))
+		Expect(err).To(BeNil())
+		Expect(block.Id()).To(Equal("Block"))
+
+		cryptoComNFT, err := projection.InitProjection("CryptoComNFT", anyInitParams(nil))
+		Expect(err).To(BeNil())
+		Expect(cryptoComNFT.Id()).To(Equal("CryptoComNFT"))
+	})
+
+	It("should create the projection of the configured type under the Id", func() {
+		myNFT, err := projection.InitProjection("MyNFT", anyInitParams(map[string]interface{}{
+			"type":               "NFT",
+			"enable_drop":        true,
+			"drop_data_accessor": "drop",
+		}))
+		Expect(err).To(BeNil())
+		Expect(myNFT).To(BeAssignableToTypeOf(&nft.NFT{}))
+		Expect(myNFT.Id()).To(Equal("MyNFT"))
+
+		accountMessage, err := projection.InitProjection("AccountMessage", anyInitParams(map[string]interface{}{
+			"exclude_message_types": []interface{}{"MsgVote"},
+			"exclude_failed":        true,
+		}))
+		Expect(err).To(BeNil())
+		Expect(accountMessage.Id()).To(Equal("AccountMessage"))
+	})
+
+	It("should return error when the config is invalid", func() {
+		_, err := projection.InitProjection("Unknown", anyInitParams(nil))
+		Expect(err).To(MatchError(HavePrefix("unrecognized projection type: Unknown (registered types: Account, ")))
+
+		_, err = projection.InitProjection("MyBlock", anyInitParams(map[string]interface{}{
+			"type": "Block",
+		}))
+		Expect(err).To(MatchError("projection type `Block` cannot run under another Id"))
+
+		_, err = projection.InitProjection("Block", anyInitParams(map[string]interface{}{
+			"unknown_key": 1,
+		}))
+		Expect(err).NotTo(BeNil())
+
+		_, err = projection.InitProjection("MyNFT", anyInitParams(map[string]interface{}{
+			"type":        "NFT",
+			"enable_drop": true,
+		}))
+		Expect(err).To(MatchError("drop_data_accessor is required when enable_drop is true"))
+
+		_, err = projection.InitProjection("AccountMessage", anyInitParams(map[string]interface{}{
+			"include_message_types": []interface{}{"MsgSend"},
+			"exclude_message_types": []interface{}{"MsgVote"},
+		}))
+		Expect(err).To(MatchError("only one of include_message_types and exclude_message_types can be set"))
+
+		_, err = projection.InitProjection("AccountMessage", anyInitParams(map[string]interface{}{
+			"include_message_types": []interface{}{"MsgUnknown"},
+		}))
+		Expect(err).To(MatchError("unknown message type: MsgUnknown"))
+	})
+})
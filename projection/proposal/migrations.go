@@ -0,0 +1,136 @@
+package proposal
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210428004519,
+		Name:    "view_proposals",
+		Up: `CREATE TABLE view_proposals (
+    id BIGSERIAL,
+    proposal_id VARCHAR NOT NULL,
+    title VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    proposer_address VARCHAR NOT NULL,
+    maybe_proposer_operator_address VARCHAR NULL,
+    data JSONB NOT NULL,
+    initial_deposit JSONB NOT NULL,
+    total_deposit JSONB NOT NULL,
+    total_vote NUMERIC NOT NULL,
+    transaction_hash VARCHAR NOT NULL,
+    submit_block_height BIGINT NOT NULL,
+    submit_time BIGINT NOT NULL,
+    deposit_end_time BIGINT NOT NULL,
+    maybe_voting_start_time BIGINT NULL,
+    maybe_voting_end_block_height BIGINT NULL,
+    maybe_voting_end_time BIGINT NULL,
+    PRIMARY KEY (id),
+    UNIQUE(proposal_id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_proposals;`,
+	},
+	{
+		Version: 20210428004523,
+		Name:    "view_proposal_votes",
+		Up: `CREATE TABLE view_proposal_votes (
+    id BIGSERIAL,
+    proposal_id VARCHAR NOT NULL,
+    voter_address VARCHAR NOT NULL,
+    maybe_voter_operator_address VARCHAR NULL,
+    transaction_hash VARCHAR NOT NULL,
+    vote_at_block_height BIGINT NOT NULL,
+    vote_at_block_time BIGINT NOT NULL,
+    answer VARCHAR NOT NULL,
+    histories JSONB NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX view_proposal_votes_vote_at_block_height_btree_index ON view_proposal_votes USING btree(vote_at_block_height);`,
+		Down: `DROP TABLE IF EXISTS view_proposal_votes;`,
+	},
+	{
+		Version: 20210428004529,
+		Name:    "view_proposal_depositors",
+		Up: `CREATE TABLE view_proposal_depositors (
+    id BIGSERIAL,
+    proposal_id VARCHAR NOT NULL,
+    depositor_address VARCHAR NOT NULL,
+    maybe_depositor_operator_address VARCHAR NULL,
+    transaction_hash VARCHAR NOT NULL,
+    deposit_at_block_height BIGINT NOT NULL,
+    deposit_at_block_time BIGINT NOT NULL,
+    amount JSONB NOT NULL,
+    PRIMARY KEY (id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_proposal_depositors;`,
+	},
+	{
+		Version: 20210428142437,
+		Name:    "create_view_proposal_params",
+		Up: `CREATE TABLE view_proposal_params (
+    module VARCHAR,
+    key VARCHAR,
+    value VARCHAR NOT NULL,
+    PRIMARY KEY (module, key)
+);`,
+		Down: `DROP TABLE IF EXISTS view_proposal_params;`,
+	},
+	{
+		Version: 20210428142527,
+		Name:    "create_view_proposal_validators",
+		Up: `CREATE TABLE view_proposal_validators (
+    id BIGSERIAL,
+    consensus_node_address VARCHAR NOT NULL,
+    operator_address VARCHAR NOT NULL,
+    initial_delegator_address VARCHAR NOT NULL,
+    tendermint_pubkey VARCHAR NOT NULL,
+    tendermint_address VARCHAR NOT NULL,
+    moniker VARCHAR NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE(consensus_node_address, operator_address)
+);
+
+CREATE INDEX view_proposal_validators_initial_delegator_address_btree_index ON view_proposal_validators USING btree (initial_delegator_address);
+
+CREATE INDEX view_proposal_validators_operator_address_btree_index ON view_proposal_validators USING btree (operator_address);`,
+		Down: `DROP TABLE IF EXISTS view_proposal_validators;
+
+DROP INDEX IF EXISTS view_proposal_validators_initial_delegator_address_btree_index;
+
+DROP INDEX IF EXISTS view_proposal_validators_initial_delegator_address_btree_index;`,
+	},
+	{
+		Version: 20210428163550,
+		Name:    "create_view_proposal_votes_total",
+		Up: `CREATE TABLE view_proposal_votes_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_proposal_votes_total;`,
+	},
+	{
+		Version: 20210428163553,
+		Name:    "create_view_proposal_depositors_total",
+		Up: `CREATE TABLE view_proposal_depositors_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_proposal_depositors_total;`,
+	},
+	{
+		Version: 20210710031225,
+		Name:    "view_proposals_fulltext_index",
+		Up: `CREATE INDEX view_proposals_title_description_gin_fulltext_index ON view_proposals USING gin (to_tsvector('english', title || ' ' || description));
+
+CREATE INDEX view_proposals_title_gin_trgm_index ON view_proposals USING gin (title gin_trgm_ops);`,
+		Down: `DROP INDEX IF EXISTS view_proposals_title_description_gin_fulltext_index;
+
+DROP INDEX IF EXISTS view_proposals_title_gin_trgm_index;`,
+	},
+}
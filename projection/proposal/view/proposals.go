@@ -324,6 +324,11 @@ func (proposalView *Proposals) List(
 	if filter.MaybeStatus != nil {
 		stmtBuilder = stmtBuilder.Where(fmt.Sprintf("%s.status = ?", PROPOSALS_TABLE_NAME), *filter.MaybeStatus)
 	}
+	if filter.MaybeProposerAddress != nil {
+		stmtBuilder = stmtBuilder.Where(
+			fmt.Sprintf("%s.proposer_address = ?", PROPOSALS_TABLE_NAME), *filter.MaybeProposerAddress,
+		)
+	}
 
 	if order.Id == view.ORDER_DESC {
 		stmtBuilder = stmtBuilder.OrderBy(fmt.Sprintf("%s.proposal_id DESC", PROPOSALS_TABLE_NAME))
@@ -429,7 +434,8 @@ func (proposalView *Proposals) List(
 }
 
 type ProposalListFilter struct {
-	MaybeStatus *string
+	MaybeStatus          *string
+	MaybeProposerAddress *string
 }
 
 type ProposalListOrder struct {
@@ -0,0 +1,327 @@
+package projection
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/internal/filereader/toml"
+)
+
+// CONFIG_TYPE_KEY is the key of the projection type in the config of a projection. The projection
+// Id is the type when it is not set.
+const CONFIG_TYPE_KEY = "type"
+
+const MIGRATIONS_TABLE_PREFIX = "projection_migrations"
+
+// Factory creates a projection of a type under the Id. params.Config is the `[projection.config.<Id>]`
+// section of the projection, which the factory decodes with DecodeConfig and validates.
+type Factory func(id string, params InitParams) (projection_entity.Projection, error)
+
+// Registry resolves the projection Ids enabled in the config to the factories of their types.
+// Projections outside of this repository are registered to it to build a custom binary.
+type Registry struct {
+	factories   map[string]Factory
+	tableOwners map[string]string
+	migrations  map[string][]rdb.Migration
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories:   make(map[string]Factory),
+		tableOwners: make(map[string]string),
+		migrations:  make(map[string][]rdb.Migration),
+	}
+}
+
+// Register registers the factory of the projection type. A type can only be registered once.
+func (registry *Registry) Register(projectionType string, factory Factory) error {
+	if _, exist := registry.factories[projectionType]; exist {
+		return fmt.Errorf("projection type `%s` already registered", projectionType)
+	}
+
+	registry.factories[projectionType] = factory
+	return nil
+}
+
+func (registry *Registry) MustRegister(projectionType string, factory Factory) {
+	if err := registry.Register(projectionType, factory); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterSharedTables registers the projection type as writing to the view tables of the owner
+// type, e.g. when both types are created from the same projection with different default configs.
+func (registry *Registry) RegisterSharedTables(projectionType string, ownerType string) error {
+	for _, registeredType := range []string{projectionType, ownerType} {
+		if _, exist := registry.factories[registeredType]; !exist {
+			return fmt.Errorf("projection type `%s` is not registered", registeredType)
+		}
+	}
+	if _, exist := registry.tableOwners[projectionType]; exist {
+		return fmt.Errorf("tables of projection type `%s` already registered", projectionType)
+	}
+
+	registry.tableOwners[projectionType] = registry.TableOwner(ownerType)
+	return nil
+}
+
+func (registry *Registry) MustRegisterSharedTables(projectionType string, ownerType string) {
+	if err := registry.RegisterSharedTables(projectionType, ownerType); err != nil {
+		panic(err)
+	}
+}
+
+// TableOwner returns the projection type owning the view tables written by the projection type,
+// which is the type itself unless it shares the tables of another type
+func (registry *Registry) TableOwner(projectionType string) string {
+	if ownerType, ok := registry.tableOwners[projectionType]; ok {
+		return ownerType
+	}
+	return projectionType
+}
+
+// ProjectionTables are the view tables written by a projection
+type ProjectionTables struct {
+	// Projection type owning the tables, whose migrations create them
+	Owner string
+	// Prefix of the names of the tables, empty for the projection running under its type as Id
+	Prefix string
+}
+
+// ResolveTables returns the view tables written by the projection Id with its config. A projection
+// running under its type as Id writes to the tables named after the table owner of the type. A
+// projection running under another Id writes to the same tables prefixed by the lower case Id, so that
+// projections of the same type can run under different Ids and configs.
+func (registry *Registry) ResolveTables(id string, config map[string]interface{}) (ProjectionTables, error) {
+	projectionType, err := registry.ResolveType(id, config)
+	if err != nil {
+		return ProjectionTables{}, err
+	}
+
+	tables := ProjectionTables{
+		Owner: registry.TableOwner(projectionType),
+	}
+	if id != projectionType {
+		if !tablePrefixIdPattern.MatchString(id) {
+			return ProjectionTables{}, fmt.Errorf(
+				"projection Id %s of type `%s` prefixes its tables and can only contain letters, digits and underscores",
+				id, projectionType,
+			)
+		}
+		tables.Prefix = strings.ToLower(id) + "_"
+	}
+	return tables, nil
+}
+
+var tablePrefixIdPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// CheckSharedTables returns error when two of the projection Ids write to the same view tables, i.e.
+// the types of both share the same tables and both run under their types as Ids, because they would
+// overwrite the records of each other
+func (registry *Registry) CheckSharedTables(
+	ids []string,
+	configs map[string]map[string]interface{},
+) error {
+	idsByTables := make(map[ProjectionTables]string, len(ids))
+	for _, id := range ids {
+		tables, err := registry.ResolveTables(id, configs[id])
+		if err != nil {
+			return fmt.Errorf("error resolving tables of projection %s: %v", id, err)
+		}
+
+		if otherId, exist := idsByTables[tables]; exist {
+			return fmt.Errorf(
+				"projections %s and %s both write to the tables of projection type `%s`, only one of them can be enabled",
+				otherId, id, tables.Owner,
+			)
+		}
+		idsByTables[tables] = id
+	}
+	return nil
+}
+
+// ServedProjections returns the table owners of the projection Ids writing to the tables without
+// prefix in alphabetical order, which are the view tables read by the APIs
+func (registry *Registry) ServedProjections(
+	ids []string,
+	configs map[string]map[string]interface{},
+) (view.Projections, error) {
+	projections := make(view.Projections, 0, len(ids))
+	for _, id := range ids {
+		tables, err := registry.ResolveTables(id, configs[id])
+		if err != nil {
+			return nil, fmt.Errorf("error resolving tables of projection %s: %v", id, err)
+		}
+		if tables.Prefix == "" {
+			projections = append(projections, tables.Owner)
+		}
+	}
+	sort.Strings(projections)
+	return projections, nil
+}
+
+// RegisterMigrations registers the migrations of the tables owned by the projection type. They are
+// applied by `chain-indexing migrate` with a version table of the type, so that only the tables of
+// the enabled projections are created, and the tables of a projection can be dropped on their own.
+func (registry *Registry) RegisterMigrations(projectionType string, migrations []rdb.Migration) error {
+	if _, exist := registry.factories[projectionType]; !exist {
+		return fmt.Errorf("projection type `%s` is not registered", projectionType)
+	}
+	if _, exist := registry.migrations[projectionType]; exist {
+		return fmt.Errorf("migrations of projection type `%s` already registered", projectionType)
+	}
+
+	registry.migrations[projectionType] = migrations
+	return nil
+}
+
+func (registry *Registry) MustRegisterMigrations(projectionType string, migrations []rdb.Migration) {
+	if err := registry.RegisterMigrations(projectionType, migrations); err != nil {
+		panic(err)
+	}
+}
+
+// Migrations returns the migrations of the tables written by the projection type, which are owned by
+// its TableOwner, nil when there is none
+func (registry *Registry) Migrations(projectionType string) []rdb.Migration {
+	return registry.migrations[registry.TableOwner(projectionType)]
+}
+
+// MigrationOwners returns the projection types with registered migrations in alphabetical order
+func (registry *Registry) MigrationOwners() []string {
+	ownerTypes := make([]string, 0, len(registry.migrations))
+	for ownerType := range registry.migrations {
+		ownerTypes = append(ownerTypes, ownerType)
+	}
+	sort.Strings(ownerTypes)
+	return ownerTypes
+}
+
+// ProjectionMigrations returns the migrations of the tables written by the projection Id with its
+// config, nil when there is none. The names of the view tables and their indexes, which all start
+// with `view_`, are prefixed by the table prefix of the projection.
+func (registry *Registry) ProjectionMigrations(
+	id string,
+	config map[string]interface{},
+) ([]rdb.Migration, error) {
+	tables, err := registry.ResolveTables(id, config)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := registry.migrations[tables.Owner]
+	if migrations == nil || tables.Prefix == "" {
+		return migrations, nil
+	}
+	prefixedMigrations := make([]rdb.Migration, 0, len(migrations))
+	for _, migration := range migrations {
+		prefixedMigrations = append(prefixedMigrations, rdb.Migration{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Up:      viewNamePattern.ReplaceAllString(migration.Up, tables.Prefix+"view_"),
+			Down:    viewNamePattern.ReplaceAllString(migration.Down, tables.Prefix+"view_"),
+		})
+	}
+	return prefixedMigrations, nil
+}
+
+var viewNamePattern = regexp.MustCompile(`\bview_`)
+
+// MigrationsTable returns the name of the version table of the migrations of the tables
+func MigrationsTable(tables ProjectionTables) string {
+	return fmt.Sprintf("%s%s_%s", tables.Prefix, MIGRATIONS_TABLE_PREFIX, strings.ToLower(tables.Owner))
+}
+
+// ResolveType returns the registered type of the projection Id with its config
+func (registry *Registry) ResolveType(id string, config map[string]interface{}) (string, error) {
+	projectionType, err := configType(id, config)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := registry.factories[projectionType]; !ok {
+		return "", fmt.Errorf(
+			"unrecognized projection type: %s (registered types: %s)",
+			projectionType, strings.Join(registry.Types(), ", "),
+		)
+	}
+	return projectionType, nil
+}
+
+// Types returns the registered projection types in alphabetical order
+func (registry *Registry) Types() []string {
+	projectionTypes := make([]string, 0, len(registry.factories))
+	for projectionType := range registry.factories {
+		projectionTypes = append(projectionTypes, projectionType)
+	}
+	sort.Strings(projectionTypes)
+	return projectionTypes
+}
+
+// InitProjection creates the projection of the Id with the factory of its type, which is the Id
+// unless the config of the projection sets `type`
+func (registry *Registry) InitProjection(id string, params InitParams) (projection_entity.Projection, error) {
+	projectionType, err := registry.ResolveType(id, params.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := registry.ResolveTables(id, params.Config)
+	if err != nil {
+		return nil, err
+	}
+	params.TablePrefix = tables.Prefix
+
+	return registry.factories[projectionType](id, params)
+}
+
+// FixedIdFactory creates the factory of a projection type without config, which only runs under its
+// type as Id
+func FixedIdFactory(newProjection func(params InitParams) projection_entity.Projection) Factory {
+	return func(id string, params InitParams) (projection_entity.Projection, error) {
+		if err := DecodeConfig(params.Config, &struct{}{}); err != nil {
+			return nil, err
+		}
+
+		projection := newProjection(params)
+		if projection.Id() != id {
+			return nil, fmt.Errorf("projection type `%s` cannot run under another Id", projection.Id())
+		}
+		return projection, nil
+	}
+}
+
+// configType returns the projection type set in the config, the Id when it is not set
+func configType(id string, config map[string]interface{}) (string, error) {
+	maybeType, ok := config[CONFIG_TYPE_KEY]
+	if !ok {
+		return id, nil
+	}
+	projectionType, ok := maybeType.(string)
+	if !ok || projectionType == "" {
+		return "", fmt.Errorf("%s must be a projection type name", CONFIG_TYPE_KEY)
+	}
+	return projectionType, nil
+}
+
+// DecodeConfig decodes the config of a projection other than the type into the typed config,
+// rejecting unknown keys
+func DecodeConfig(config map[string]interface{}, typedConfig interface{}) error {
+	values := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		if key == CONFIG_TYPE_KEY {
+			continue
+		}
+		values[key] = value
+	}
+
+	if err := toml.DecodeMap(values, typedConfig); err != nil {
+		return fmt.Errorf("error decoding config: %v", err)
+	}
+	return nil
+}
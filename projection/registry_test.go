@@ -0,0 +1,198 @@
+package projection_test
+
+import (
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	. "github.com/crypto-com/chain-indexing/entity/projection/test"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/projection"
+	"github.com/crypto-com/chain-indexing/projection/account_message"
+	"github.com/crypto-com/chain-indexing/projection/block"
+	"github.com/crypto-com/chain-indexing/projection/nft"
+)
+
+var _ = Describe("Registry", func() {
+	anyInitParams := func(config map[string]interface{}) projection.InitParams {
+		return projection.InitParams{
+			Logger:  NewFakeLogger(),
+			RdbConn: NewFakeRDbConn(),
+
+			Config: config,
+		}
+	}
+
+	It("should create the projections of a registered type under the configured Ids", func() {
+		registry := projection.NewCoreRegistry()
+		var createdIds []string
+		var createdConfigs []anyPluginConfig
+		Expect(registry.Register("AnyPlugin", func(
+			id string,
+			params projection.InitParams,
+		) (projection_entity.Projection, error) {
+			config := anyPluginConfig{Threshold: 1}
+			if err := projection.DecodeConfig(params.Config, &config); err != nil {
+				return nil, err
+			}
+			createdIds = append(createdIds, id)
+			createdConfigs = append(createdConfigs, config)
+			return NewFakeProjection(), nil
+		})).To(Succeed())
+
+		_, err := registry.InitProjection("AnyPlugin", anyInitParams(nil))
+		Expect(err).To(BeNil())
+		_, err = registry.InitProjection("AnyOtherPlugin", anyInitParams(map[string]interface{}{
+			"type":      "AnyPlugin",
+			"threshold": 10,
+		}))
+		Expect(err).To(BeNil())
+
+		Expect(createdIds).To(Equal([]string{"AnyPlugin", "AnyOtherPlugin"}))
+		Expect(createdConfigs).To(Equal([]anyPluginConfig{{Threshold: 1}, {Threshold: 10}}))
+		Expect(registry.Types()).To(ContainElement("AnyPlugin"))
+	})
+
+	It("should return error when the type is already registered", func() {
+		registry := projection.NewCoreRegistry()
+
+		Expect(registry.Register("Block", projection.FixedIdFactory(
+			func(_ projection.InitParams) projection_entity.Projection {
+				return NewFakeProjection()
+			},
+		))).To(MatchError("projection type `Block` already registered"))
+	})
+
+	It("should only create a projection without config under its type", func() {
+		registry := projection.NewRegistry()
+		registry.MustRegister("FakeProjection", projection.FixedIdFactory(
+			func(_ projection.InitParams) projection_entity.Projection {
+				return NewFakeProjection()
+			},
+		))
+
+		fakeProjection, err := registry.InitProjection("FakeProjection", anyInitParams(nil))
+		Expect(err).To(BeNil())
+		Expect(fakeProjection.Id()).To(Equal("FakeProjection"))
+
+		_, err = registry.InitProjection("AnyOtherProjection", anyInitParams(map[string]interface{}{
+			"type": "FakeProjection",
+		}))
+		Expect(err).To(MatchError("projection type `FakeProjection` cannot run under another Id"))
+	})
+
+	It("should keep the migrations of a registered type with a version table of the type", func() {
+		registry := projection.NewCoreRegistry()
+		migrations := []rdb.Migration{
+			{Version: 1, Name: "view_any_plugin", Up: "CREATE TABLE", Down: "DROP TABLE"},
+		}
+
+		Expect(registry.RegisterMigrations("AnyPlugin", migrations)).To(
+			MatchError("projection type `AnyPlugin` is not registered"),
+		)
+		registry.MustRegister("AnyPlugin", projection.FixedIdFactory(
+			func(_ projection.InitParams) projection_entity.Projection {
+				return NewFakeProjection()
+			},
+		))
+		Expect(registry.RegisterMigrations("AnyPlugin", migrations)).To(Succeed())
+		Expect(registry.RegisterMigrations("AnyPlugin", migrations)).To(
+			MatchError("migrations of projection type `AnyPlugin` already registered"),
+		)
+
+		projectionType, err := registry.ResolveType("AnyOtherPlugin", map[string]interface{}{
+			"type": "AnyPlugin",
+		})
+		Expect(err).To(BeNil())
+		Expect(projectionType).To(Equal("AnyPlugin"))
+		Expect(registry.Migrations(projectionType)).To(Equal(migrations))
+		Expect(registry.Migrations("Block")).To(Equal(block.Migrations))
+		Expect(registry.Migrations("CryptoComNFT")).To(Equal(nft.Migrations))
+		Expect(registry.MigrationOwners()).To(HaveLen(17))
+		Expect(registry.MigrationOwners()).To(ContainElements("AnyPlugin", "Block", "NFT"))
+		Expect(registry.MigrationOwners()).NotTo(ContainElement("CryptoComNFT"))
+		Expect(projection.MigrationsTable(projection.ProjectionTables{
+			Owner: projectionType,
+		})).To(Equal("projection_migrations_anyplugin"))
+	})
+
+	It("should return error when two projections write to the same tables", func() {
+		registry := projection.NewCoreRegistry()
+
+		Expect(registry.CheckSharedTables([]string{"Block", "NFT", "CryptoComNFT"}, nil)).To(MatchError(
+			"projections NFT and CryptoComNFT both write to the tables of projection type `NFT`, only one of them can be enabled",
+		))
+		Expect(registry.CheckSharedTables(
+			[]string{"NFT", "MyNFT", "AccountMessage", "MyAccountMessage"},
+			map[string]map[string]interface{}{
+				"MyNFT":            {"type": "CryptoComNFT"},
+				"MyAccountMessage": {"type": "AccountMessage"},
+			},
+		)).To(Succeed())
+		Expect(registry.CheckSharedTables(
+			[]string{"MyNFT", "mynft"},
+			map[string]map[string]interface{}{
+				"MyNFT": {"type": "NFT"},
+				"mynft": {"type": "CryptoComNFT"},
+			},
+		)).To(MatchError(
+			"projections MyNFT and mynft both write to the tables of projection type `NFT`, only one of them can be enabled",
+		))
+		Expect(registry.TableOwner("CryptoComNFT")).To(Equal("NFT"))
+		Expect(registry.TableOwner("Block")).To(Equal("Block"))
+	})
+
+	It("should prefix the tables of projection running under another Id", func() {
+		registry := projection.NewCoreRegistry()
+
+		tables, err := registry.ResolveTables("CryptoComNFT", nil)
+		Expect(err).To(BeNil())
+		Expect(tables).To(Equal(projection.ProjectionTables{Owner: "NFT"}))
+		Expect(projection.MigrationsTable(tables)).To(Equal("projection_migrations_nft"))
+		migrations, err := registry.ProjectionMigrations("CryptoComNFT", nil)
+		Expect(err).To(BeNil())
+		Expect(migrations).To(Equal(nft.Migrations))
+
+		config := map[string]interface{}{"type": "AccountMessage"}
+		tables, err = registry.ResolveTables("VoteMessages", config)
+		Expect(err).To(BeNil())
+		Expect(tables).To(Equal(projection.ProjectionTables{Owner: "AccountMessage", Prefix: "votemessages_"}))
+		Expect(projection.MigrationsTable(tables)).To(Equal("votemessages_projection_migrations_accountmessage"))
+		migrations, err = registry.ProjectionMigrations("VoteMessages", config)
+		Expect(err).To(BeNil())
+		Expect(migrations).To(HaveLen(len(account_message.Migrations)))
+		Expect(migrations[0].Version).To(Equal(account_message.Migrations[0].Version))
+		Expect(migrations[0].Up).To(HavePrefix("CREATE TABLE votemessages_view_account_messages ("))
+		Expect(migrations[2].Up).To(Equal(
+			"CREATE INDEX votemessages_view_account_messages_account_btree_index ON votemessages_view_account_messages USING btree (account);",
+		))
+		Expect(migrations[2].Down).To(Equal(
+			"DROP INDEX IF EXISTS votemessages_view_account_messages_account_btree_index;",
+		))
+
+		_, err = registry.ResolveTables("Vote-Messages", config)
+		Expect(err).To(MatchError(
+			"projection Id Vote-Messages of type `AccountMessage` prefixes its tables and can only contain letters, digits and underscores",
+		))
+	})
+
+	It("should serve the tables without prefix of the projections", func() {
+		registry := projection.NewCoreRegistry()
+
+		projections, err := registry.ServedProjections(
+			[]string{"Transaction", "CryptoComNFT", "Block", "VoteMessages"},
+			map[string]map[string]interface{}{
+				"VoteMessages": {"type": "AccountMessage"},
+			},
+		)
+		Expect(err).To(BeNil())
+		Expect(projections).To(Equal(view.Projections{"Block", "NFT", "Transaction"}))
+	})
+})
+
+type anyPluginConfig struct {
+	Threshold int64 `toml:"threshold"`
+}
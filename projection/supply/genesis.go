@@ -0,0 +1,34 @@
+package supply
+
+import (
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+// SupplyFromGenesis returns the initial supply of the chain. When the genesis does not specify the
+// bank supply, it is computed as the sum of all genesis balances.
+func SupplyFromGenesis(genesis *genesis.Genesis) (coin.Coins, error) {
+	if len(genesis.AppState.Bank.Supply) > 0 {
+		supply, err := tmcosmosutils.NewCoinsFromAmountInterface(genesis.AppState.Bank.Supply)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing genesis bank supply: %v", err)
+		}
+		return supply, nil
+	}
+
+	supply := coin.NewEmptyCoins()
+	for _, balance := range genesis.AppState.Bank.Balances {
+		for _, rawCoin := range balance.Coins {
+			unit, err := coin.NewCoinFromString(rawCoin.Denom, rawCoin.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing genesis balance of %s: %v", balance.Address, err)
+			}
+			supply = supply.Add(unit)
+		}
+	}
+
+	return supply, nil
+}
@@ -0,0 +1,60 @@
+package supply
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+// GovDepositMovements keeps the deposits burnt and refunded by the gov module within a block. The
+// burn and transfer events do not carry the proposal they belong to, so each settled proposal
+// claims the movement matching its deposits. A movement can only be claimed once, so that a burn
+// of one proposal is never taken as the burn of another proposal in the same block.
+type GovDepositMovements struct {
+	burns    []coin.Coins
+	refunded coin.Coins
+}
+
+func NewGovDepositMovements(govModuleAddress string, events []event_entity.Event) *GovDepositMovements {
+	movements := &GovDepositMovements{
+		burns:    make([]coin.Coins, 0),
+		refunded: coin.NewEmptyCoins(),
+	}
+	for _, event := range events {
+		if burnedEvent, ok := event.(*event_usecase.Burned); ok {
+			if burnedEvent.Burner == govModuleAddress {
+				movements.burns = append(movements.burns, burnedEvent.Amount)
+			}
+		} else if transferredEvent, ok := event.(*event_usecase.AccountTransferred); ok {
+			if transferredEvent.Sender == govModuleAddress {
+				movements.refunded = movements.refunded.Add(transferredEvent.Amount...)
+			}
+		}
+	}
+
+	return movements
+}
+
+// ClaimBurn claims the gov module burn of exactly the deposits. It returns false when there is no
+// such burn left in the block.
+func (movements *GovDepositMovements) ClaimBurn(deposits coin.Coins) bool {
+	for i, burn := range movements.burns {
+		if burn.IsAllGTE(deposits) && deposits.IsAllGTE(burn) {
+			movements.burns = append(movements.burns[:i], movements.burns[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClaimRefund claims the deposits from the coins transferred out of the gov module. It returns
+// false when the unclaimed transfers do not cover the deposits.
+func (movements *GovDepositMovements) ClaimRefund(deposits coin.Coins) bool {
+	if !movements.refunded.IsAllGTE(deposits) {
+		return false
+	}
+	movements.refunded = movements.refunded.Sub(deposits)
+
+	return true
+}
@@ -0,0 +1,63 @@
+package supply
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210705120412,
+		Name:    "view_supplies",
+		Up: `CREATE TABLE view_supplies (
+    denom VARCHAR NOT NULL,
+    total NUMERIC NOT NULL,
+    maybe_inflation VARCHAR NULL,
+    maybe_bonded_ratio VARCHAR NULL,
+    maybe_annual_provisions VARCHAR NULL,
+    last_updated_block_height BIGINT NOT NULL,
+    last_updated_block_time BIGINT NOT NULL,
+    PRIMARY KEY (denom)
+)`,
+		Down: `DROP TABLE IF EXISTS view_supplies;`,
+	},
+	{
+		Version: 20210705120425,
+		Name:    "view_supply_history",
+		Up: `CREATE TABLE view_supply_history (
+    id BIGSERIAL,
+    denom VARCHAR NOT NULL,
+    block_height BIGINT NOT NULL,
+    block_time BIGINT NOT NULL,
+    total NUMERIC NOT NULL,
+    minted NUMERIC NOT NULL,
+    burned NUMERIC NOT NULL,
+    maybe_inflation VARCHAR NULL,
+    maybe_bonded_ratio VARCHAR NULL,
+    maybe_annual_provisions VARCHAR NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX view_supply_history_denom_block_height_btree_index ON view_supply_history USING btree(denom, block_height);`,
+		Down: `DROP TABLE IF EXISTS view_supply_history;`,
+	},
+	{
+		Version: 20210705120431,
+		Name:    "view_supply_history_total",
+		Up: `CREATE TABLE view_supply_history_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_supply_history_total;`,
+	},
+	{
+		Version: 20210705120447,
+		Name:    "view_supply_proposal_deposits",
+		Up: `CREATE TABLE view_supply_proposal_deposits (
+    proposal_id VARCHAR NOT NULL,
+    amount JSONB NOT NULL,
+    PRIMARY KEY (proposal_id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_supply_proposal_deposits;`,
+	},
+}
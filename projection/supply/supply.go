@@ -0,0 +1,351 @@
+package supply
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/supply/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ projection_entity.Projection = &Supply{}
+
+const PROPOSAL_RESULT_PASSED = "proposal_passed"
+
+// Supply projection keeps track of the total supply of each denom. It starts from the genesis
+// supply, adds the minted coins and deducts the burnt coins at each block. Since Cosmos SDK v0.42
+// does not emit any event when proposal deposits are burnt, the deposits of each proposal are
+// tracked and deducted when the proposal becomes inactive, or when the proposal ends without its
+// deposits being refunded, e.g. it is vetoed.
+type Supply struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+
+	govModuleAddress string
+}
+
+func NewSupply(logger applogger.Logger, rdbConn rdb.Conn, accountAddressPrefix string) *Supply {
+	return &Supply{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "Supply"),
+
+		rdbConn,
+		logger,
+
+		tmcosmosutils.NewModuleAccounts(accountAddressPrefix).Gov,
+	}
+}
+
+func (_ *Supply) GetEventsToListen() []string {
+	return []string{
+		event_usecase.GENESIS_CREATED,
+		event_usecase.BLOCK_CREATED,
+		event_usecase.MINTED,
+		event_usecase.BURNED,
+		event_usecase.ACCOUNT_TRANSFERRED,
+		event_usecase.MSG_SUBMIT_TEXT_PROPOSAL_CREATED,
+		event_usecase.MSG_SUBMIT_COMMUNITY_POOL_SPEND_PROPOSAL_CREATED,
+		event_usecase.MSG_SUBMIT_PARAM_CHANGE_PROPOSAL_CREATED,
+		event_usecase.MSG_SUBMIT_SOFTWARE_UPGRADE_PROPOSAL_CREATED,
+		event_usecase.MSG_SUBMIT_CANCEL_SOFTWARE_UPGRADE_PROPOSAL_CREATED,
+		event_usecase.MSG_DEPOSIT_CREATED,
+		event_usecase.PROPOSAL_INACTIVED,
+		event_usecase.PROPOSAL_ENDED,
+	}
+}
+
+func (_ *Supply) OnInit() error {
+	return nil
+}
+
+func (projection *Supply) HandleEvents(height int64, events []event_entity.Event) error {
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+
+	proposalDepositsView := view.NewProposalDeposits(rdbTxHandle)
+
+	var blockTime utctime.UTCTime
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTime = blockCreatedEvent.Block.Time
+		}
+	}
+	govDepositMovements := NewGovDepositMovements(projection.govModuleAddress, events)
+
+	changes := NewChanges()
+	for _, event := range events {
+		if genesisCreatedEvent, ok := event.(*event_usecase.GenesisCreated); ok {
+			genesisTime, parseErr := utctime.Parse(
+				time.RFC3339, genesisCreatedEvent.Genesis.GenesisTime,
+			)
+			if parseErr != nil {
+				return fmt.Errorf("error parsing genesis time: %v", parseErr)
+			}
+			blockTime = genesisTime
+
+			genesisSupply, supplyErr := SupplyFromGenesis(&genesisCreatedEvent.Genesis)
+			if supplyErr != nil {
+				return fmt.Errorf("error getting supply from genesis: %v", supplyErr)
+			}
+			changes.AddMinted(genesisSupply)
+
+		} else if mintedEvent, ok := event.(*event_usecase.Minted); ok {
+			changes.AddMinted(mintedEvent.Amount)
+			changes.SetMintInfo(mintedEvent.Inflation, mintedEvent.BondedRatio, mintedEvent.AnnualProvisions)
+
+		} else if burnedEvent, ok := event.(*event_usecase.Burned); ok {
+			changes.AddBurned(burnedEvent.Amount)
+
+		} else if msgSubmitProposal, ok := event.(*event_usecase.MsgSubmitTextProposal); ok {
+			if err := proposalDepositsView.Add(
+				*msgSubmitProposal.MaybeProposalId, msgSubmitProposal.InitialDeposit,
+			); err != nil {
+				return fmt.Errorf("error adding proposal initial deposit: %v", err)
+			}
+		} else if msgSubmitProposal, ok := event.(*event_usecase.MsgSubmitCommunityPoolSpendProposal); ok {
+			if err := proposalDepositsView.Add(
+				*msgSubmitProposal.MaybeProposalId, msgSubmitProposal.InitialDeposit,
+			); err != nil {
+				return fmt.Errorf("error adding proposal initial deposit: %v", err)
+			}
+		} else if msgSubmitProposal, ok := event.(*event_usecase.MsgSubmitParamChangeProposal); ok {
+			if err := proposalDepositsView.Add(
+				*msgSubmitProposal.MaybeProposalId, msgSubmitProposal.InitialDeposit,
+			); err != nil {
+				return fmt.Errorf("error adding proposal initial deposit: %v", err)
+			}
+		} else if msgSubmitProposal, ok := event.(*event_usecase.MsgSubmitSoftwareUpgradeProposal); ok {
+			if err := proposalDepositsView.Add(
+				*msgSubmitProposal.MaybeProposalId, msgSubmitProposal.InitialDeposit,
+			); err != nil {
+				return fmt.Errorf("error adding proposal initial deposit: %v", err)
+			}
+		} else if msgSubmitProposal, ok := event.(*event_usecase.MsgSubmitCancelSoftwareUpgradeProposal); ok {
+			if err := proposalDepositsView.Add(
+				*msgSubmitProposal.MaybeProposalId, msgSubmitProposal.InitialDeposit,
+			); err != nil {
+				return fmt.Errorf("error adding proposal initial deposit: %v", err)
+			}
+
+		} else if msgDeposit, ok := event.(*event_usecase.MsgDeposit); ok {
+			if err := proposalDepositsView.Add(msgDeposit.ProposalId, msgDeposit.Amount); err != nil {
+				return fmt.Errorf("error adding proposal deposit: %v", err)
+			}
+
+		} else if proposalInactived, ok := event.(*event_usecase.ProposalInactived); ok {
+			deposits, findErr := proposalDepositsView.FindBy(proposalInactived.ProposalId)
+			if findErr != nil && !errors.Is(findErr, rdb.ErrNoRows) {
+				return fmt.Errorf("error finding inactive proposal deposits: %v", findErr)
+			}
+			// Burnt deposit is already accounted for when the chain emits burn event
+			if findErr == nil && !govDepositMovements.ClaimBurn(deposits) {
+				changes.AddBurned(deposits)
+			}
+			if err := proposalDepositsView.Delete(proposalInactived.ProposalId); err != nil {
+				return fmt.Errorf("error deleting inactive proposal deposits: %v", err)
+			}
+
+		} else if proposalEnded, ok := event.(*event_usecase.ProposalEnded); ok {
+			deposits, findErr := proposalDepositsView.FindBy(proposalEnded.ProposalId)
+			if findErr != nil && !errors.Is(findErr, rdb.ErrNoRows) {
+				return fmt.Errorf("error finding ended proposal deposits: %v", findErr)
+			}
+			// Deposits are refunded to the depositors when the voting period ends, unless the
+			// proposal is vetoed or does not reach the quorum, in which case they are burnt
+			if findErr == nil && !govDepositMovements.ClaimBurn(deposits) {
+				isRefunded := govDepositMovements.ClaimRefund(deposits) ||
+					proposalEnded.Result == PROPOSAL_RESULT_PASSED
+				if !isRefunded {
+					changes.AddBurned(deposits)
+				}
+			}
+			if err := proposalDepositsView.Delete(proposalEnded.ProposalId); err != nil {
+				return fmt.Errorf("error deleting ended proposal deposits: %v", err)
+			}
+		}
+	}
+
+	if err := projection.applyChanges(rdbTxHandle, height, blockTime, changes); err != nil {
+		return err
+	}
+
+	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err := rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+func (projection *Supply) applyChanges(
+	rdbTxHandle *rdb.Handle,
+	height int64,
+	blockTime utctime.UTCTime,
+	changes *Changes,
+) error {
+	suppliesView := view.NewSupplies(rdbTxHandle)
+	supplyHistoryView := view.NewSupplyHistory(rdbTxHandle)
+
+	for _, denom := range changes.Denoms() {
+		var prevTotal coin.Int
+		var prevInflation, prevBondedRatio, prevAnnualProvisions *string
+		supply, err := suppliesView.FindBy(denom)
+		if err != nil {
+			if !errors.Is(err, rdb.ErrNoRows) {
+				return fmt.Errorf("error finding supply of %s: %v", denom, err)
+			}
+			prevTotal = coin.ZeroInt()
+		} else {
+			prevTotal = supply.Total
+			prevInflation = supply.MaybeInflation
+			prevBondedRatio = supply.MaybeBondedRatio
+			prevAnnualProvisions = supply.MaybeAnnualProvisions
+		}
+
+		minted := changes.MintedOf(denom)
+		burned := changes.BurnedOf(denom)
+		total := prevTotal.Add(minted).Sub(burned)
+		if total.IsNegative() {
+			projection.logger.Errorf("negative supply of %s at height %d, resetting to zero", denom, height)
+			total = coin.ZeroInt()
+		}
+
+		maybeInflation, maybeBondedRatio, maybeAnnualProvisions := prevInflation, prevBondedRatio, prevAnnualProvisions
+		var historyInflation, historyBondedRatio, historyAnnualProvisions *string
+		if changes.HasMinted(denom) && changes.MaybeInflation != nil {
+			maybeInflation = changes.MaybeInflation
+			maybeBondedRatio = changes.MaybeBondedRatio
+			maybeAnnualProvisions = changes.MaybeAnnualProvisions
+
+			historyInflation = maybeInflation
+			historyBondedRatio = maybeBondedRatio
+			historyAnnualProvisions = maybeAnnualProvisions
+		}
+
+		if err := suppliesView.Upsert(&view.SupplyRow{
+			Denom:                  denom,
+			Total:                  total,
+			MaybeInflation:         maybeInflation,
+			MaybeBondedRatio:       maybeBondedRatio,
+			MaybeAnnualProvisions:  maybeAnnualProvisions,
+			LastUpdatedBlockHeight: height,
+			LastUpdatedBlockTime:   blockTime,
+		}); err != nil {
+			return fmt.Errorf("error updating supply of %s: %v", denom, err)
+		}
+
+		if err := supplyHistoryView.Insert(&view.SupplyHistoryRow{
+			Denom:                 denom,
+			BlockHeight:           height,
+			BlockTime:             blockTime,
+			Total:                 total,
+			Minted:                minted,
+			Burned:                burned,
+			MaybeInflation:        historyInflation,
+			MaybeBondedRatio:      historyBondedRatio,
+			MaybeAnnualProvisions: historyAnnualProvisions,
+		}); err != nil {
+			return fmt.Errorf("error inserting supply history of %s: %v", denom, err)
+		}
+	}
+
+	return nil
+}
+
+// Changes accumulates the minted and burnt amount of each denom within a block
+type Changes struct {
+	minted map[string]coin.Int
+	burned map[string]coin.Int
+
+	MaybeInflation        *string
+	MaybeBondedRatio      *string
+	MaybeAnnualProvisions *string
+}
+
+func NewChanges() *Changes {
+	return &Changes{
+		minted: make(map[string]coin.Int),
+		burned: make(map[string]coin.Int),
+	}
+}
+
+func (changes *Changes) AddMinted(amount coin.Coins) {
+	for _, unit := range amount {
+		changes.minted[unit.Denom] = changes.MintedOf(unit.Denom).Add(unit.Amount)
+	}
+}
+
+func (changes *Changes) AddBurned(amount coin.Coins) {
+	for _, unit := range amount {
+		changes.burned[unit.Denom] = changes.BurnedOf(unit.Denom).Add(unit.Amount)
+	}
+}
+
+func (changes *Changes) SetMintInfo(inflation string, bondedRatio string, annualProvisions string) {
+	changes.MaybeInflation = &inflation
+	changes.MaybeBondedRatio = &bondedRatio
+	changes.MaybeAnnualProvisions = &annualProvisions
+}
+
+func (changes *Changes) HasMinted(denom string) bool {
+	_, ok := changes.minted[denom]
+	return ok
+}
+
+func (changes *Changes) MintedOf(denom string) coin.Int {
+	if amount, ok := changes.minted[denom]; ok {
+		return amount
+	}
+	return coin.ZeroInt()
+}
+
+func (changes *Changes) BurnedOf(denom string) coin.Int {
+	if amount, ok := changes.burned[denom]; ok {
+		return amount
+	}
+	return coin.ZeroInt()
+}
+
+// Denoms returns the sorted list of denoms with supply changes
+func (changes *Changes) Denoms() []string {
+	denomSet := make(map[string]bool)
+	for denom := range changes.minted {
+		denomSet[denom] = true
+	}
+	for denom := range changes.burned {
+		denomSet[denom] = true
+	}
+
+	denoms := make([]string, 0, len(denomSet))
+	for denom := range denomSet {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	return denoms
+}
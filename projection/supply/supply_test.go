@@ -0,0 +1,233 @@
+package supply_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/pg"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/tmcosmosutils"
+	"github.com/crypto-com/chain-indexing/projection/supply"
+	"github.com/crypto-com/chain-indexing/projection/supply/view"
+	. "github.com/crypto-com/chain-indexing/test"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ = Describe("Supply", func() {
+	govModuleAddress := tmcosmosutils.NewModuleAccounts("tcro").Gov
+	anyAccountAddress := "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn"
+
+	It("should implement projection", func() {
+		fakeLogger := NewFakeLogger()
+		fakeRdbConn := NewFakeRDbConn()
+		var _ entity_projection.Projection = supply.NewSupply(fakeLogger, fakeRdbConn, "tcro")
+	})
+
+	Describe("SupplyFromGenesis", func() {
+		It("should return bank supply when it is provided", func() {
+			var anyGenesis genesis.Genesis
+			anyGenesis.AppState.Bank.Supply = []interface{}{
+				map[string]interface{}{
+					"denom":  "basetcro",
+					"amount": "1000",
+				},
+			}
+			anyGenesis.AppState.Bank.Balances = []genesis.Balance{{
+				Address: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				Coins: []genesis.MinDeposit{{
+					Denom:  "basetcro",
+					Amount: "1",
+				}},
+			}}
+
+			actual, err := supply.SupplyFromGenesis(&anyGenesis)
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(coin.NewCoins(coin.NewInt64Coin("basetcro", 1000))))
+		})
+
+		It("should sum up genesis balances when bank supply is empty", func() {
+			var anyGenesis genesis.Genesis
+			anyGenesis.AppState.Bank.Balances = []genesis.Balance{{
+				Address: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				Coins: []genesis.MinDeposit{{
+					Denom:  "basetcro",
+					Amount: "100",
+				}},
+			}, {
+				Address: "tcro1782gn9hzqavecukdaqqclvsnpck4mtz3vwzpxl",
+				Coins: []genesis.MinDeposit{{
+					Denom:  "basetcro",
+					Amount: "200",
+				}, {
+					Denom:  "ibc/0000",
+					Amount: "5",
+				}},
+			}}
+
+			actual, err := supply.SupplyFromGenesis(&anyGenesis)
+			Expect(err).To(BeNil())
+			Expect(actual.AmountOf("basetcro")).To(Equal(coin.NewInt(300)))
+			Expect(actual.AmountOf("ibc/0000")).To(Equal(coin.NewInt(5)))
+		})
+	})
+
+	Describe("Changes", func() {
+		It("should accumulate minted and burned amount by denom", func() {
+			changes := supply.NewChanges()
+			changes.AddMinted(coin.NewCoins(coin.NewInt64Coin("basetcro", 100)))
+			changes.AddMinted(coin.NewCoins(coin.NewInt64Coin("basetcro", 50)))
+			changes.AddBurned(coin.NewCoins(coin.NewInt64Coin("basetcro", 30), coin.NewInt64Coin("atom", 1)))
+
+			Expect(changes.Denoms()).To(Equal([]string{"atom", "basetcro"}))
+			Expect(changes.MintedOf("basetcro")).To(Equal(coin.NewInt(150)))
+			Expect(changes.BurnedOf("basetcro")).To(Equal(coin.NewInt(30)))
+			Expect(changes.MintedOf("atom")).To(Equal(coin.ZeroInt()))
+			Expect(changes.HasMinted("basetcro")).To(BeTrue())
+			Expect(changes.HasMinted("atom")).To(BeFalse())
+		})
+	})
+
+	Describe("GovDepositMovements", func() {
+		It("should claim each gov burn once by the deposit amount", func() {
+			movements := supply.NewGovDepositMovements(govModuleAddress, []event_entity.Event{
+				event_usecase.NewBurned(1, model.BurnParams{
+					Burner: govModuleAddress,
+					Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 100)),
+				}),
+				event_usecase.NewBurned(1, model.BurnParams{
+					Burner: anyAccountAddress,
+					Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 50)),
+				}),
+			})
+
+			Expect(movements.ClaimBurn(coin.NewCoins(coin.NewInt64Coin("basetcro", 50)))).To(BeFalse())
+			Expect(movements.ClaimBurn(coin.NewCoins(coin.NewInt64Coin("basetcro", 100)))).To(BeTrue())
+			Expect(movements.ClaimBurn(coin.NewCoins(coin.NewInt64Coin("basetcro", 100)))).To(BeFalse())
+		})
+
+		It("should claim refunds until the gov module transfers are used up", func() {
+			movements := supply.NewGovDepositMovements(govModuleAddress, []event_entity.Event{
+				event_usecase.NewAccountTransferred(1, model.AccountTransferParams{
+					Sender:    govModuleAddress,
+					Recipient: anyAccountAddress,
+					Amount:    coin.NewCoins(coin.NewInt64Coin("basetcro", 60)),
+				}),
+				event_usecase.NewAccountTransferred(1, model.AccountTransferParams{
+					Sender:    govModuleAddress,
+					Recipient: anyAccountAddress,
+					Amount:    coin.NewCoins(coin.NewInt64Coin("basetcro", 40)),
+				}),
+				event_usecase.NewAccountTransferred(1, model.AccountTransferParams{
+					Sender:    anyAccountAddress,
+					Recipient: govModuleAddress,
+					Amount:    coin.NewCoins(coin.NewInt64Coin("basetcro", 1000)),
+				}),
+			})
+
+			Expect(movements.ClaimRefund(coin.NewCoins(coin.NewInt64Coin("basetcro", 100)))).To(BeTrue())
+			Expect(movements.ClaimRefund(coin.NewCoins(coin.NewInt64Coin("basetcro", 1)))).To(BeFalse())
+		})
+	})
+
+	WithTestPgxConn(func(pgConn *pg.PgxConn, pgMigrate *pg.Migrate) {
+		BeforeEach(func() {
+			_ = pgMigrate.Reset()
+			pgMigrate.MustUp()
+		})
+
+		AfterEach(func() {
+			_ = pgMigrate.Reset()
+		})
+
+		mustHandleEvents := func(projection *supply.Supply, height int64, events []event_entity.Event) {
+			Expect(projection.HandleEvents(height, events)).To(Succeed())
+		}
+		mintedEvent := func(height int64, amount int64) event_entity.Event {
+			return event_usecase.NewMinted(height, model.MintParams{
+				BondedRatio:      "0.5",
+				Inflation:        "0.01",
+				AnnualProvisions: "1000",
+				Amount:           coin.NewCoins(coin.NewInt64Coin("basetcro", amount)),
+			})
+		}
+		totalSupply := func() coin.Int {
+			row, err := view.NewSupplies(pgConn.ToHandle()).FindBy("basetcro")
+			Expect(err).To(BeNil())
+			return row.Total
+		}
+		addDeposits := func(proposalId string, amount int64) {
+			Expect(view.NewProposalDeposits(pgConn.ToHandle()).Add(
+				proposalId, coin.NewCoins(coin.NewInt64Coin("basetcro", amount)),
+			)).To(Succeed())
+		}
+
+		It("should deduct the deposits of each inactive proposal without a matching burn", func() {
+			projection := supply.NewSupply(NewFakeLogger(), pgConn, "tcro")
+			mustHandleEvents(projection, 1, []event_entity.Event{mintedEvent(1, 1000)})
+			addDeposits("1", 100)
+			addDeposits("2", 50)
+
+			mustHandleEvents(projection, 2, []event_entity.Event{
+				event_usecase.NewBurned(2, model.BurnParams{
+					Burner: govModuleAddress,
+					Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 100)),
+				}),
+				event_usecase.NewProposalInactived(2, "1", "proposal_dropped"),
+				event_usecase.NewProposalInactived(2, "2", "proposal_dropped"),
+			})
+
+			Expect(totalSupply()).To(Equal(coin.NewInt(850)))
+		})
+
+		It("should deduct the deposits of ended proposal when they are not refunded", func() {
+			projection := supply.NewSupply(NewFakeLogger(), pgConn, "tcro")
+			mustHandleEvents(projection, 1, []event_entity.Event{mintedEvent(1, 1000)})
+			addDeposits("1", 100)
+
+			mustHandleEvents(projection, 2, []event_entity.Event{
+				event_usecase.NewProposalEnded(2, "1", "proposal_rejected"),
+			})
+
+			Expect(totalSupply()).To(Equal(coin.NewInt(900)))
+		})
+
+		It("should keep the deposits of ended proposal when they are refunded", func() {
+			projection := supply.NewSupply(NewFakeLogger(), pgConn, "tcro")
+			mustHandleEvents(projection, 1, []event_entity.Event{mintedEvent(1, 1000)})
+			addDeposits("1", 100)
+
+			mustHandleEvents(projection, 2, []event_entity.Event{
+				event_usecase.NewAccountTransferred(2, model.AccountTransferParams{
+					Sender:    govModuleAddress,
+					Recipient: anyAccountAddress,
+					Amount:    coin.NewCoins(coin.NewInt64Coin("basetcro", 100)),
+				}),
+				event_usecase.NewProposalEnded(2, "1", "proposal_rejected"),
+			})
+
+			Expect(totalSupply()).To(Equal(coin.NewInt(1000)))
+		})
+
+		It("should deduct the deposits of ended proposal only once when the chain emits the burn", func() {
+			projection := supply.NewSupply(NewFakeLogger(), pgConn, "tcro")
+			mustHandleEvents(projection, 1, []event_entity.Event{mintedEvent(1, 1000)})
+			addDeposits("1", 100)
+
+			mustHandleEvents(projection, 2, []event_entity.Event{
+				event_usecase.NewBurned(2, model.BurnParams{
+					Burner: govModuleAddress,
+					Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 100)),
+				}),
+				event_usecase.NewProposalEnded(2, "1", "proposal_rejected"),
+			})
+
+			Expect(totalSupply()).To(Equal(coin.NewInt(900)))
+		})
+	})
+})
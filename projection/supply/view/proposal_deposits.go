@@ -0,0 +1,102 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const PROPOSAL_DEPOSITS_TABLE_NAME = "view_supply_proposal_deposits"
+
+// ProposalDeposits keeps track of the deposits held by the gov module for each proposal so that
+// the amount burnt on proposal inactive can be deducted from the supply
+type ProposalDeposits struct {
+	rdb *rdb.Handle
+}
+
+func NewProposalDeposits(handle *rdb.Handle) *ProposalDeposits {
+	return &ProposalDeposits{
+		handle,
+	}
+}
+
+func (proposalDepositsView *ProposalDeposits) Add(proposalId string, amount coin.Coins) error {
+	existing, err := proposalDepositsView.FindBy(proposalId)
+	if err != nil {
+		if !errors.Is(err, rdb.ErrNoRows) {
+			return err
+		}
+		existing = coin.NewEmptyCoins()
+	}
+
+	sql, sqlArgs, err := proposalDepositsView.rdb.StmtBuilder.Insert(
+		PROPOSAL_DEPOSITS_TABLE_NAME,
+	).Columns(
+		"proposal_id",
+		"amount",
+	).Values(
+		proposalId,
+		json.MustMarshalToString(existing.Add(amount...)),
+	).Suffix(
+		"ON CONFLICT(proposal_id) DO UPDATE SET amount = EXCLUDED.amount",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building proposal deposit upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := proposalDepositsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting proposal deposit into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting proposal deposit into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (proposalDepositsView *ProposalDeposits) FindBy(proposalId string) (coin.Coins, error) {
+	sql, sqlArgs, err := proposalDepositsView.rdb.StmtBuilder.Select(
+		"amount",
+	).From(
+		PROPOSAL_DEPOSITS_TABLE_NAME,
+	).Where(
+		"proposal_id = ?", proposalId,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building proposal deposit selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	var amountJSON string
+	if err = proposalDepositsView.rdb.QueryRow(sql, sqlArgs...).Scan(&amountJSON); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning proposal deposit row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	var amount coin.Coins
+	json.MustUnmarshalFromString(amountJSON, &amount)
+
+	return amount, nil
+}
+
+func (proposalDepositsView *ProposalDeposits) Delete(proposalId string) error {
+	sql, sqlArgs, err := proposalDepositsView.rdb.StmtBuilder.Delete(
+		PROPOSAL_DEPOSITS_TABLE_NAME,
+	).Where(
+		"proposal_id = ?", proposalId,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building proposal deposit deletion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	if _, err = proposalDepositsView.rdb.Exec(sql, sqlArgs...); err != nil {
+		return fmt.Errorf("error deleting proposal deposit from the table: %v: %w", err, rdb.ErrWrite)
+	}
+
+	return nil
+}
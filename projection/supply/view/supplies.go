@@ -0,0 +1,192 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const SUPPLIES_TABLE_NAME = "view_supplies"
+
+type Supplies struct {
+	rdb *rdb.Handle
+}
+
+func NewSupplies(handle *rdb.Handle) *Supplies {
+	return &Supplies{
+		handle,
+	}
+}
+
+func (suppliesView *Supplies) Upsert(row *SupplyRow) error {
+	sql, sqlArgs, err := suppliesView.rdb.StmtBuilder.Insert(
+		SUPPLIES_TABLE_NAME,
+	).Columns(
+		"denom",
+		"total",
+		"maybe_inflation",
+		"maybe_bonded_ratio",
+		"maybe_annual_provisions",
+		"last_updated_block_height",
+		"last_updated_block_time",
+	).Values(
+		row.Denom,
+		suppliesView.rdb.Bton(row.Total.BigInt()),
+		row.MaybeInflation,
+		row.MaybeBondedRatio,
+		row.MaybeAnnualProvisions,
+		row.LastUpdatedBlockHeight,
+		suppliesView.rdb.Tton(&row.LastUpdatedBlockTime),
+	).Suffix(
+		"ON CONFLICT(denom) DO UPDATE SET " +
+			"total = EXCLUDED.total, " +
+			"maybe_inflation = EXCLUDED.maybe_inflation, " +
+			"maybe_bonded_ratio = EXCLUDED.maybe_bonded_ratio, " +
+			"maybe_annual_provisions = EXCLUDED.maybe_annual_provisions, " +
+			"last_updated_block_height = EXCLUDED.last_updated_block_height, " +
+			"last_updated_block_time = EXCLUDED.last_updated_block_time",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building supply upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := suppliesView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting supply into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting supply into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (suppliesView *Supplies) FindBy(denom string) (*SupplyRow, error) {
+	sql, sqlArgs, err := suppliesView.rdb.StmtBuilder.Select(
+		"denom",
+		"total",
+		"maybe_inflation",
+		"maybe_bonded_ratio",
+		"maybe_annual_provisions",
+		"last_updated_block_height",
+		"last_updated_block_time",
+	).From(
+		SUPPLIES_TABLE_NAME,
+	).Where(
+		"denom = ?", denom,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building supply selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	var row SupplyRow
+	totalReader := suppliesView.rdb.NtobReader()
+	lastUpdatedBlockTimeReader := suppliesView.rdb.NtotReader()
+	if err = suppliesView.rdb.QueryRow(sql, sqlArgs...).Scan(
+		&row.Denom,
+		totalReader.ScannableArg(),
+		&row.MaybeInflation,
+		&row.MaybeBondedRatio,
+		&row.MaybeAnnualProvisions,
+		&row.LastUpdatedBlockHeight,
+		lastUpdatedBlockTimeReader.ScannableArg(),
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning supply row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	if parseErr := parseSupplyRowReaders(&row, totalReader, lastUpdatedBlockTimeReader); parseErr != nil {
+		return nil, parseErr
+	}
+
+	return &row, nil
+}
+
+func (suppliesView *Supplies) List() ([]SupplyRow, error) {
+	sql, sqlArgs, err := suppliesView.rdb.StmtBuilder.Select(
+		"denom",
+		"total",
+		"maybe_inflation",
+		"maybe_bonded_ratio",
+		"maybe_annual_provisions",
+		"last_updated_block_height",
+		"last_updated_block_time",
+	).From(
+		SUPPLIES_TABLE_NAME,
+	).OrderBy(
+		"denom",
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building supplies selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	rowsResult, err := suppliesView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing supplies selection sql: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]SupplyRow, 0)
+	for rowsResult.Next() {
+		var row SupplyRow
+		totalReader := suppliesView.rdb.NtobReader()
+		lastUpdatedBlockTimeReader := suppliesView.rdb.NtotReader()
+		if scanErr := rowsResult.Scan(
+			&row.Denom,
+			totalReader.ScannableArg(),
+			&row.MaybeInflation,
+			&row.MaybeBondedRatio,
+			&row.MaybeAnnualProvisions,
+			&row.LastUpdatedBlockHeight,
+			lastUpdatedBlockTimeReader.ScannableArg(),
+		); scanErr != nil {
+			if errors.Is(scanErr, rdb.ErrNoRows) {
+				return nil, rdb.ErrNoRows
+			}
+			return nil, fmt.Errorf("error scanning supply row: %v: %w", scanErr, rdb.ErrQuery)
+		}
+
+		if parseErr := parseSupplyRowReaders(&row, totalReader, lastUpdatedBlockTimeReader); parseErr != nil {
+			return nil, parseErr
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseSupplyRowReaders(
+	row *SupplyRow,
+	totalReader rdb.NtobReader,
+	lastUpdatedBlockTimeReader rdb.NtotReader,
+) error {
+	total, parseTotalErr := totalReader.Parse()
+	if parseTotalErr != nil {
+		return fmt.Errorf("error parsing supply total: %v: %w", parseTotalErr, rdb.ErrQuery)
+	}
+	row.Total = coin.NewIntFromBigInt(total)
+
+	lastUpdatedBlockTime, parseTimeErr := lastUpdatedBlockTimeReader.Parse()
+	if parseTimeErr != nil {
+		return fmt.Errorf("error parsing supply last updated block time: %v: %w", parseTimeErr, rdb.ErrQuery)
+	}
+	row.LastUpdatedBlockTime = *lastUpdatedBlockTime
+
+	return nil
+}
+
+type SupplyRow struct {
+	Denom                  string          `json:"denom"`
+	Total                  coin.Int        `json:"total"`
+	MaybeInflation         *string         `json:"inflation"`
+	MaybeBondedRatio       *string         `json:"bondedRatio"`
+	MaybeAnnualProvisions  *string         `json:"annualProvisions"`
+	LastUpdatedBlockHeight int64           `json:"lastUpdatedBlockHeight"`
+	LastUpdatedBlockTime   utctime.UTCTime `json:"lastUpdatedBlockTime"`
+}
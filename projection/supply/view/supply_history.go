@@ -0,0 +1,197 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	pagination_interface "github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const SUPPLY_HISTORY_TABLE_NAME = "view_supply_history"
+
+type SupplyHistory struct {
+	rdb *rdb.Handle
+}
+
+func NewSupplyHistory(handle *rdb.Handle) *SupplyHistory {
+	return &SupplyHistory{
+		handle,
+	}
+}
+
+func (supplyHistoryView *SupplyHistory) Insert(row *SupplyHistoryRow) error {
+	sql, sqlArgs, err := supplyHistoryView.rdb.StmtBuilder.Insert(
+		SUPPLY_HISTORY_TABLE_NAME,
+	).Columns(
+		"denom",
+		"block_height",
+		"block_time",
+		"total",
+		"minted",
+		"burned",
+		"maybe_inflation",
+		"maybe_bonded_ratio",
+		"maybe_annual_provisions",
+	).Values(
+		row.Denom,
+		row.BlockHeight,
+		supplyHistoryView.rdb.Tton(&row.BlockTime),
+		supplyHistoryView.rdb.Bton(row.Total.BigInt()),
+		supplyHistoryView.rdb.Bton(row.Minted.BigInt()),
+		supplyHistoryView.rdb.Bton(row.Burned.BigInt()),
+		row.MaybeInflation,
+		row.MaybeBondedRatio,
+		row.MaybeAnnualProvisions,
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building supply history insertion sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := supplyHistoryView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error inserting supply history into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error inserting supply history into the table: no rows inserted: %w", rdb.ErrWrite)
+	}
+
+	totalView := NewSupplyHistoryTotal(supplyHistoryView.rdb)
+	if err := totalView.Increment(row.Denom, 1); err != nil {
+		return fmt.Errorf("error incrementing supply history total: %w", err)
+	}
+
+	return nil
+}
+
+func (supplyHistoryView *SupplyHistory) ListByDenom(
+	denom string,
+	order SupplyHistoryListOrder,
+	pagination *pagination_interface.Pagination,
+) ([]SupplyHistoryRow, *pagination_interface.PaginationResult, error) {
+	stmtBuilder := supplyHistoryView.rdb.StmtBuilder.Select(
+		"denom",
+		"block_height",
+		"block_time",
+		"total",
+		"minted",
+		"burned",
+		"maybe_inflation",
+		"maybe_bonded_ratio",
+		"maybe_annual_provisions",
+	).From(
+		SUPPLY_HISTORY_TABLE_NAME,
+	).Where(
+		"denom = ?", denom,
+	)
+
+	if order.Height == view.ORDER_DESC {
+		stmtBuilder = stmtBuilder.OrderBy("block_height DESC")
+	} else {
+		stmtBuilder = stmtBuilder.OrderBy("block_height")
+	}
+
+	rDbPagination := rdb.NewRDbPaginationBuilder(
+		pagination,
+		supplyHistoryView.rdb,
+	).WithCustomTotalQueryFn(
+		func(rdbHandle *rdb.Handle, _ sq.SelectBuilder) (int64, error) {
+			totalView := NewSupplyHistoryTotal(rdbHandle)
+			total, err := totalView.FindBy(denom)
+			if err != nil {
+				return int64(0), err
+			}
+			return total, nil
+		},
+	).BuildStmt(stmtBuilder)
+	sql, sqlArgs, err := rDbPagination.ToStmtBuilder().ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error building supply history select SQL: %v, %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	rowsResult, err := supplyHistoryView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing supply history select SQL: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]SupplyHistoryRow, 0)
+	for rowsResult.Next() {
+		var row SupplyHistoryRow
+		blockTimeReader := supplyHistoryView.rdb.NtotReader()
+		totalReader := supplyHistoryView.rdb.NtobReader()
+		mintedReader := supplyHistoryView.rdb.NtobReader()
+		burnedReader := supplyHistoryView.rdb.NtobReader()
+
+		if err = rowsResult.Scan(
+			&row.Denom,
+			&row.BlockHeight,
+			blockTimeReader.ScannableArg(),
+			totalReader.ScannableArg(),
+			mintedReader.ScannableArg(),
+			burnedReader.ScannableArg(),
+			&row.MaybeInflation,
+			&row.MaybeBondedRatio,
+			&row.MaybeAnnualProvisions,
+		); err != nil {
+			if errors.Is(err, rdb.ErrNoRows) {
+				return nil, nil, rdb.ErrNoRows
+			}
+			return nil, nil, fmt.Errorf("error scanning supply history row: %v: %w", err, rdb.ErrQuery)
+		}
+
+		blockTime, parseErr := blockTimeReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf("error parsing supply history block time: %v: %w", parseErr, rdb.ErrQuery)
+		}
+		row.BlockTime = *blockTime
+
+		total, parseErr := totalReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf("error parsing supply history total: %v: %w", parseErr, rdb.ErrQuery)
+		}
+		row.Total = coin.NewIntFromBigInt(total)
+
+		minted, parseErr := mintedReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf("error parsing supply history minted: %v: %w", parseErr, rdb.ErrQuery)
+		}
+		row.Minted = coin.NewIntFromBigInt(minted)
+
+		burned, parseErr := burnedReader.Parse()
+		if parseErr != nil {
+			return nil, nil, fmt.Errorf("error parsing supply history burned: %v: %w", parseErr, rdb.ErrQuery)
+		}
+		row.Burned = coin.NewIntFromBigInt(burned)
+
+		rows = append(rows, row)
+	}
+
+	paginationResult, err := rDbPagination.Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error preparing pagination result: %v", err)
+	}
+
+	return rows, paginationResult, nil
+}
+
+type SupplyHistoryRow struct {
+	Denom                 string          `json:"denom"`
+	BlockHeight           int64           `json:"blockHeight"`
+	BlockTime             utctime.UTCTime `json:"blockTime"`
+	Total                 coin.Int        `json:"total"`
+	Minted                coin.Int        `json:"minted"`
+	Burned                coin.Int        `json:"burned"`
+	MaybeInflation        *string         `json:"inflation"`
+	MaybeBondedRatio      *string         `json:"bondedRatio"`
+	MaybeAnnualProvisions *string         `json:"annualProvisions"`
+}
+
+type SupplyHistoryListOrder struct {
+	Height view.ORDER
+}
@@ -0,0 +1,18 @@
+package view
+
+import (
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+)
+
+const SUPPLY_HISTORY_TOTAL_TABLE_NAME = "view_supply_history_total"
+
+type SupplyHistoryTotal struct {
+	*view.Total
+}
+
+func NewSupplyHistoryTotal(rdbHandle *rdb.Handle) *SupplyHistoryTotal {
+	return &SupplyHistoryTotal{
+		view.NewTotal(rdbHandle, SUPPLY_HISTORY_TOTAL_TABLE_NAME),
+	}
+}
@@ -0,0 +1,79 @@
+package transaction
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201122201940,
+		Name:    "view_transactions",
+		Up: `CREATE TABLE view_transactions (
+    id BIGSERIAL,
+    block_height BIGINT,
+    block_hash VARCHAR NOT NULL,
+    block_time BIGINT NOT NULL,
+    hash VARCHAR NOT NULL,
+    index INT NOT NULL,
+    success BOOLEAN NOT NULL,
+    code INT NOT NULL,
+    log VARCHAR NOT NULL,
+    fee JSONB NOT NULL,
+    fee_payer VARCHAR NOT NULL,
+    fee_granter VARCHAR NOT NULL,
+    gas_wanted BIGINT NOT NULL,
+    gas_used BIGINT NOT NULL,
+    memo VARCHAR NOT NULL,
+    timeout_height BIGINT NOT NULL,
+    messages JSONB NOT NULL,
+    PRIMARY KEY(id)
+);`,
+		Down: `DROP TABLE IF EXISTS view_transactions;`,
+	},
+	{
+		Version: 20201128074938,
+		Name:    "view_transactions_block_height_brin_index",
+		Up:      `CREATE INDEX view_transactions_block_height_brin_index ON view_transactions USING brin (block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_transactions_block_height_brin_index;`,
+	},
+	{
+		Version: 20201128075100,
+		Name:    "view_transactions_hash_btree_index",
+		Up:      `CREATE INDEX view_transactions_block_hash_btree_index ON view_transactions(hash);`,
+		Down:    `DROP INDEX IF EXISTS view_transactions_block_hash_btree_index;`,
+	},
+	{
+		Version: 20201129164141,
+		Name:    "view_transactions_block_height_btree_index",
+		Up:      `CREATE INDEX view_transactions_block_height_btree_index ON view_transactions USING btree (block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_transactions_block_height_btree_index;`,
+	},
+	{
+		Version: 20201129164236,
+		Name:    "view_transactions_block_height_id_btree_index",
+		Up:      `CREATE INDEX view_transactions_block_height_id_btree_index ON view_transactions USING btree (block_height, id);`,
+		Down:    `DROP INDEX IF EXISTS view_transactions_block_height_id_btree_index;`,
+	},
+	{
+		Version: 20201129203915,
+		Name:    "view_transactions_total",
+		Up: `CREATE TABLE view_transactions_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_transactions_total;`,
+	},
+	{
+		Version: 20210120021440,
+		Name:    "view_transactions_block_height_desc_id_btree_index",
+		Up:      `CREATE INDEX view_transactions_block_height_desc_id_btree_index ON view_transactions USING btree (block_height DESC, id);`,
+		Down:    `DROP INDEX IF EXISTS view_transactions_block_height_desc_id_btree_index;`,
+	},
+	{
+		Version: 20210710031212,
+		Name:    "view_transactions_memo_gin_trgm_index",
+		Up:      `CREATE INDEX view_transactions_memo_gin_trgm_index ON view_transactions USING gin (memo gin_trgm_ops) WHERE memo <> '';`,
+		Down:    `DROP INDEX IF EXISTS view_transactions_memo_gin_trgm_index;`,
+	},
+}
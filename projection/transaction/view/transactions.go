@@ -43,7 +43,8 @@ func (transactionsView *BlockTransactions) InsertAll(transactions []TransactionR
 				"block_hash",
 				"block_time",
 				"hash",
-				"index",
+				// Quoted because INDEX is a reserved keyword in SQLite
+				`"index"`,
 				"success",
 				"code",
 				"log",
@@ -124,7 +125,7 @@ func (transactionsView *BlockTransactions) Insert(transaction *TransactionRow) e
 		"block_hash",
 		"block_time",
 		"hash",
-		"index",
+		`"index"`,
 		"success",
 		"code",
 		"log",
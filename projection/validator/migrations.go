@@ -0,0 +1,189 @@
+package validator
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201126204644,
+		Name:    "view_validators",
+		Up: `CREATE TABLE view_validators (
+    id BIGSERIAL,
+    operator_address VARCHAR NOT NULL,
+    consensus_node_address VARCHAR,
+    initial_delegator_address VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    jailed BOOL NOT NULL,
+    joined_at_block_height BIGINT NOT NULL,
+    power VARCHAR NOT NULL,
+    unbonding_height BIGINT NULL,
+    unbonding_completion_time BIGINT NULL,
+    moniker VARCHAR NOT NULL,
+    identity VARCHAR NULL,
+    website VARCHAR NULL,
+    security_contact VARCHAR NULL,
+    details VARCHAR NULL,
+    commission_rate VARCHAR NOT NULL,
+    commission_max_rate VARCHAR NOT NULL,
+    commission_max_change_rate VARCHAR NOT NULL,
+    min_self_delegation VARCHAR NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE (operator_address, consensus_node_address)
+);`,
+		Down: `DROP TABLE IF EXISTS view_validators;`,
+	},
+	{
+		Version: 20201127114628,
+		Name:    "view_validator_activities",
+		Up: `CREATE TABLE view_validator_activities (
+    id BIGSERIAL,
+    block_height BIGINT NOT NULL,
+    block_hash VARCHAR NOT NULL,
+    block_time BIGINT NOT NULL,
+    transaction_hash VARCHAR NULL,
+    operator_address VARCHAR NOT NULL,
+    success BOOLEAN NOT NULL,
+    data JSONB NOT NULL,
+    PRIMARY KEY (id)
+);`,
+		Down: `DROP TABLE IF EXISTS view_validator_activities;`,
+	},
+	{
+		Version: 20201128021411,
+		Name:    "view_validator_activites_total",
+		Up: `CREATE TABLE view_validator_activities_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_validator_activities_total;`,
+	},
+	{
+		Version: 20201128075608,
+		Name:    "view_validators_address_btree_index",
+		Up: `CREATE INDEX view_validators_operator_address_btree_index ON view_validators USING btree (operator_address);
+CREATE INDEX view_validators_consensus_node_address_btree_index ON view_validators USING btree (consensus_node_address);`,
+		Down: `DROP INDEX IF EXISTS view_validators_operator_address_btree_index;
+DROP INDEX IF EXISTS view_validators_consensus_node_address_btree_index;`,
+	},
+	{
+		Version: 20201128075815,
+		Name:    "view_validator_activities_block_height_brin_index",
+		Up:      `CREATE INDEX view_validator_activities_block_height_brin_index ON view_validator_activities USING brin (block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_validator_activities_block_height_brin_index;`,
+	},
+	{
+		Version: 20201128080022,
+		Name:    "view_validator_activities_operator_address_btree_index",
+		Up:      `CREATE INDEX view_validator_activities_operator_address_btree_index ON view_validator_activities USING btree (operator_address);`,
+		Down:    `DROP INDEX IF EXISTS view_validator_activities_operator_address_btree_index;`,
+	},
+	{
+		Version: 20201129190041,
+		Name:    "view_validator_activities_block_height_btree_index",
+		Up:      `CREATE INDEX view_validator_activities_block_height_btree_index ON view_validator_activities USING btree (block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_validator_activities_block_height_btree_index;`,
+	},
+	{
+		Version: 20201129190050,
+		Name:    "view_validator_activities_block_height_id_btree_index",
+		Up:      `CREATE INDEX view_validator_activities_block_height_id_btree_index ON view_validator_activities USING btree (block_height, id);`,
+		Down:    `DROP INDEX IF EXISTS view_validator_activities_block_height_id_btree_index;`,
+	},
+	{
+		Version: 20210125171426,
+		Name:    "view_validators_add_tendermint_pubkey_address",
+		Up: `ALTER TABLE view_validators
+    ADD tendermint_pubkey VARCHAR NOT NULL DEFAULT '',
+    ADD tendermint_address VARCHAR NOT NULL DEFAULT '';`,
+		Down: `ALTER TABLE view_validators
+    DROP COLUMN tendermint_pubkey,
+    DROP COLUMN tendermint_address;`,
+	},
+	{
+		Version: 20210125202339,
+		Name:    "view_validators_drop_unbonding_columns",
+		Up: `ALTER TABLE view_validators
+    DROP COLUMN unbonding_height,
+    DROP COLUMN unbonding_completion_time;`,
+		Down: `ALTER TABLE view_validators
+    ADD unbonding_height BIGINT NULL,
+    ADD unbonding_completion_time BIGINT NULL;`,
+	},
+	{
+		Version: 20210125232317,
+		Name:    "view_validator_block_commitments",
+		Up: `CREATE TABLE view_validator_block_commitments (
+    id BIGSERIAL,
+    consensus_node_address VARCHAR NOT NULL,
+    block_height BIGINT NOT NULL,
+    signature VARCHAR NOT NULL,
+    timestamp BIGINT NOT NULL,
+    PRIMARY KEY (id)
+)`,
+		Down: `DROP TABLE IF EXISTS view_validator_block_commitments;`,
+	},
+	{
+		Version: 20210126004127,
+		Name:    "view_validator_block_commitments_block_height_btree_index",
+		Up:      `CREATE INDEX view_validator_block_commitments_block_height_btree_index ON view_validator_block_commitments USING btree(block_height);`,
+		Down:    `DROP INDEX IF EXISTS view_validator_block_commitments_block_height_btree_index;`,
+	},
+	{
+		Version: 20210126005946,
+		Name:    "view_validator_block_commitments_total",
+		Up: `CREATE TABLE view_validator_block_commitments_total (
+    identity VARCHAR,
+    total BIGINT NOT NULL,
+    PRIMARY KEY (identity)
+)`,
+		Down: `DROP TABLE IF EXISTS view_validator_block_commitments_total;`,
+	},
+	{
+		Version: 20210130232207,
+		Name:    "view_validator_activities_operator_address_block_height_btree_index",
+		Up: `CREATE INDEX view_validator_activities_opaddr_block_height_btree_index
+ON view_validator_activities
+USING btree (operator_address, block_height);`,
+		Down: `DROP INDEX IF EXISTS view_validator_activities_opaddr_block_height_btree_index;`,
+	},
+	{
+		Version: 20210130232708,
+		Name:    "view_validator_activities_drop_operator_address_btree_index",
+		Up:      `DROP INDEX view_validator_activities_operator_address_btree_index;`,
+		Down:    `CREATE INDEX view_validator_activities_operator_address_btree_index ON view_validator_activities USING btree (operator_address);`,
+	},
+	{
+		Version: 20210429194507,
+		Name:    "alter_view_validators_add_uptime_columns",
+		Up: `ALTER TABLE view_validators
+ADD total_signed_block BIGINT,
+ADD total_active_block BIGINT,
+ADD imprecise_up_time NUMERIC;`,
+		Down: `ALTER TABLE view_validators
+    DROP COLUMN total_signed_block,
+    DROP COLUMN total_active_block,
+    DROP COLUMN imprecise_up_time;`,
+	},
+	{
+		Version: 20210430092131,
+		Name:    "alter_view_validators_add_voted_gov_proposal_column",
+		Up: `ALTER TABLE view_validators
+    ADD voted_gov_proposal NUMERIC`,
+		Down: `ALTER TABLE view_validators
+    DROP COLUMN voted_gov_proposal;`,
+	},
+	{
+		Version: 20210511020013,
+		Name:    "view_validator_block_commitments_add_column_is_proposer",
+		Up:      `ALTER TABLE view_validator_block_commitments ADD COLUMN is_proposer BOOLEAN NOT NULL;`,
+		Down:    `ALTER TABLE view_validator_block_commitments DROP COLUMN is_proposer;`,
+	},
+	{
+		Version: 20210710031219,
+		Name:    "view_validators_moniker_gin_trgm_index",
+		Up:      `CREATE INDEX view_validators_moniker_gin_trgm_index ON view_validators USING gin (moniker gin_trgm_ops);`,
+		Down:    `DROP INDEX IF EXISTS view_validators_moniker_gin_trgm_index;`,
+	},
+}
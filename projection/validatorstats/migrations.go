@@ -0,0 +1,18 @@
+package validatorstats
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20201128081828,
+		Name:    "view_validator_stats",
+		Up: `CREATE TABLE view_validator_stats (
+    metrics VARCHAR,
+    value VARCHAR,
+    PRIMARY KEY (metrics)
+)`,
+		Down: `DROP TABLE IF EXISTS view_validator_stats;`,
+	},
+}
@@ -0,0 +1,26 @@
+package vesting_account
+
+import "github.com/crypto-com/chain-indexing/appinterface/rdb"
+
+// Migrations creates the view tables of the projection. They are registered to the projection
+// registry and applied by `chain-indexing migrate` with the version table of the projection type.
+var Migrations = []rdb.Migration{
+	{
+		Version: 20210707081522,
+		Name:    "view_vesting_accounts",
+		Up: `CREATE TABLE view_vesting_accounts (
+    address VARCHAR NOT NULL,
+    type VARCHAR NOT NULL,
+    original_vesting JSONB NOT NULL,
+    start_time BIGINT NOT NULL,
+    end_time BIGINT NOT NULL,
+    periods JSONB NOT NULL,
+    created_at_block_height BIGINT NOT NULL,
+    maybe_created_at_transaction_hash VARCHAR NULL,
+    PRIMARY KEY (address)
+);
+
+CREATE INDEX view_vesting_accounts_end_time_btree_index ON view_vesting_accounts USING btree(end_time);`,
+		Down: `DROP TABLE IF EXISTS view_vesting_accounts;`,
+	},
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+const (
+	VESTING_TYPE_CONTINUOUS = "continuous"
+	VESTING_TYPE_DELAYED    = "delayed"
+	VESTING_TYPE_PERIODIC   = "periodic"
+)
+
+// Schedule describes how the original vesting coins of a vesting account are unlocked over time.
+// All times are in Unix seconds, following the Cosmos SDK vesting module.
+type Schedule struct {
+	Type            string     `json:"type"`
+	OriginalVesting coin.Coins `json:"originalVesting"`
+	StartTime       int64      `json:"startTime"`
+	EndTime         int64      `json:"endTime"`
+	Periods         []Period   `json:"periods"`
+}
+
+type Period struct {
+	// Length of the period in seconds
+	Length int64      `json:"length"`
+	Amount coin.Coins `json:"amount"`
+}
+
+// VestedAt returns the amount of coins vested at the specified Unix time
+func (schedule *Schedule) VestedAt(unixTime int64) coin.Coins {
+	switch schedule.Type {
+	case VESTING_TYPE_CONTINUOUS:
+		return schedule.continuousVestedAt(unixTime)
+	case VESTING_TYPE_DELAYED:
+		if unixTime >= schedule.EndTime {
+			return schedule.OriginalVesting
+		}
+		return coin.NewEmptyCoins()
+	case VESTING_TYPE_PERIODIC:
+		return schedule.periodicVestedAt(unixTime)
+	default:
+		return coin.NewEmptyCoins()
+	}
+}
+
+// UnvestedAt returns the amount of coins still vesting at the specified Unix time
+func (schedule *Schedule) UnvestedAt(unixTime int64) coin.Coins {
+	return schedule.OriginalVesting.Sub(schedule.VestedAt(unixTime))
+}
+
+func (schedule *Schedule) continuousVestedAt(unixTime int64) coin.Coins {
+	if unixTime <= schedule.StartTime {
+		return coin.NewEmptyCoins()
+	}
+	if unixTime >= schedule.EndTime {
+		return schedule.OriginalVesting
+	}
+
+	elapsed := coin.NewDec(unixTime - schedule.StartTime)
+	duration := coin.NewDec(schedule.EndTime - schedule.StartTime)
+	ratio := elapsed.Quo(duration)
+
+	vested := coin.NewEmptyCoins()
+	for _, unit := range schedule.OriginalVesting {
+		vestedAmount := unit.Amount.ToDec().Mul(ratio).RoundInt()
+		vested = vested.Add(coin.NewCoin(unit.Denom, vestedAmount))
+	}
+
+	return vested
+}
+
+func (schedule *Schedule) periodicVestedAt(unixTime int64) coin.Coins {
+	if unixTime <= schedule.StartTime {
+		return coin.NewEmptyCoins()
+	}
+	if unixTime >= schedule.EndTime {
+		return schedule.OriginalVesting
+	}
+
+	vested := coin.NewEmptyCoins()
+	periodEndTime := schedule.StartTime
+	for _, period := range schedule.Periods {
+		periodEndTime += period.Length
+		if unixTime < periodEndTime {
+			break
+		}
+		vested = vested.Add(period.Amount...)
+	}
+
+	return vested
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/projection/vesting_account/types"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+)
+
+var _ = Describe("Schedule", func() {
+	anyOriginalVesting := coin.NewCoins(coin.NewInt64Coin("basetcro", 1000))
+
+	Describe("continuous", func() {
+		schedule := types.Schedule{
+			Type:            types.VESTING_TYPE_CONTINUOUS,
+			OriginalVesting: anyOriginalVesting,
+			StartTime:       1000,
+			EndTime:         2000,
+		}
+
+		It("should vest nothing before start time", func() {
+			Expect(schedule.VestedAt(1000).IsZero()).To(BeTrue())
+			Expect(schedule.UnvestedAt(1000)).To(Equal(anyOriginalVesting))
+		})
+
+		It("should vest linearly between start and end time", func() {
+			Expect(schedule.VestedAt(1250)).To(Equal(coin.NewCoins(coin.NewInt64Coin("basetcro", 250))))
+			Expect(schedule.UnvestedAt(1250)).To(Equal(coin.NewCoins(coin.NewInt64Coin("basetcro", 750))))
+		})
+
+		It("should vest everything at end time", func() {
+			Expect(schedule.VestedAt(2000)).To(Equal(anyOriginalVesting))
+			Expect(schedule.UnvestedAt(2000).IsZero()).To(BeTrue())
+		})
+	})
+
+	Describe("delayed", func() {
+		schedule := types.Schedule{
+			Type:            types.VESTING_TYPE_DELAYED,
+			OriginalVesting: anyOriginalVesting,
+			EndTime:         2000,
+		}
+
+		It("should vest everything only at end time", func() {
+			Expect(schedule.VestedAt(1999).IsZero()).To(BeTrue())
+			Expect(schedule.VestedAt(2000)).To(Equal(anyOriginalVesting))
+		})
+	})
+
+	Describe("periodic", func() {
+		schedule := types.Schedule{
+			Type:            types.VESTING_TYPE_PERIODIC,
+			OriginalVesting: anyOriginalVesting,
+			StartTime:       1000,
+			EndTime:         1300,
+			Periods: []types.Period{{
+				Length: 100,
+				Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 200)),
+			}, {
+				Length: 100,
+				Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 300)),
+			}, {
+				Length: 100,
+				Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 500)),
+			}},
+		}
+
+		It("should vest period amount at the end of each period", func() {
+			Expect(schedule.VestedAt(1099).IsZero()).To(BeTrue())
+			Expect(schedule.VestedAt(1100)).To(Equal(coin.NewCoins(coin.NewInt64Coin("basetcro", 200))))
+			Expect(schedule.VestedAt(1250)).To(Equal(coin.NewCoins(coin.NewInt64Coin("basetcro", 500))))
+			Expect(schedule.VestedAt(1300)).To(Equal(anyOriginalVesting))
+		})
+	})
+})
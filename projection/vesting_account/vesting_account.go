@@ -0,0 +1,218 @@
+package vesting_account
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/crypto-com/chain-indexing/appinterface/projection/rdbprojectionbase"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	projection_entity "github.com/crypto-com/chain-indexing/entity/projection"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/vesting_account/types"
+	"github.com/crypto-com/chain-indexing/projection/vesting_account/view"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ projection_entity.Projection = &VestingAccount{}
+
+const (
+	GENESIS_CONTINUOUS_VESTING_ACCOUNT = "/cosmos.vesting.v1beta1.ContinuousVestingAccount"
+	GENESIS_DELAYED_VESTING_ACCOUNT    = "/cosmos.vesting.v1beta1.DelayedVestingAccount"
+	GENESIS_PERIODIC_VESTING_ACCOUNT   = "/cosmos.vesting.v1beta1.PeriodicVestingAccount"
+)
+
+type VestingAccount struct {
+	*rdbprojectionbase.Base
+
+	rdbConn rdb.Conn
+	logger  applogger.Logger
+}
+
+func NewVestingAccount(logger applogger.Logger, rdbConn rdb.Conn) *VestingAccount {
+	return &VestingAccount{
+		rdbprojectionbase.NewRDbBase(rdbConn.ToHandle(), "VestingAccount"),
+
+		rdbConn,
+		logger,
+	}
+}
+
+func (_ *VestingAccount) GetEventsToListen() []string {
+	return []string{
+		event_usecase.GENESIS_CREATED,
+		event_usecase.BLOCK_CREATED,
+		event_usecase.MSG_CREATE_VESTING_ACCOUNT_CREATED,
+	}
+}
+
+func (_ *VestingAccount) OnInit() error {
+	return nil
+}
+
+func (projection *VestingAccount) HandleEvents(height int64, events []event_entity.Event) error {
+	rdbTx, err := projection.rdbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			_ = rdbTx.Rollback()
+		}
+	}()
+
+	rdbTxHandle := rdbTx.ToHandle()
+
+	vestingAccountsView := view.NewVestingAccounts(rdbTxHandle)
+
+	var blockTimeUnix int64
+	for _, event := range events {
+		if blockCreatedEvent, ok := event.(*event_usecase.BlockCreated); ok {
+			blockTimeUnix = blockCreatedEvent.Block.Time.UnixNano() / int64(time.Second)
+		}
+	}
+
+	for _, event := range events {
+		if genesisCreatedEvent, ok := event.(*event_usecase.GenesisCreated); ok {
+			genesisTime, parseErr := utctime.Parse(time.RFC3339, genesisCreatedEvent.Genesis.GenesisTime)
+			if parseErr != nil {
+				return fmt.Errorf("error parsing genesis time: %v", parseErr)
+			}
+			genesisTimeUnix := genesisTime.UnixNano() / int64(time.Second)
+
+			for _, account := range genesisCreatedEvent.Genesis.AppState.Auth.Accounts {
+				address, maybeSchedule, parseErr := ScheduleFromGenesisAccount(&account, genesisTimeUnix)
+				if parseErr != nil {
+					return fmt.Errorf("error parsing genesis vesting account: %v", parseErr)
+				}
+				if maybeSchedule == nil {
+					continue
+				}
+
+				if err := vestingAccountsView.Upsert(&view.VestingAccountRow{
+					Address:              address,
+					Schedule:             *maybeSchedule,
+					CreatedAtBlockHeight: height,
+				}); err != nil {
+					return fmt.Errorf("error inserting genesis vesting account: %v", err)
+				}
+			}
+
+		} else if msgCreateVestingAccount, ok := event.(*event_usecase.MsgCreateVestingAccount); ok {
+			vestingType := types.VESTING_TYPE_CONTINUOUS
+			if msgCreateVestingAccount.Delayed {
+				vestingType = types.VESTING_TYPE_DELAYED
+			}
+
+			if err := vestingAccountsView.Upsert(&view.VestingAccountRow{
+				Address: msgCreateVestingAccount.ToAddress,
+				Schedule: types.Schedule{
+					Type:            vestingType,
+					OriginalVesting: msgCreateVestingAccount.Amount,
+					StartTime:       blockTimeUnix,
+					EndTime:         msgCreateVestingAccount.EndTime,
+					Periods:         []types.Period{},
+				},
+				CreatedAtBlockHeight:          height,
+				MaybeCreatedAtTransactionHash: primptr.String(msgCreateVestingAccount.TxHash()),
+			}); err != nil {
+				return fmt.Errorf("error inserting vesting account: %v", err)
+			}
+		}
+	}
+
+	if err := projection.UpdateLastHandledEventHeight(rdbTxHandle, height); err != nil {
+		return fmt.Errorf("error updating last handled event height: %v", err)
+	}
+
+	if err := rdbTx.Commit(); err != nil {
+		return fmt.Errorf("error committing changes: %v", err)
+	}
+	committed = true
+	return nil
+}
+
+// ScheduleFromGenesisAccount returns the address and vesting schedule of a genesis account. The
+// returned schedule is nil when the account is not a vesting account. Delayed vesting accounts have
+// no start time in genesis, so their schedule starts at the genesis time, the same way the accounts
+// created by messages start at the block time.
+func ScheduleFromGenesisAccount(account *genesis.Account, genesisTimeUnix int64) (string, *types.Schedule, error) {
+	var vestingType string
+	switch account.Type {
+	case GENESIS_CONTINUOUS_VESTING_ACCOUNT:
+		vestingType = types.VESTING_TYPE_CONTINUOUS
+	case GENESIS_DELAYED_VESTING_ACCOUNT:
+		vestingType = types.VESTING_TYPE_DELAYED
+	case GENESIS_PERIODIC_VESTING_ACCOUNT:
+		vestingType = types.VESTING_TYPE_PERIODIC
+	default:
+		return "", nil, nil
+	}
+	if account.BaseVestingAccount == nil {
+		return "", nil, fmt.Errorf("missing base vesting account in %s", account.Type)
+	}
+
+	address := account.BaseVestingAccount.BaseAccount.Address
+	originalVesting, err := coinsFromMinDeposits(account.BaseVestingAccount.OriginalVesting)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing original vesting of %s: %v", address, err)
+	}
+
+	endTime, err := strconv.ParseInt(account.BaseVestingAccount.EndTime, 10, 64)
+	if err != nil {
+		return "", nil, fmt.Errorf("error parsing vesting end time of %s: %v", address, err)
+	}
+
+	startTime := genesisTimeUnix
+	if account.StartTime != nil {
+		startTime, err = strconv.ParseInt(*account.StartTime, 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("error parsing vesting start time of %s: %v", address, err)
+		}
+	}
+
+	periods := make([]types.Period, 0, len(account.VestingPeriods))
+	for _, rawPeriod := range account.VestingPeriods {
+		length, parseErr := strconv.ParseInt(rawPeriod.Length, 10, 64)
+		if parseErr != nil {
+			return "", nil, fmt.Errorf("error parsing vesting period length of %s: %v", address, parseErr)
+		}
+		amount, parseErr := coinsFromMinDeposits(rawPeriod.Amount)
+		if parseErr != nil {
+			return "", nil, fmt.Errorf("error parsing vesting period amount of %s: %v", address, parseErr)
+		}
+
+		periods = append(periods, types.Period{
+			Length: length,
+			Amount: amount,
+		})
+	}
+
+	return address, &types.Schedule{
+		Type:            vestingType,
+		OriginalVesting: originalVesting,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		Periods:         periods,
+	}, nil
+}
+
+func coinsFromMinDeposits(rawCoins []genesis.MinDeposit) (coin.Coins, error) {
+	coins := coin.NewEmptyCoins()
+	for _, rawCoin := range rawCoins {
+		unit, err := coin.NewCoinFromString(rawCoin.Denom, rawCoin.Amount)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(unit)
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,13 @@
+package vesting_account_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestVestingAccount(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Vesting Account Suite")
+}
@@ -0,0 +1,117 @@
+package vesting_account_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/crypto-com/chain-indexing/appinterface/rdb/test"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/projection/vesting_account"
+	"github.com/crypto-com/chain-indexing/projection/vesting_account/types"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model/genesis"
+)
+
+var _ = Describe("VestingAccount", func() {
+	It("should implement projection", func() {
+		fakeLogger := NewFakeLogger()
+		fakeRdbConn := NewFakeRDbConn()
+		var _ entity_projection.Projection = vesting_account.NewVestingAccount(fakeLogger, fakeRdbConn)
+	})
+
+	Describe("ScheduleFromGenesisAccount", func() {
+		anyGenesisTimeUnix := int64(1616630000)
+
+		It("should return nil schedule for non-vesting account", func() {
+			_, schedule, err := vesting_account.ScheduleFromGenesisAccount(&genesis.Account{
+				Type: "/cosmos.auth.v1beta1.BaseAccount",
+			}, anyGenesisTimeUnix)
+			Expect(err).To(BeNil())
+			Expect(schedule).To(BeNil())
+		})
+
+		It("should parse periodic vesting account", func() {
+			anyAddress := "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn"
+			address, schedule, err := vesting_account.ScheduleFromGenesisAccount(&genesis.Account{
+				Type: vesting_account.GENESIS_PERIODIC_VESTING_ACCOUNT,
+				BaseVestingAccount: &genesis.BaseVestingAccount{
+					BaseAccount: genesis.BaseAccount{
+						Address: anyAddress,
+					},
+					OriginalVesting: []genesis.MinDeposit{{
+						Denom:  "basetcro",
+						Amount: "300",
+					}},
+					EndTime: "1616634200",
+				},
+				StartTime: primptr.String("1616634000"),
+				VestingPeriods: []genesis.VestingPeriod{{
+					Length: "100",
+					Amount: []genesis.MinDeposit{{
+						Denom:  "basetcro",
+						Amount: "100",
+					}},
+				}, {
+					Length: "100",
+					Amount: []genesis.MinDeposit{{
+						Denom:  "basetcro",
+						Amount: "200",
+					}},
+				}},
+			}, anyGenesisTimeUnix)
+			Expect(err).To(BeNil())
+			Expect(address).To(Equal(anyAddress))
+			Expect(*schedule).To(Equal(types.Schedule{
+				Type:            types.VESTING_TYPE_PERIODIC,
+				OriginalVesting: coin.NewCoins(coin.NewInt64Coin("basetcro", 300)),
+				StartTime:       1616634000,
+				EndTime:         1616634200,
+				Periods: []types.Period{{
+					Length: 100,
+					Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 100)),
+				}, {
+					Length: 100,
+					Amount: coin.NewCoins(coin.NewInt64Coin("basetcro", 200)),
+				}},
+			}))
+		})
+
+		It("should start delayed vesting account at genesis time", func() {
+			anyAddress := "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn"
+			address, schedule, err := vesting_account.ScheduleFromGenesisAccount(&genesis.Account{
+				Type: vesting_account.GENESIS_DELAYED_VESTING_ACCOUNT,
+				BaseVestingAccount: &genesis.BaseVestingAccount{
+					BaseAccount: genesis.BaseAccount{
+						Address: anyAddress,
+					},
+					OriginalVesting: []genesis.MinDeposit{{
+						Denom:  "basetcro",
+						Amount: "300",
+					}},
+					EndTime: "1616634200",
+				},
+			}, anyGenesisTimeUnix)
+			Expect(err).To(BeNil())
+			Expect(address).To(Equal(anyAddress))
+			Expect(*schedule).To(Equal(types.Schedule{
+				Type:            types.VESTING_TYPE_DELAYED,
+				OriginalVesting: coin.NewCoins(coin.NewInt64Coin("basetcro", 300)),
+				StartTime:       anyGenesisTimeUnix,
+				EndTime:         1616634200,
+				Periods:         []types.Period{},
+			}))
+		})
+
+		It("should return error when end time is invalid", func() {
+			_, _, err := vesting_account.ScheduleFromGenesisAccount(&genesis.Account{
+				Type: vesting_account.GENESIS_DELAYED_VESTING_ACCOUNT,
+				BaseVestingAccount: &genesis.BaseVestingAccount{
+					EndTime: "invalid",
+				},
+			}, anyGenesisTimeUnix)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
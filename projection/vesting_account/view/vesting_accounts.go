@@ -0,0 +1,168 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/internal/json"
+	"github.com/crypto-com/chain-indexing/projection/vesting_account/types"
+)
+
+const VESTING_ACCOUNTS_TABLE_NAME = "view_vesting_accounts"
+
+type VestingAccounts struct {
+	rdb *rdb.Handle
+}
+
+func NewVestingAccounts(handle *rdb.Handle) *VestingAccounts {
+	return &VestingAccounts{
+		handle,
+	}
+}
+
+func (vestingAccountsView *VestingAccounts) Upsert(row *VestingAccountRow) error {
+	sql, sqlArgs, err := vestingAccountsView.rdb.StmtBuilder.Insert(
+		VESTING_ACCOUNTS_TABLE_NAME,
+	).Columns(
+		"address",
+		"type",
+		"original_vesting",
+		"start_time",
+		"end_time",
+		"periods",
+		"created_at_block_height",
+		"maybe_created_at_transaction_hash",
+	).Values(
+		row.Address,
+		row.Schedule.Type,
+		json.MustMarshalToString(row.Schedule.OriginalVesting),
+		row.Schedule.StartTime,
+		row.Schedule.EndTime,
+		json.MustMarshalToString(row.Schedule.Periods),
+		row.CreatedAtBlockHeight,
+		row.MaybeCreatedAtTransactionHash,
+	).Suffix(
+		"ON CONFLICT(address) DO UPDATE SET " +
+			"type = EXCLUDED.type, " +
+			"original_vesting = EXCLUDED.original_vesting, " +
+			"start_time = EXCLUDED.start_time, " +
+			"end_time = EXCLUDED.end_time, " +
+			"periods = EXCLUDED.periods, " +
+			"created_at_block_height = EXCLUDED.created_at_block_height, " +
+			"maybe_created_at_transaction_hash = EXCLUDED.maybe_created_at_transaction_hash",
+	).ToSql()
+	if err != nil {
+		return fmt.Errorf("error building vesting account upsert sql: %v: %w", err, rdb.ErrBuildSQLStmt)
+	}
+
+	result, err := vestingAccountsView.rdb.Exec(sql, sqlArgs...)
+	if err != nil {
+		return fmt.Errorf("error upserting vesting account into the table: %v: %w", err, rdb.ErrWrite)
+	}
+	if result.RowsAffected() != 1 {
+		return fmt.Errorf("error upserting vesting account into the table: no rows upserted: %w", rdb.ErrWrite)
+	}
+
+	return nil
+}
+
+func (vestingAccountsView *VestingAccounts) FindBy(address string) (*VestingAccountRow, error) {
+	sql, sqlArgs, err := vestingAccountsView.selectStmtBuilder().Where(
+		"address = ?", address,
+	).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building vesting account selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	row, err := vestingAccountsView.scanRow(vestingAccountsView.rdb.QueryRow(sql, sqlArgs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// ListAll returns all the vesting accounts. Optionally only the accounts still vesting after the
+// specified Unix time are returned.
+func (vestingAccountsView *VestingAccounts) ListAll(filter VestingAccountsListFilter) ([]VestingAccountRow, error) {
+	stmtBuilder := vestingAccountsView.selectStmtBuilder()
+	if filter.MaybeEndTimeAfter != nil {
+		stmtBuilder = stmtBuilder.Where("end_time > ?", *filter.MaybeEndTimeAfter)
+	}
+	sql, sqlArgs, err := stmtBuilder.OrderBy("address").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building vesting accounts selection sql: %v: %w", err, rdb.ErrPrepare)
+	}
+
+	rowsResult, err := vestingAccountsView.rdb.Query(sql, sqlArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("error executing vesting accounts selection sql: %v: %w", err, rdb.ErrQuery)
+	}
+	defer rowsResult.Close()
+
+	rows := make([]VestingAccountRow, 0)
+	for rowsResult.Next() {
+		row, scanErr := vestingAccountsView.scanRow(rowsResult)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+
+		rows = append(rows, *row)
+	}
+
+	return rows, nil
+}
+
+func (vestingAccountsView *VestingAccounts) selectStmtBuilder() sq.SelectBuilder {
+	return vestingAccountsView.rdb.StmtBuilder.Select(
+		"address",
+		"type",
+		"original_vesting",
+		"start_time",
+		"end_time",
+		"periods",
+		"created_at_block_height",
+		"maybe_created_at_transaction_hash",
+	).From(
+		VESTING_ACCOUNTS_TABLE_NAME,
+	)
+}
+
+func (vestingAccountsView *VestingAccounts) scanRow(scanner rdb.RowResult) (*VestingAccountRow, error) {
+	var row VestingAccountRow
+	var originalVestingJSON, periodsJSON string
+	if err := scanner.Scan(
+		&row.Address,
+		&row.Schedule.Type,
+		&originalVestingJSON,
+		&row.Schedule.StartTime,
+		&row.Schedule.EndTime,
+		&periodsJSON,
+		&row.CreatedAtBlockHeight,
+		&row.MaybeCreatedAtTransactionHash,
+	); err != nil {
+		if errors.Is(err, rdb.ErrNoRows) {
+			return nil, rdb.ErrNoRows
+		}
+		return nil, fmt.Errorf("error scanning vesting account row: %v: %w", err, rdb.ErrQuery)
+	}
+
+	json.MustUnmarshalFromString(originalVestingJSON, &row.Schedule.OriginalVesting)
+	json.MustUnmarshalFromString(periodsJSON, &row.Schedule.Periods)
+
+	return &row, nil
+}
+
+type VestingAccountRow struct {
+	Address                       string         `json:"address"`
+	Schedule                      types.Schedule `json:"schedule"`
+	CreatedAtBlockHeight          int64          `json:"createdAtBlockHeight"`
+	MaybeCreatedAtTransactionHash *string        `json:"createdAtTransactionHash"`
+}
+
+type VestingAccountsListFilter struct {
+	MaybeEndTimeAfter *int64
+}
@@ -0,0 +1,219 @@
+package harness
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	event_interface "github.com/crypto-com/chain-indexing/appinterface/event"
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/infrastructure/sqlite"
+	applogger "github.com/crypto-com/chain-indexing/internal/logger"
+	. "github.com/crypto-com/chain-indexing/internal/logger/test"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+// Harness feeds a scripted sequence of events through a StoreBasedManager into projections. The
+// events are kept in an in-memory event store, and the projections write to a temporary SQLite
+// database, so a projection must have its tables in the SQLite migrations to be tested.
+//
+//	h := harness.MustNew()
+//	defer h.MustClose()
+//	projection := block.NewBlock(h.Logger(), h.RDbConn())
+//	h.MustRegisterProjection(projection)
+//	h.MustAddHeight(1, event_usecase.NewBlockCreated(...))
+//	h.MustRun()
+//	blocks := view.NewBlocks(h.RDbConn().ToHandle())
+type Harness struct {
+	logger applogger.Logger
+
+	tempDir string
+	rdbConn *sqlite.SQLiteConn
+
+	registry   *entity_event.Registry
+	eventStore *event_interface.MemoryStore
+	manager    *entity_projection.StoreBasedManager
+
+	projections     []entity_projection.Projection
+	maybeLastHeight *int64
+}
+
+func MustNew() *Harness {
+	harness, err := New()
+	if err != nil {
+		panic(err)
+	}
+
+	return harness
+}
+
+// New creates a harness with an empty event store and a migrated temporary SQLite database. All
+// events of the usecase are registered to the registry.
+func New() (*Harness, error) {
+	tempDir, err := ioutil.TempDir("", "chain-indexing-harness")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %v", err)
+	}
+
+	config := &sqlite.ConnConfig{
+		Path: filepath.Join(tempDir, "harness.db"),
+	}
+	sqliteMigrate, err := sqlite.NewMigrate(config, migrationsFolder())
+	if err != nil {
+		_ = os.RemoveAll(tempDir)
+		return nil, fmt.Errorf("error creating migrate: %v", err)
+	}
+	migrateErr := sqliteMigrate.Up()
+	_, _ = sqliteMigrate.Close()
+	if migrateErr != nil {
+		_ = os.RemoveAll(tempDir)
+		return nil, fmt.Errorf("error migrating database: %v", migrateErr)
+	}
+
+	rdbConn, err := sqlite.NewSQLiteConn(config)
+	if err != nil {
+		_ = os.RemoveAll(tempDir)
+		return nil, fmt.Errorf("error connecting database: %v", err)
+	}
+
+	logger := NewFakeLogger()
+	registry := entity_event.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+	eventStore := event_interface.NewMemoryStore(registry)
+
+	return &Harness{
+		logger: logger,
+
+		tempDir: tempDir,
+		rdbConn: rdbConn,
+
+		registry:   registry,
+		eventStore: eventStore,
+		manager:    entity_projection.NewStoreBasedManager(logger, eventStore),
+
+		projections: make([]entity_projection.Projection, 0),
+	}, nil
+}
+
+func migrationsFolder() string {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		panic("error retrieving file directory")
+	}
+
+	return path.Join(filename, "../../..", sqlite.DEFAULT_MIGRATIONS_FOLDER)
+}
+
+func (harness *Harness) Logger() applogger.Logger {
+	return harness.logger
+}
+
+func (harness *Harness) RDbConn() rdb.Conn {
+	return harness.rdbConn
+}
+
+// Registry returns the event registry, to which events outside of the usecase can be registered
+func (harness *Harness) Registry() *entity_event.Registry {
+	return harness.registry
+}
+
+func (harness *Harness) EventStore() *event_interface.MemoryStore {
+	return harness.eventStore
+}
+
+func (harness *Harness) MustRegisterProjection(projection entity_projection.Projection) {
+	if err := harness.RegisterProjection(projection); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterProjection initializes the projection and registers it to the manager
+func (harness *Harness) RegisterProjection(projection entity_projection.Projection) error {
+	if err := projection.OnInit(); err != nil {
+		return fmt.Errorf("error initializing projection `%s`: %v", projection.Id(), err)
+	}
+	if err := harness.manager.RegisterProjection(projection); err != nil {
+		return err
+	}
+
+	harness.projections = append(harness.projections, projection)
+	return nil
+}
+
+func (harness *Harness) MustAddHeight(height int64, events ...entity_event.Event) {
+	if err := harness.AddHeight(height, events...); err != nil {
+		panic(err)
+	}
+}
+
+// AddHeight appends the events of the height to the event store. Heights must be added in
+// ascending order. Skipped heights, and heights added with no events, are fed to the projections
+// with no events once a later height has events.
+func (harness *Harness) AddHeight(height int64, events ...entity_event.Event) error {
+	for _, event := range events {
+		if event.Height() != height {
+			return fmt.Errorf(
+				"event %sV%d(%s) has height %d but is added to height %d",
+				event.Name(), event.Version(), event.UUID(), event.Height(), height,
+			)
+		}
+	}
+
+	if harness.maybeLastHeight != nil && height <= *harness.maybeLastHeight {
+		return fmt.Errorf("height %d is not after the last added height %d", height, *harness.maybeLastHeight)
+	}
+
+	if err := harness.eventStore.InsertAll(events); err != nil {
+		return err
+	}
+	harness.maybeLastHeight = &height
+	return nil
+}
+
+func (harness *Harness) MustRun() {
+	if err := harness.Run(); err != nil {
+		panic(err)
+	}
+}
+
+// Run feeds all the events added since the last run to the projections in the order they are
+// registered, after their dependencies, and returns the first error of the projections
+func (harness *Harness) Run() error {
+	dependencyGraph, err := harness.manager.DependencyGraph()
+	if err != nil {
+		return err
+	}
+	projectionsById := make(map[string]entity_projection.Projection, len(harness.projections))
+	for _, projection := range harness.projections {
+		projectionsById[projection.Id()] = projection
+	}
+
+	for _, id := range dependencyGraph.Order {
+		projection := projectionsById[id]
+		if err := harness.manager.SyncProjection(projection); err != nil {
+			return fmt.Errorf("error running projection `%s`: %v", projection.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func (harness *Harness) MustClose() {
+	if err := harness.Close(); err != nil {
+		panic(err)
+	}
+}
+
+// Close closes the database and deletes it
+func (harness *Harness) Close() error {
+	if err := harness.rdbConn.Close(); err != nil {
+		return fmt.Errorf("error closing database: %v", err)
+	}
+
+	return os.RemoveAll(harness.tempDir)
+}
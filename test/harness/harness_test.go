@@ -0,0 +1,231 @@
+package harness_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/appinterface/pagination"
+	"github.com/crypto-com/chain-indexing/appinterface/projection/view"
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	entity_projection "github.com/crypto-com/chain-indexing/entity/projection"
+	"github.com/crypto-com/chain-indexing/internal/primptr"
+	"github.com/crypto-com/chain-indexing/internal/utctime"
+	"github.com/crypto-com/chain-indexing/projection/account"
+	"github.com/crypto-com/chain-indexing/projection/block"
+	block_view "github.com/crypto-com/chain-indexing/projection/block/view"
+	"github.com/crypto-com/chain-indexing/projection/transaction"
+	transaction_view "github.com/crypto-com/chain-indexing/projection/transaction/view"
+	"github.com/crypto-com/chain-indexing/projection/validator"
+	"github.com/crypto-com/chain-indexing/test/harness"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+	usecase_model "github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+var _ = Describe("Harness", func() {
+	var h *harness.Harness
+
+	BeforeEach(func() {
+		h = harness.MustNew()
+	})
+
+	AfterEach(func() {
+		h.MustClose()
+	})
+
+	It("should feed scripted heights to Block and Transaction projections", func() {
+		blockProjection := block.NewBlock(h.Logger(), h.RDbConn())
+		transactionProjection := transaction.NewTransaction(h.Logger(), h.RDbConn())
+		h.MustRegisterProjection(blockProjection)
+		h.MustRegisterProjection(transactionProjection)
+
+		h.MustAddHeight(1, newBlockCreated(1, 0))
+		h.MustAddHeight(3, newBlockCreated(3, 1), newTransactionCreated(3, "ANY_TX_HASH"))
+		h.MustRun()
+
+		Expect(blockProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(3)))
+		Expect(transactionProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(3)))
+
+		blocks, _, err := block_view.NewBlocks(h.RDbConn().ToHandle()).List(block_view.BlocksListOrder{
+			Height: view.ORDER_ASC,
+		}, pagination.NewOffsetPagination(1, 10))
+		Expect(err).To(BeNil())
+		Expect(blocks).To(HaveLen(2))
+		Expect(blocks[0].Height).To(Equal(int64(1)))
+		Expect(blocks[1].Height).To(Equal(int64(3)))
+		Expect(blocks[1].TransactionCount).To(Equal(1))
+
+		transactionsView := transaction_view.NewTransactions(h.RDbConn().ToHandle())
+		transaction, err := transactionsView.FindByHash("ANY_TX_HASH")
+		Expect(err).To(BeNil())
+		Expect(transaction.BlockHeight).To(Equal(int64(3)))
+		Expect(transaction.BlockHash).To(Equal(anyBlockHash(3)))
+		Expect(transaction.Fee).To(Equal(coin.NewCoins(coin.NewInt64Coin("basecro", 1000))))
+	})
+
+	It("should only feed the heights added since the last run", func() {
+		fakeProjection := newFakeProjection()
+		h.MustRegisterProjection(fakeProjection)
+
+		h.MustAddHeight(1, newBlockCreated(1, 0))
+		h.MustRun()
+		h.MustAddHeight(2, newBlockCreated(2, 0))
+		h.MustRun()
+
+		Expect(fakeProjection.handledHeights).To(Equal([]int64{0, 1, 2}))
+	})
+
+	It("should return error of projection", func() {
+		fakeProjection := newFakeProjection()
+		fakeProjection.maybeFailingHeight = primptr.Int64(2)
+		h.MustRegisterProjection(fakeProjection)
+
+		h.MustAddHeight(1, newBlockCreated(1, 0))
+		h.MustAddHeight(2, newBlockCreated(2, 0))
+		h.MustAddHeight(3, newBlockCreated(3, 0))
+
+		Expect(h.Run()).To(MatchError(
+			"error running projection `FakeProjection`: error handling events at height 2: any error",
+		))
+		Expect(fakeProjection.GetLastHandledEventHeight()).To(Equal(primptr.Int64(1)))
+	})
+
+	It("should run Account after Validator it depends on", func() {
+		handledLog := make([]string, 0)
+		accountProjection := newRecordingProjection(
+			account.NewAccount(h.Logger(), h.RDbConn(), nil), &handledLog,
+		)
+		validatorProjection := newRecordingProjection(
+			validator.NewValidator(h.Logger(), h.RDbConn(), "crocnclcons"), &handledLog,
+		)
+		h.MustRegisterProjection(accountProjection)
+		h.MustRegisterProjection(validatorProjection)
+
+		h.MustAddHeight(1, newBlockCreated(1, 0))
+		h.MustAddHeight(2, newBlockCreated(2, 0))
+		h.MustRun()
+
+		Expect(handledLog).To(Equal([]string{
+			"Validator@0", "Validator@1", "Validator@2",
+			"Account@0", "Account@1", "Account@2",
+		}))
+	})
+
+	It("should return error when the height of event mismatches or heights are out of order", func() {
+		Expect(h.AddHeight(2, newBlockCreated(1, 0))).NotTo(Succeed())
+
+		Expect(h.AddHeight(2, newBlockCreated(2, 0))).To(Succeed())
+		Expect(h.AddHeight(2)).NotTo(Succeed())
+		Expect(h.AddHeight(1, newBlockCreated(1, 0))).NotTo(Succeed())
+	})
+})
+
+func anyBlockHash(height int64) string {
+	return []string{
+		"B69554A020537DA8E7C7610A318180C09BFEB91229BB85D4A78DDA2FACF68A48",
+		"C8D9C71D3E8B2ED7B2E36A5E1F5E1C8B0D1C2D8A8C0B7E5D3D2A1F4E6C8B9A0D",
+		"2A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F70819",
+	}[height-1]
+}
+
+func newBlockCreated(height int64, txCount int) *event_usecase.BlockCreated {
+	txs := make([]string, 0, txCount)
+	for i := 0; i < txCount; i++ {
+		txs = append(txs, "AAAMZqICtpjLrA3uEe3Rkg6cqDgQl0iBwG1Wm8ORZRzKL9EBAE1R0oP73H8A")
+	}
+
+	return event_usecase.NewBlockCreated(&usecase_model.Block{
+		Height:          height,
+		Hash:            anyBlockHash(height),
+		Time:            utctime.FromUnixNano(height * int64(1000000)),
+		AppHash:         "24474D86CBFA7E6328D473C17A9E46CD5A80FFE82A348A74844BF3E2BA2B3AF1",
+		ProposerAddress: "F9E6FFB9B536956201AA138224FD888D03775AB4",
+		Txs:             txs,
+		Signatures:      []usecase_model.BlockSignature{},
+	})
+}
+
+func newTransactionCreated(height int64, txHash string) *event_usecase.TransactionCreated {
+	return event_usecase.NewTransactionCreated(height, usecase_model.CreateTransactionParams{
+		TxHash:    txHash,
+		Log:       "[]",
+		MsgCount:  0,
+		Signers:   []usecase_model.TransactionSigner{},
+		Fee:       coin.NewCoins(coin.NewInt64Coin("basecro", 1000)),
+		GasWanted: 200000,
+		GasUsed:   60000,
+	})
+}
+
+type fakeProjection struct {
+	handledHeights     []int64
+	maybeFailingHeight *int64
+}
+
+func newFakeProjection() *fakeProjection {
+	return &fakeProjection{
+		handledHeights: make([]int64, 0),
+	}
+}
+
+func (projection *fakeProjection) Id() string {
+	return "FakeProjection"
+}
+func (projection *fakeProjection) GetEventsToListen() []string {
+	return []string{event_usecase.BLOCK_CREATED}
+}
+func (projection *fakeProjection) GetLastHandledEventHeight() (*int64, error) {
+	if len(projection.handledHeights) == 0 {
+		return nil, nil
+	}
+	return primptr.Int64(projection.handledHeights[len(projection.handledHeights)-1]), nil
+}
+func (projection *fakeProjection) OnInit() error {
+	return nil
+}
+func (projection *fakeProjection) HandleEvents(height int64, _ []entity_event.Event) error {
+	if projection.maybeFailingHeight != nil && *projection.maybeFailingHeight == height {
+		return errors.New("any error")
+	}
+	projection.handledHeights = append(projection.handledHeights, height)
+	return nil
+}
+
+// recordingProjection keeps the Id, listened events and dependencies of a projection, but only
+// records the heights it handles into a log shared with other projections
+type recordingProjection struct {
+	entity_projection.Projection
+
+	dependencies           []string
+	handledLog             *[]string
+	maybeLastHandledHeight *int64
+}
+
+func newRecordingProjection(projection entity_projection.Projection, handledLog *[]string) *recordingProjection {
+	dependencies := make([]string, 0)
+	if dependentProjection, ok := projection.(entity_projection.DependentProjection); ok {
+		dependencies = dependentProjection.GetDependencies()
+	}
+
+	return &recordingProjection{
+		Projection: projection,
+
+		dependencies: dependencies,
+		handledLog:   handledLog,
+	}
+}
+
+func (projection *recordingProjection) GetDependencies() []string {
+	return projection.dependencies
+}
+func (projection *recordingProjection) GetLastHandledEventHeight() (*int64, error) {
+	return projection.maybeLastHandledHeight, nil
+}
+func (projection *recordingProjection) HandleEvents(height int64, _ []entity_event.Event) error {
+	*projection.handledLog = append(*projection.handledLog, fmt.Sprintf("%s@%d", projection.Id(), height))
+	projection.maybeLastHandledHeight = primptr.Int64(height)
+	return nil
+}
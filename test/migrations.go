@@ -0,0 +1,79 @@
+package test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/crypto-com/chain-indexing/appinterface/rdb"
+	"github.com/crypto-com/chain-indexing/projection"
+)
+
+// mustReadAllMigrations returns the core migrations in the folder together with the migrations of
+// the projections of this repository, so that a test database has all the tables
+func mustReadAllMigrations(coreMigrationsFolder string) []rdb.Migration {
+	migrations, err := readMigrationsFolder(coreMigrationsFolder)
+	if err != nil {
+		panic(err)
+	}
+
+	registry := projection.NewCoreRegistry()
+	readOwners := make(map[string]bool)
+	for _, projectionType := range registry.Types() {
+		tableOwner := registry.TableOwner(projectionType)
+		if readOwners[tableOwner] {
+			continue
+		}
+		readOwners[tableOwner] = true
+		migrations = append(migrations, registry.Migrations(projectionType)...)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations
+}
+
+// readMigrationsFolder reads the `<version>_<name>.up.sql` and `<version>_<name>.down.sql` files in
+// the folder
+func readMigrationsFolder(folder string) ([]rdb.Migration, error) {
+	files, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return nil, fmt.Errorf("error reading migrations folder: %v", err)
+	}
+
+	migrations := make([]rdb.Migration, 0)
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".up.sql") {
+			continue
+		}
+		identifier := strings.TrimSuffix(file.Name(), ".up.sql")
+		versionAndName := strings.SplitN(identifier, "_", 2)
+		if len(versionAndName) != 2 {
+			return nil, fmt.Errorf("invalid migration file name: %s", file.Name())
+		}
+		version, err := strconv.ParseUint(versionAndName[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version of %s: %v", file.Name(), err)
+		}
+
+		up, err := ioutil.ReadFile(path.Join(folder, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading migration %s: %v", file.Name(), err)
+		}
+		down, err := ioutil.ReadFile(path.Join(folder, identifier+".down.sql"))
+		if err != nil {
+			return nil, fmt.Errorf("error reading down migration of %s: %v", file.Name(), err)
+		}
+		migrations = append(migrations, rdb.Migration{
+			Version: uint(version),
+			Name:    versionAndName[1],
+			Up:      string(up),
+			Down:    string(down),
+		})
+	}
+	return migrations, nil
+}
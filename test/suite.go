@@ -11,6 +11,9 @@ import (
 	"github.com/crypto-com/chain-indexing/internal/typeconv"
 )
 
+// DEFAULT_MIGRATIONS_TABLE is the version table of golang-migrate when none is configured
+const DEFAULT_MIGRATIONS_TABLE = "schema_migrations"
+
 func WithTestPgConnConfig(body func(*pg.ConnConfig)) bool {
 	ssl := true
 	if os.Getenv("TEST_POSTGRES_SSL") == "0" {
@@ -50,7 +53,14 @@ func WithTestPgxConn(body func(*pg.PgxConn, *pg.Migrate)) bool {
 	if !ok {
 		panic("error retrieving file directory")
 	}
-	migrate := pg.MustNewMigrate(config, path.Join(filename, "../../migrations"))
+	migrate, err := pg.NewMigrateWithMigrations(
+		config,
+		mustReadAllMigrations(path.Join(filename, "../../migrations")),
+		DEFAULT_MIGRATIONS_TABLE,
+	)
+	if err != nil {
+		panic(err)
+	}
 
 	body(conn, migrate)
 
@@ -0,0 +1,35 @@
+package command
+
+import (
+	entity_event "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+type CreateBurn struct {
+	blockHeight int64
+	params      model.BurnParams
+}
+
+func NewCreateBurn(blockHeight int64, params model.BurnParams) *CreateBurn {
+	return &CreateBurn{
+		blockHeight,
+		params,
+	}
+}
+
+// Name returns name of command
+func (*CreateBurn) Name() string {
+	return "CreateBurn"
+}
+
+// Version returns version of command
+func (*CreateBurn) Version() int {
+	return 1
+}
+
+// Exec process the command data and return the event accordingly
+func (cmd *CreateBurn) Exec() (entity_event.Event, error) {
+	event := event.NewBurned(cmd.blockHeight, cmd.params)
+	return event, nil
+}
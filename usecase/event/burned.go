@@ -0,0 +1,59 @@
+package event
+
+import (
+	"bytes"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/luci/go-render/render"
+
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+)
+
+const BURNED = "Burned"
+
+type Burned struct {
+	event_entity.Base
+
+	Burner string     `json:"burner"`
+	Amount coin.Coins `json:"amount"`
+}
+
+func NewBurned(blockHeight int64, params model.BurnParams) *Burned {
+	return &Burned{
+		event_entity.NewBase(event_entity.BaseParams{
+			Name:        BURNED,
+			Version:     1,
+			BlockHeight: blockHeight,
+		}),
+
+		params.Burner,
+		params.Amount,
+	}
+
+}
+func (event *Burned) ToJSON() (string, error) {
+	encoded, err := jsoniter.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func (event *Burned) String() string {
+	return render.Render(event)
+}
+
+func DecodeBurned(encoded []byte) (event_entity.Event, error) {
+	jsonDecoder := jsoniter.NewDecoder(bytes.NewReader(encoded))
+	jsonDecoder.DisallowUnknownFields()
+
+	var event *Burned
+	if err := jsonDecoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
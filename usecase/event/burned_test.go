@@ -0,0 +1,46 @@
+package event_test
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ = Describe("Event", func() {
+	registry := event_entity.NewRegistry()
+	event_usecase.RegisterEvents(registry)
+
+	Describe("En/DecodeBurned", func() {
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyBurner := "tcro10d07y265gmmuvt4z0w9aw880jnsr700jvvjc2n"
+			anyAmount := coin.MustParseCoinsNormalized("1000000basetcro")
+
+			anyParams := model.BurnParams{
+				Burner: anyBurner,
+				Amount: anyAmount,
+			}
+			event := event_usecase.NewBurned(anyHeight, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.BURNED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.Burned)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.BURNED))
+			Expect(typedEvent.Version()).To(Equal(1))
+			Expect(typedEvent.Height()).To(Equal(anyHeight))
+
+			Expect(typedEvent.Burner).To(Equal(anyBurner))
+			Expect(typedEvent.Amount).To(Equal(anyAmount))
+		})
+	})
+})
@@ -17,6 +17,7 @@ func RegisterEvents(registry *event.Registry) {
 	registry.Register(BLOCK_REWARDED, 1, DecodeBlockRewarded)
 	registry.Register(BLOCK_COMMISSIONED, 1, DecodeBlockCommissioned)
 	registry.Register(MINTED, 1, DecodeMinted)
+	registry.Register(BURNED, 1, DecodeBurned)
 
 	registry.Register(POWER_CHANGED, 1, DecodePowerChanged)
 	registry.Register(VALIDATOR_SLASHED, 1, DecodeValidatorSlashed)
@@ -28,6 +29,10 @@ func RegisterEvents(registry *event.Registry) {
 	registry.Register(MSG_MULTI_SEND_CREATED, 1, DecodeMsgMultiSend)
 	registry.Register(MSG_MULTI_SEND_FAILED, 1, DecodeMsgMultiSend)
 
+	// Vesting
+	registry.Register(MSG_CREATE_VESTING_ACCOUNT_CREATED, 1, DecodeMsgCreateVestingAccount)
+	registry.Register(MSG_CREATE_VESTING_ACCOUNT_FAILED, 1, DecodeMsgCreateVestingAccount)
+
 	// Distribution
 	registry.Register(MSG_SET_WITHDRAW_ADDRESS_CREATED, 1, DecodeMsgSetWithdrawAddress)
 	registry.Register(MSG_SET_WITHDRAW_ADDRESS_FAILED, 1, DecodeMsgSetWithdrawAddress)
@@ -0,0 +1,92 @@
+package event_test
+
+import (
+	event_entity "github.com/crypto-com/chain-indexing/entity/event"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	event_usecase "github.com/crypto-com/chain-indexing/usecase/event"
+)
+
+var _ = Describe("Event", func() {
+	Describe("En/DecodeMsgCreateVestingAccount", func() {
+		registry := event_entity.NewRegistry()
+		event_usecase.RegisterEvents(registry)
+
+		It("should able to encode and decode to the same event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyFromAddress := "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn"
+			anyToAddress := "tcro1782gn9hzqavecukdaqqclvsnpck4mtz3vwzpxl"
+			anyAmount := coin.MustParseCoinsNormalized("123456basetcro")
+			anyEndTime := int64(1640995200)
+			anyParams := model.MsgCreateVestingAccountParams{
+				FromAddress: anyFromAddress,
+				ToAddress:   anyToAddress,
+				Amount:      anyAmount,
+				EndTime:     anyEndTime,
+				Delayed:     true,
+			}
+			event := event_usecase.NewMsgCreateVestingAccount(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   true,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_CREATE_VESTING_ACCOUNT_CREATED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgCreateVestingAccount)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_CREATE_VESTING_ACCOUNT_CREATED))
+			Expect(typedEvent.Version()).To(Equal(1))
+
+			Expect(typedEvent.MsgTxHash).To(Equal(anyTxHash))
+			Expect(typedEvent.MsgIndex).To(Equal(anyMsgIndex))
+			Expect(typedEvent.FromAddress).To(Equal(anyFromAddress))
+			Expect(typedEvent.ToAddress).To(Equal(anyToAddress))
+			Expect(typedEvent.Amount).To(Equal(anyAmount))
+			Expect(typedEvent.EndTime).To(Equal(anyEndTime))
+			Expect(typedEvent.Delayed).To(BeTrue())
+		})
+
+		It("should able to encode and decode to failed event", func() {
+			anyHeight := int64(1000)
+			anyTxHash := "4936522F7391D425F2A93AD47576F8AEC3947DC907113BE8A2FBCFF8E9F2A416"
+			anyMsgIndex := 2
+			anyParams := model.MsgCreateVestingAccountParams{
+				FromAddress: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+				ToAddress:   "tcro1782gn9hzqavecukdaqqclvsnpck4mtz3vwzpxl",
+				Amount:      coin.MustParseCoinsNormalized("123456basetcro"),
+				EndTime:     int64(1640995200),
+				Delayed:     false,
+			}
+			event := event_usecase.NewMsgCreateVestingAccount(event_usecase.MsgCommonParams{
+				BlockHeight: anyHeight,
+				TxHash:      anyTxHash,
+				TxSuccess:   false,
+				MsgIndex:    anyMsgIndex,
+			}, anyParams)
+
+			encoded, err := event.ToJSON()
+			Expect(err).To(BeNil())
+
+			decodedEvent, err := registry.DecodeByType(
+				event_usecase.MSG_CREATE_VESTING_ACCOUNT_FAILED, 1, []byte(encoded),
+			)
+			Expect(err).To(BeNil())
+			Expect(decodedEvent).To(Equal(event))
+			typedEvent, _ := decodedEvent.(*event_usecase.MsgCreateVestingAccount)
+			Expect(typedEvent.Name()).To(Equal(event_usecase.MSG_CREATE_VESTING_ACCOUNT_FAILED))
+			Expect(typedEvent.Version()).To(Equal(1))
+		})
+	})
+})
@@ -16,6 +16,9 @@ var MSG_EVENTS = []string{
 	MSG_MULTI_SEND_CREATED,
 	MSG_MULTI_SEND_FAILED,
 
+	MSG_CREATE_VESTING_ACCOUNT_CREATED,
+	MSG_CREATE_VESTING_ACCOUNT_FAILED,
+
 	MSG_SET_WITHDRAW_ADDRESS_CREATED,
 	MSG_SET_WITHDRAW_ADDRESS_FAILED,
 	MSG_WITHDRAW_DELEGATOR_REWARD_CREATED,
@@ -0,0 +1,8 @@
+package model
+
+import "github.com/crypto-com/chain-indexing/usecase/coin"
+
+type BurnParams struct {
+	Burner string
+	Amount coin.Coins
+}
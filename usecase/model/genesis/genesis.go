@@ -45,6 +45,8 @@ type Account struct {
 	BaseAccount              *BaseAccount        `json:"base_account,omitempty"`
 	ModuleAccountName        *string             `json:"name,omitempty"`
 	ModuleAccountPermissions []string            `json:"permissions,omitempty"`
+	StartTime                *string             `json:"start_time,omitempty"`
+	VestingPeriods           []VestingPeriod     `json:"vesting_periods,omitempty"`
 }
 
 type BaseVestingAccount struct {
@@ -55,6 +57,11 @@ type BaseVestingAccount struct {
 	EndTime          string        `json:"end_time"`
 }
 
+type VestingPeriod struct {
+	Length string       `json:"length"`
+	Amount []MinDeposit `json:"amount"`
+}
+
 type BaseAccount struct {
 	Address       string      `json:"address"`
 	PubKey        interface{} `json:"pub_key"`
@@ -0,0 +1,12 @@
+package model
+
+import "github.com/crypto-com/chain-indexing/usecase/coin"
+
+type MsgCreateVestingAccountParams struct {
+	FromAddress string     `json:"fromAddress"`
+	ToAddress   string     `json:"toAddress"`
+	Amount      coin.Coins `json:"amount"`
+	// Vesting end time in Unix seconds
+	EndTime int64 `json:"endTime"`
+	Delayed bool  `json:"delayed"`
+}
@@ -58,6 +58,20 @@ func ParseBeginBlockEventsCommands(
 					Amount:           coin.MustParseCoinsNormalized(fmt.Sprintf("%s%s", amount, bondingDenom)),
 				},
 			))
+		} else if event.Type == "burn" {
+			burnEvent := NewParsedTxsResultLogEvent(&beginBlockEvents[i])
+
+			amount := burnEvent.MustGetAttributeByKey("amount")
+			if amount == "" {
+				continue
+			}
+			commands = append(commands, command_usecase.NewCreateBurn(
+				blockHeight,
+				model.BurnParams{
+					Burner: burnEvent.MustGetAttributeByKey("burner"),
+					Amount: coin.MustParseCoinsNormalized(amount),
+				},
+			))
 		} else if event.Type == "proposer_reward" {
 			proposerRewardEvent := NewParsedTxsResultLogEvent(&beginBlockEvents[i])
 			amount := proposerRewardEvent.MustGetAttributeByKey("amount")
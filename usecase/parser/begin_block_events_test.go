@@ -86,6 +86,26 @@ var _ = Describe("ParseBeginBlockEventsCommands", func() {
 			}))
 		})
 
+		It("should return Burn command when begin_block_events has burn event", func() {
+			blockResults := mustParseBlockResultsResp(usecase_parser_test.BEGIN_BLOCK_BURN_EVENT_BLOCK_RESULTS_RESP)
+			bondingDenom := "basetcro"
+			cmds, err := parser.ParseBeginBlockEventsCommands(
+				blockResults.Height,
+				blockResults.BeginBlockEvents,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateBurn(
+					int64(377673),
+					model.BurnParams{
+						Burner: "tcro1m3h30wlvsf8llruxtpukdvsy0km2kum87lx9mq",
+						Amount: coin.MustParseCoinsNormalized("1000basetcro"),
+					},
+				),
+			}))
+		})
+
 		It("should return ValidatorSlashed and ValidatorJailed command base on missing signature events", func() {
 			blockResults := mustParseBlockResultsResp(usecase_parser_test.BEGIN_BLOCK_SLASH_MISSING_SIGNATURES_EVENT_BLOCK_RESULTS_RESP)
 			bondingDenom := "basetcro"
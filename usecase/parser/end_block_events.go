@@ -24,6 +24,20 @@ func ParseEndBlockEventsCommands(blockHeight int64, endBlockEvents []model.Block
 					Sender:    transferEvent.MustGetAttributeByKey("sender"),
 					Amount:    coin.MustParseCoinsNormalized(amount),
 				}))
+		} else if event.Type == "burn" {
+			burnEvent := NewParsedTxsResultLogEvent(&endBlockEvents[i])
+
+			amount := burnEvent.MustGetAttributeByKey("amount")
+			if amount == "" {
+				continue
+			}
+			commands = append(commands, command_usecase.NewCreateBurn(
+				blockHeight,
+				model.BurnParams{
+					Burner: burnEvent.MustGetAttributeByKey("burner"),
+					Amount: coin.MustParseCoinsNormalized(amount),
+				},
+			))
 		} else if event.Type == "complete_unbonding" {
 			completeBondingEvent := NewParsedTxsResultLogEvent(&endBlockEvents[i])
 			amountValue := completeBondingEvent.MustGetAttributeByKey("amount")
@@ -76,6 +76,32 @@ var _ = Describe("ParseEndBlockEventsCommands", func() {
 		}))
 	})
 
+	It("should return Burn commands before InactiveProposal commands when end_block_events has burn event", func() {
+		blockResults := mustParseBlockResultsResp(usecase_parser_test.END_BLOCK_PROPOSAL_DEPOSITS_BURNT_BLOCK_RESULTS_RESP)
+
+		cmds, err := parser.ParseEndBlockEventsCommands(
+			blockResults.Height,
+			blockResults.EndBlockEvents,
+		)
+		Expect(err).To(BeNil())
+		Expect(cmds).To(HaveLen(2))
+		expectedBlockHeight := int64(21541)
+		Expect(cmds).To(Equal([]command.Command{
+			command_usecase.NewCreateBurn(
+				expectedBlockHeight,
+				model.BurnParams{
+					Burner: "tcro10d07y265gmmuvt4z0w9aw880jnsr700jvvjc2n",
+					Amount: coin.MustParseCoinsNormalized("2000basetcro"),
+				},
+			),
+			command_usecase.NewInactiveProposal(
+				expectedBlockHeight,
+				"2",
+				"proposal_dropped",
+			),
+		}))
+	})
+
 	It("should return CompleteBonding commands when end_blocks_events has complete_unbonding event", func() {
 		blockResults := mustParseBlockResultsResp(usecase_parser_test.END_BLOCK_COMPLETE_UNBONDING_BLOCK_RESULTS_RESP)
 
@@ -60,6 +60,8 @@ func ParseBlockResultsTxsMsgToCommands(
 				msgCommands = parseMsgSend(msgCommonParams, msg)
 			case "/cosmos.bank.v1beta1.MsgMultiSend":
 				msgCommands = parseMsgMultiSend(msgCommonParams, msg)
+			case "/cosmos.vesting.v1beta1.MsgCreateVestingAccount":
+				msgCommands = parseMsgCreateVestingAccount(msgCommonParams, msg)
 			case "/cosmos.distribution.v1beta1.MsgSetWithdrawAddress":
 				msgCommands = parseMsgSetWithdrawAddress(msgCommonParams, msg)
 			case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
@@ -163,6 +165,30 @@ func parseMsgMultiSend(
 	)}
 }
 
+func parseMsgCreateVestingAccount(
+	msgCommonParams event.MsgCommonParams,
+	msg map[string]interface{},
+) []command.Command {
+	endTime, err := strconv.ParseInt(msg["end_time"].(string), 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("error parsing vesting account end time: %v", err))
+	}
+	// `delayed` is omitted from the message when it is false
+	delayed, _ := msg["delayed"].(bool)
+
+	return []command.Command{command_usecase.NewCreateMsgCreateVestingAccount(
+		msgCommonParams,
+
+		model.MsgCreateVestingAccountParams{
+			FromAddress: msg["from_address"].(string),
+			ToAddress:   msg["to_address"].(string),
+			Amount:      tmcosmosutils.MustNewCoinsFromAmountInterface(msg["amount"].([]interface{})),
+			EndTime:     endTime,
+			Delayed:     delayed,
+		},
+	)}
+}
+
 func parseMsgSetWithdrawAddress(
 	msgCommonParams event.MsgCommonParams,
 	msg map[string]interface{},
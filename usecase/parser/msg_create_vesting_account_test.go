@@ -0,0 +1,70 @@
+package parser_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/crypto-com/chain-indexing/entity/command"
+	"github.com/crypto-com/chain-indexing/usecase/coin"
+	command_usecase "github.com/crypto-com/chain-indexing/usecase/command"
+	"github.com/crypto-com/chain-indexing/usecase/event"
+	"github.com/crypto-com/chain-indexing/usecase/model"
+	"github.com/crypto-com/chain-indexing/usecase/parser"
+	usecase_parser_test "github.com/crypto-com/chain-indexing/usecase/parser/test"
+)
+
+var _ = Describe("ParseMsgCommands", func() {
+	Describe("MsgCreateVestingAccount", func() {
+		It("should parse Msg commands when there are vesting.MsgCreateVestingAccount in the transactions", func() {
+			txDecoder := parser.NewTxDecoder()
+			block, _ := mustParseBlockResp(usecase_parser_test.TX_MSG_CREATE_VESTING_ACCOUNT_BLOCK_RESP)
+			blockResults := mustParseBlockResultsResp(
+				usecase_parser_test.TX_MSG_CREATE_VESTING_ACCOUNT_BLOCK_RESULTS_RESP,
+			)
+			accountAddressPrefix := "tcro"
+			bondingDenom := "basetcro"
+
+			cmds, err := parser.ParseBlockResultsTxsMsgToCommands(
+				txDecoder,
+				block,
+				blockResults,
+				accountAddressPrefix,
+				bondingDenom,
+			)
+			Expect(err).To(BeNil())
+			Expect(cmds).To(HaveLen(2))
+			Expect(cmds).To(Equal([]command.Command{
+				command_usecase.NewCreateMsgCreateVestingAccount(
+					event.MsgCommonParams{
+						BlockHeight: int64(460662),
+						TxHash:      "41EBE645FE568095A10A3597EDA5511D3D02F0EBE0BC61D7C625D08EAB711F4C",
+						TxSuccess:   true,
+						MsgIndex:    0,
+					},
+					model.MsgCreateVestingAccountParams{
+						FromAddress: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+						ToAddress:   "tcro1p4fzn6ta24c6ek4v2qls6y5uug44ku9tnypcaf",
+						Amount:      coin.MustParseCoinsNormalized("1000000basetcro"),
+						EndTime:     1640995200,
+						Delayed:     true,
+					},
+				),
+				command_usecase.NewCreateMsgCreateVestingAccount(
+					event.MsgCommonParams{
+						BlockHeight: int64(460662),
+						TxHash:      "C1AD805248C77EA2E16C8CF68CCC1521C83BFE94E296FC8C34808CFE1F1EF987",
+						TxSuccess:   true,
+						MsgIndex:    0,
+					},
+					model.MsgCreateVestingAccountParams{
+						FromAddress: "tcro1fmprm0sjy6lz9llv7rltn0v2azzwcwzvk2lsyn",
+						ToAddress:   "tcro1p4fzn6ta24c6ek4v2qls6y5uug44ku9tnypcaf",
+						Amount:      coin.MustParseCoinsNormalized("1000000basetcro"),
+						EndTime:     1640995200,
+						Delayed:     false,
+					},
+				),
+			}))
+		})
+	})
+})
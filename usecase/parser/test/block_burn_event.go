@@ -0,0 +1,102 @@
+package usecase_parser_test
+
+const BEGIN_BLOCK_BURN_EVENT_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "377673",
+    "txs_results": null,
+    "begin_block_events": [
+      {
+        "type": "burn",
+        "attributes": [
+          {
+            "key": "YnVybmVy",
+            "value": "dGNybzFtM2gzMHdsdnNmOGxscnV4dHB1a2R2c3kwa20ya3VtODdseDltcQ==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MTAwMGJhc2V0Y3Jv",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "end_block_events": null,
+    "validator_updates": null,
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`
+
+const END_BLOCK_PROPOSAL_DEPOSITS_BURNT_BLOCK_RESULTS_RESP = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "height": "21541",
+    "txs_results": null,
+    "begin_block_events": null,
+    "end_block_events": [
+      {
+        "type": "burn",
+        "attributes": [
+          {
+            "key": "YnVybmVy",
+            "value": "dGNybzEwZDA3eTI2NWdtbXV2dDR6MHc5YXc4ODBqbnNyNzAwanZ2amMybg==",
+            "index": true
+          },
+          {
+            "key": "YW1vdW50",
+            "value": "MjAwMGJhc2V0Y3Jv",
+            "index": true
+          }
+        ]
+      },
+      {
+        "type": "inactive_proposal",
+        "attributes": [
+          {
+            "key": "cHJvcG9zYWxfaWQ=",
+            "value": "Mg==",
+            "index": true
+          },
+          {
+            "key": "cHJvcG9zYWxfcmVzdWx0",
+            "value": "cHJvcG9zYWxfZHJvcHBlZA==",
+            "index": true
+          }
+        ]
+      }
+    ],
+    "validator_updates": null,
+    "consensus_param_updates": {
+      "block": {
+        "max_bytes": "22020096",
+        "max_gas": "-1"
+      },
+      "evidence": {
+        "max_age_num_blocks": "100000",
+        "max_age_duration": "172800000000000"
+      },
+      "validator": {
+        "pub_key_types": [
+          "ed25519"
+        ]
+      }
+    }
+  }
+}`